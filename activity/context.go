@@ -0,0 +1,71 @@
+package activity
+
+import (
+	"context"
+	"time"
+)
+
+type activityInfoKey struct{}
+
+// activityInfo identifies the activity being worked on
+type activityInfo struct {
+	workflowID string
+	activityID string
+	taskToken  string
+}
+
+func withActivityInfo(ctx context.Context, workflowID, activityID, taskToken string) context.Context {
+	return context.WithValue(ctx, activityInfoKey{}, activityInfo{workflowID: workflowID, activityID: activityID, taskToken: taskToken})
+}
+
+// FromContext returns the IDs and task token of the activity being worked on, e.g. so a WorkerFunc can reference its own
+// activity in telemetry or sub-calls.  ok is false if ctx does not come from Worker.Do or Worker.Resume.
+func FromContext(ctx context.Context) (workflowID, activityID, taskToken string, ok bool) {
+	info, ok := ctx.Value(activityInfoKey{}).(activityInfo)
+	return info.workflowID, info.activityID, info.taskToken, ok
+}
+
+type retryBudgetKey struct{}
+
+// retries is the retry budget left when the WorkerFunc was called
+type retries struct {
+	attempt   int
+	remaining int
+}
+
+// RetryBudget is what is left of the retries of the WorkerFunc, see RetryBudgetFromContext
+type RetryBudget struct {
+	// Attempt is the number of the current call of the WorkerFunc, starting at 1
+	Attempt int
+	// RemainingRetries is how many more times the WorkerFunc is called if the current call returns a retryable error
+	// (see Worker.MaxWorkRetries and Worker.WorkRetryable)
+	RemainingRetries int
+	// RemainingTime is the time left before the deadline of the work (see Worker.ActivityTimeout), including the time of
+	// the current call.  It is only set when HasDeadline is true.
+	RemainingTime time.Duration
+	// HasDeadline tells if the work has a deadline, without one the retries are only limited by RemainingRetries
+	HasDeadline bool
+}
+
+func withRetryBudget(ctx context.Context, attempt, remaining int) context.Context {
+	return context.WithValue(ctx, retryBudgetKey{}, retries{attempt: attempt, remaining: remaining})
+}
+
+// RetryBudgetFromContext returns what is left of the retries of the WorkerFunc when it is called, e.g. to skip
+// expensive recovery work on the last attempt or when too little time remains for it.  RemainingTime is measured when
+// RetryBudgetFromContext is called.  ok is false if ctx is not the context Worker.Do gives the WorkerFunc.
+func RetryBudgetFromContext(ctx context.Context) (budget RetryBudget, ok bool) {
+	r, ok := ctx.Value(retryBudgetKey{}).(retries)
+	if !ok {
+		return RetryBudget{}, false
+	}
+	budget = RetryBudget{Attempt: r.attempt, RemainingRetries: r.remaining}
+	if deadline, hasDeadline := ctx.Deadline(); hasDeadline {
+		budget.HasDeadline = true
+		budget.RemainingTime = time.Until(deadline)
+		if budget.RemainingTime < 0 {
+			budget.RemainingTime = 0
+		}
+	}
+	return budget, true
+}
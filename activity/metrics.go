@@ -0,0 +1,65 @@
+package activity
+
+import (
+	"context"
+	"time"
+
+	log "github.com/inconshreveable/log15"
+)
+
+// Names and labels of the metrics a Worker emits to its Metrics
+const (
+	// WorkDurationMetric is a histogram of how long the WorkerFunc took in seconds, labelled with OutcomeLabel
+	WorkDurationMetric = "worker_activity_duration_seconds"
+	// WorkTotalMetric is a counter of the WorkerFuncs that returned, labelled with OutcomeLabel
+	WorkTotalMetric = "worker_activity_total"
+	// OutcomeLabel is the label telling how the work ended, one of the Outcome constants
+	OutcomeLabel = "outcome"
+)
+
+// Outcomes of the work, the values of OutcomeLabel
+const (
+	OutcomeSucceeded = "succeeded"
+	OutcomeFailed    = "failed"
+	// OutcomeCancelled is the outcome of work that returned after its context was closed, whether it was cancelled
+	// through a heartbeat or timed out (see Worker.ActivityTimeout)
+	OutcomeCancelled = "cancelled"
+)
+
+// Metrics receives the metrics emitted by a Worker, e.g. to export them to Prometheus with a histogram and a counter
+// vector per name.  It must be safe for concurrent use since a Worker may do several pieces of work at once.  A panic
+// of Metrics is logged by the Worker and does not affect the work.
+type Metrics interface {
+	// Observe adds value to the histogram name with the labels
+	Observe(name string, value float64, labels map[string]string)
+	// Inc adds 1 to the counter name with the labels
+	Inc(name string, labels map[string]string)
+}
+
+// workOutcome tells how the work that returned err while ctx was in the given state ended
+func workOutcome(ctx context.Context, err error) string {
+	if ctx.Err() != nil {
+		return OutcomeCancelled
+	}
+	if err != nil {
+		return OutcomeFailed
+	}
+	return OutcomeSucceeded
+}
+
+// emitWorkMetrics emits the metrics of work that started at start and returned err, if the Worker has Metrics.  A panic
+// of Metrics is logged and swallowed so that a broken metrics backend never keeps the outcome of the work from being
+// reported.
+func (w *Worker) emitWorkMetrics(ctx context.Context, workLog log.Logger, start time.Time, err error) {
+	if w.Metrics == nil {
+		return
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			workLog.Error("Metrics panicked, dropping the metrics of the work", "panic", r)
+		}
+	}()
+	labels := map[string]string{OutcomeLabel: workOutcome(ctx, err)}
+	w.Metrics.Observe(WorkDurationMetric, time.Since(start).Seconds(), labels)
+	w.Metrics.Inc(WorkTotalMetric, labels)
+}
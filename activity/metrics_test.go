@@ -0,0 +1,109 @@
+package activity
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/3dsim/workflow-goclient/workflow/workflowfakes"
+	"github.com/stretchr/testify/assert"
+)
+
+// recordingMetrics keeps the observations and counts it receives
+type recordingMetrics struct {
+	mu           sync.Mutex
+	observations map[string][]float64
+	counts       map[string]int
+}
+
+func newRecordingMetrics() *recordingMetrics {
+	return &recordingMetrics{observations: map[string][]float64{}, counts: map[string]int{}}
+}
+
+func (m *recordingMetrics) Observe(name string, value float64, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := name + "{" + labels[OutcomeLabel] + "}"
+	m.observations[key] = append(m.observations[key], value)
+}
+
+func (m *recordingMetrics) Inc(name string, labels map[string]string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.counts[name+"{"+labels[OutcomeLabel]+"}"]++
+}
+
+func TestDoWithMetrics(t *testing.T) {
+	t.Run("WhenWorkCompletesExpectsDurationObservedAsSucceeded", func(t *testing.T) {
+		// arrange
+		metrics := newRecordingMetrics()
+		worker := &Worker{WorkflowClient: &workflowfakes.FakeClient{}, Metrics: metrics, Logger: logger}
+
+		// act
+		worker.Do(context.Background(), "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+			time.Sleep(20 * time.Millisecond)
+			return "result", nil
+		})
+
+		// assert
+		durations := metrics.observations["worker_activity_duration_seconds{succeeded}"]
+		if assert.Len(t, durations, 1, "Expected one duration observed for the completed activity") {
+			assert.True(t, durations[0] >= 0.02, "Expected the duration of the work in seconds but got %v", durations[0])
+		}
+		assert.Equal(t, 1, metrics.counts["worker_activity_total{succeeded}"], "Expected the completed activity to be counted")
+	})
+
+	t.Run("WhenWorkFailsExpectsFailureCounted", func(t *testing.T) {
+		// arrange
+		metrics := newRecordingMetrics()
+		worker := &Worker{WorkflowClient: &workflowfakes.FakeClient{}, Metrics: metrics, Logger: logger}
+
+		// act
+		worker.Do(context.Background(), "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+			return nil, errors.New("Some error")
+		})
+
+		// assert
+		assert.Len(t, metrics.observations["worker_activity_duration_seconds{failed}"], 1, "Expected one duration observed for the failed activity")
+		assert.Equal(t, 1, metrics.counts["worker_activity_total{failed}"], "Expected the failed activity to be counted")
+	})
+
+	t.Run("WhenWorkCancelledExpectsCancellationCounted", func(t *testing.T) {
+		// arrange
+		metrics := newRecordingMetrics()
+		worker := &Worker{WorkflowClient: &workflowfakes.FakeClient{}, Metrics: metrics, Logger: logger}
+		ctx, cancel := context.WithCancel(context.Background())
+
+		// act
+		worker.Do(ctx, "workflow id", "activity id", "token", func(ctx context.Context, _ chan<- int) (interface{}, error) {
+			cancel()
+			<-ctx.Done()
+			return nil, ctx.Err()
+		})
+
+		// assert
+		assert.Equal(t, 1, metrics.counts["worker_activity_total{cancelled}"], "Expected the cancelled activity to be counted")
+	})
+}
+
+// panickingMetrics panics on every metric, like a broken metrics backend
+type panickingMetrics struct{}
+
+func (panickingMetrics) Observe(string, float64, map[string]string) { panic("metrics backend down") }
+func (panickingMetrics) Inc(string, map[string]string)              { panic("metrics backend down") }
+
+func TestDoWhenMetricsPanicsExpectsSuccessReported(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Metrics: panickingMetrics{}, Logger: logger}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+		return "result", nil
+	})
+
+	// assert
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteSuccessfulActivityCallCount(), "Expected the success to be reported despite the metrics")
+}
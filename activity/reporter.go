@@ -0,0 +1,251 @@
+package activity
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/3dsim/workflow-goclient/workflow"
+	"github.com/go-openapi/strfmt"
+	log "github.com/inconshreveable/log15"
+)
+
+const (
+	defaultLogFlushInterval = 10 * time.Second
+	// maxLogBatchSize is how many lines are buffered before they are sent without waiting for the flush interval
+	maxLogBatchSize = 100
+)
+
+type reporterKey struct{}
+
+// Progress is a progress report richer than the percent complete sent on the channel given to a WorkerFunc, see
+// ProgressReporter.Report.  A percent complete sent on the channel is reported as a Progress with only PercentComplete
+// set.
+type Progress struct {
+	// PercentComplete is how much of the work is done, from 0 to 100
+	PercentComplete int
+	// Stage names the step the work is at, e.g. "meshing".  Optional.
+	Stage string
+	// Message describes the progress, e.g. "Meshed 3 of 8 parts".  Optional.
+	Message string
+}
+
+// ProgressReporter lets a WorkerFunc report more than percent complete.  Worker.Do puts one in the context given to the
+// WorkerFunc, get it with ReporterFromContext.  A nil *ProgressReporter is valid and discards everything.
+type ProgressReporter struct {
+	client     workflow.Client
+	workflowID string
+	activityID string
+	taskToken  string
+	workLog    log.Logger
+
+	mu     sync.Mutex
+	lines  []*models.LogLine
+	closed bool
+	// lastPercentComplete and lastLine are the last progress reported by the work, -1 and "" until some is reported
+	lastPercentComplete int
+	lastLine            string
+	// heartbeatsPausedUntil is when the heartbeat timeout extension asked for by ExtendHeartbeatTimeout ends
+	heartbeatsPausedUntil time.Time
+	// serverCancellationReason is the reason of the cancellation given by the heartbeat that requested it
+	serverCancellationReason string
+
+	// progress hands the values given to Report to the goroutine sending the updates
+	progress  chan Progress
+	full      chan struct{}
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// ReporterFromContext returns the ProgressReporter of the activity being worked on, or nil if ctx does not come from
+// Worker.Do.
+func ReporterFromContext(ctx context.Context) *ProgressReporter {
+	reporter, _ := ctx.Value(reporterKey{}).(*ProgressReporter)
+	return reporter
+}
+
+func newProgressReporter(client workflow.Client, workflowID, activityID, taskToken string, workLog log.Logger) *ProgressReporter {
+	return &ProgressReporter{
+		client:     client,
+		workflowID: workflowID,
+		activityID: activityID,
+		taskToken:  taskToken,
+		workLog:    workLog,
+		progress:   make(chan Progress),
+		full:       make(chan struct{}, 1),
+		stop:       make(chan struct{}),
+		done:       make(chan struct{}),
+
+		lastPercentComplete: -1,
+	}
+}
+
+// Log attaches a line of output to the activity.  Lines are sent in batches periodically (see Worker.LogFlushInterval)
+// and before the completion of the activity is reported.  Lines logged after the activity completed are dropped.
+func (r *ProgressReporter) Log(line string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		r.workLog.Debug("Dropping log line logged after the activity completed", "line", line)
+		return
+	}
+	r.lines = append(r.lines, &models.LogLine{Line: line, Time: strfmt.DateTime(time.Now())})
+	r.lastLine = line
+	if len(r.lines) >= maxLogBatchSize {
+		select {
+		case r.full <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// Report sends the progress of the work along with its stage and message, see Progress.  Like a percent complete sent on
+// the channel given to the WorkerFunc, it blocks until the update is sent and is dropped when it is the same as the last
+// one.  Progress reported after the activity completed is dropped.
+func (r *ProgressReporter) Report(progress Progress) {
+	if r == nil {
+		return
+	}
+	select {
+	case r.progress <- progress:
+	case <-r.stop:
+		r.workLog.Debug("Dropping progress reported after the activity completed", "percentComplete", progress.PercentComplete, "stage", progress.Stage)
+	}
+}
+
+// ExtendHeartbeatTimeout asks the workflow API to wait up to extension for the next heartbeat of the activity instead of
+// the heartbeat timeout (see workflow.Client.ExtendHeartbeatTimeout) and pauses the heartbeats sent by the Worker until
+// then, e.g. before a blocking call during which the process can't heartbeat so the activity is not timed out.
+// Heartbeating resumes once extension has passed, a cancellation requested meanwhile is noticed by the first heartbeat
+// after that.  The heartbeats are not paused when the request fails.
+func (r *ProgressReporter) ExtendHeartbeatTimeout(extension time.Duration) error {
+	if r == nil {
+		return nil
+	}
+	start := time.Now()
+	if _, err := r.client.ExtendHeartbeatTimeout(r.taskToken, r.activityID, extension); err != nil {
+		r.workLog.Error("Problem extending heartbeat timeout", "error", err, "extension", extension)
+		return err
+	}
+	r.workLog.Info("Pausing heartbeats", "extension", extension)
+	r.mu.Lock()
+	r.heartbeatsPausedUntil = start.Add(extension)
+	r.mu.Unlock()
+	return nil
+}
+
+// heartbeatsPaused tells if the heartbeats are paused by ExtendHeartbeatTimeout at now
+func (r *ProgressReporter) heartbeatsPaused(now time.Time) bool {
+	if r == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return now.Before(r.heartbeatsPausedUntil)
+}
+
+// setCancellationReason keeps the reason of the cancellation given by the workflow API
+func (r *ProgressReporter) setCancellationReason(reason string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.serverCancellationReason = reason
+}
+
+// cancellationReason returns the reason to report a requested cancellation with: the one given by the workflow API, or
+// cancelledReason when it gave none
+func (r *ProgressReporter) cancellationReason() string {
+	if r == nil {
+		return cancelledReason
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.serverCancellationReason == "" {
+		return cancelledReason
+	}
+	return r.serverCancellationReason
+}
+
+// recordPercentComplete keeps percentComplete as the last percent complete reported by the work
+func (r *ProgressReporter) recordPercentComplete(percentComplete int) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	r.lastPercentComplete = percentComplete
+	r.mu.Unlock()
+}
+
+// lastProgress returns the last percent complete and line reported by the work, to be appended to the details of a
+// cancellation or failure so they tell how far the work got.  It is empty when no progress was reported.
+func (r *ProgressReporter) lastProgress() string {
+	if r == nil {
+		return ""
+	}
+	r.mu.Lock()
+	percentComplete, line := r.lastPercentComplete, r.lastLine
+	r.mu.Unlock()
+	var progress []string
+	if percentComplete >= 0 {
+		progress = append(progress, fmt.Sprintf("%d%% complete", percentComplete))
+	}
+	if line != "" {
+		progress = append(progress, fmt.Sprintf("last message %q", line))
+	}
+	if len(progress) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (last progress: %v)", strings.Join(progress, ", "))
+}
+
+// run sends the buffered lines every interval until close is called
+func (r *ProgressReporter) run(interval time.Duration) {
+	defer close(r.done)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			r.flush()
+		case <-r.full:
+			r.flush()
+		case <-r.stop:
+			return
+		}
+	}
+}
+
+// close stops run, sends whatever is left and drops lines logged from then on
+func (r *ProgressReporter) close() {
+	r.closeOnce.Do(func() {
+		close(r.stop)
+		<-r.done
+		r.mu.Lock()
+		r.closed = true
+		r.mu.Unlock()
+		r.flush()
+	})
+}
+
+func (r *ProgressReporter) flush() {
+	r.mu.Lock()
+	lines := r.lines
+	r.lines = nil
+	r.mu.Unlock()
+	if len(lines) == 0 {
+		return
+	}
+	r.workLog.Debug("Sending activity logs", "lines", len(lines))
+	if err := r.client.AppendActivityLogs(r.workflowID, r.activityID, lines); err != nil {
+		r.workLog.Error("Problem sending activity logs, dropping them", "error", err, "lines", len(lines))
+	}
+}
@@ -0,0 +1,163 @@
+package activity
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/3dsim/workflow-goclient/models"
+	log "github.com/inconshreveable/log15"
+)
+
+// ErrActivityFinished is returned when the completion of a ResumedActivity is reported more than once
+var ErrActivityFinished = errors.New("Completion of the activity was already reported")
+
+// ResumedActivity is an activity whose work was started by an earlier process, see Worker.Resume.  Heartbeats are sent
+// until Complete or Fail is called.
+type ResumedActivity struct {
+	worker     *Worker
+	workflowID string
+	activityID string
+	workLog    log.Logger
+	ctx        context.Context
+	cancelFunc context.CancelFunc
+	reporter   *ProgressReporter
+	pc         chan int
+	// closed once every percent complete update has been sent
+	pcDone chan struct{}
+	stop   chan bool
+
+	mu       sync.Mutex
+	finished bool
+}
+
+// Resume re-attaches to an activity whose work was started by an earlier process, e.g. one that crashed, so that the
+// work can carry on from where it was instead of starting over.  It starts heartbeating with taskToken like Do does and
+// returns a ResumedActivity through which progress and completion are reported.  The percent complete the workflow API
+// already has for the activity is fetched first so that reporting it again does not send a redundant update.
+//
+// The caller is responsible for persisting what the work needs to continue, and the task token along with it, before
+// starting the work and for deleting it once the completion has been reported.  A task token that was lost can be
+// fetched again with workflow.Client.GetActivityTaskToken while the activity is running.  As with Do, ctx may hold the
+// attempt number (see workflow.ContextWithAttempt) to send with the reports.  Worker.ActivityTimeout is not applied
+// since the time the work started is not known here.
+func (w *Worker) Resume(ctx context.Context, workflowID, activityID, taskToken string) *ResumedActivity {
+	workLog := w.workLog(workflowID, activityID)
+	workLog.Info("Resuming activity")
+	w = w.withAttempt(ctx, workLog)
+	childCtx, cancelFunc := context.WithCancel(ctx)
+	reporter := newProgressReporter(w.WorkflowClient, workflowID, activityID, taskToken, workLog)
+	a := &ResumedActivity{
+		worker:     w,
+		workflowID: workflowID,
+		activityID: activityID,
+		workLog:    workLog,
+		ctx:        withActivityInfo(context.WithValue(childCtx, reporterKey{}, reporter), workflowID, activityID, taskToken),
+		cancelFunc: cancelFunc,
+		reporter:   reporter,
+		pc:         make(chan int),
+		pcDone:     make(chan struct{}),
+		stop:       make(chan bool),
+	}
+	go w.heartbeat(a.ctx, workLog, taskToken, activityID, cancelFunc, a.stop)
+	lastPercentComplete := w.currentPercentComplete(childCtx, workflowID, activityID, workLog)
+	reporter.recordPercentComplete(lastPercentComplete)
+	go func() {
+		defer close(a.pcDone)
+		w.updatePercentComplete(workflowID, activityID, workLog, reporter, lastPercentComplete, a.pc, nil, nil)
+	}()
+	go reporter.run(w.logFlushInterval())
+	return a
+}
+
+// currentPercentComplete returns the percent complete the workflow API has for the activity so that resumed work does
+// not send it again, or -1 if it can't be fetched
+func (w *Worker) currentPercentComplete(ctx context.Context, workflowID, activityID string, workLog log.Logger) int {
+	client := w.WorkflowClient.WithContext(ctx)
+	if client == nil {
+		// fakes of workflow.Client return nil unless told otherwise
+		client = w.WorkflowClient
+	}
+	activity, err := client.GetActivity(workflowID, activityID)
+	if err != nil {
+		workLog.Warn("Problem getting the percent complete of the resumed activity, the first update will be sent regardless", "error", err)
+		return -1
+	}
+	if activity == nil {
+		return -1
+	}
+	return int(activity.PercentComplete)
+}
+
+// Context returns the context the resumed work should run with.  It is closed when a cancellation is requested via a
+// heartbeat, when the context given to Resume is closed and once the completion has been reported.  Lines of output can
+// be attached to the activity with ReporterFromContext(ctx).Log and the IDs of the activity are returned by
+// FromContext(ctx).
+func (a *ResumedActivity) Context() context.Context {
+	return a.ctx
+}
+
+// UpdatePercentComplete reports the progress of the resumed work.  Updates made after the completion was reported are
+// dropped.
+func (a *ResumedActivity) UpdatePercentComplete(percentComplete int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.finished {
+		a.workLog.Debug("Dropping percent complete update made after the activity completed", "percentComplete", percentComplete)
+		return
+	}
+	a.pc <- percentComplete
+}
+
+// Complete reports that the resumed work succeeded with result and stops heartbeating.  If the context returned by
+// Context was closed before, the activity is reported as cancelled instead, like Do does (see
+// Worker.ReportPartialResults).
+func (a *ResumedActivity) Complete(result interface{}) error {
+	return a.finish(func(cancelled bool) error {
+		if cancelled {
+			_, err := a.worker.completeCancelled(a.workflowID, a.activityID, a.reporter.cancellationReason(), a.worker.details(a.reporter, completedMessage), result)
+			return err
+		}
+		a.workLog.Info("Sending success message to workflow API", "result", result)
+		_, err := a.worker.WorkflowClient.CompleteSuccessfulActivity(a.workflowID, a.activityID, result)
+		return err
+	})
+}
+
+// Fail reports that the resumed work failed with workErr and stops heartbeating.  If the context returned by Context
+// was closed before, the activity is reported as cancelled instead, like Do does.
+func (a *ResumedActivity) Fail(workErr error) error {
+	return a.finish(func(cancelled bool) error {
+		if cancelled {
+			_, err := a.worker.WorkflowClient.CompleteCancelledActivity(a.workflowID, a.activityID, a.reporter.cancellationReason(), a.worker.details(a.reporter, workErr.Error()))
+			return err
+		}
+		a.workLog.Info("Sending failure message to workflow API", "error", workErr)
+		activityError := models.ActivityErrorFromError(workErr)
+		_, err := a.worker.WorkflowClient.CompleteFailedActivity(a.workflowID, a.activityID, *activityError.Reason, a.worker.details(a.reporter, activityError.Details))
+		return err
+	})
+}
+
+func (a *ResumedActivity) finish(report func(cancelled bool) error) error {
+	a.mu.Lock()
+	if a.finished {
+		a.mu.Unlock()
+		return ErrActivityFinished
+	}
+	a.finished = true
+	close(a.pc)
+	a.mu.Unlock()
+
+	<-a.pcDone
+	// logs are sent before the completion so they are attached to the activity while it is still open
+	a.reporter.close()
+	err := report(a.ctx.Err() != nil)
+	if err != nil {
+		a.workLog.Error("Problem sending completion message", "error", err)
+	}
+	// Stop heartbeating
+	a.stop <- false
+	a.cancelFunc()
+	return err
+}
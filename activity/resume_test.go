@@ -0,0 +1,116 @@
+package activity
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/3dsim/workflow-goclient/workflow/workflowfakes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResumeExpectsHeartbeatActivityWithTokenCalledWithGivenToken(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	ticks := make(chan time.Time)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatTicks: ticks, Logger: logger}
+	activityID := "activity id"
+	workflowID := "workflow id"
+	taskToken := "persisted token"
+
+	// act
+	resumed := worker.Resume(context.Background(), workflowID, activityID, taskToken)
+	ticks <- time.Now()
+	ticks <- time.Now()
+	err := resumed.Complete("the result")
+
+	// assert
+	assert.Nil(t, err, "Expected no error completing the resumed activity")
+	assert.Equal(t, 2, fakeWorkflowClient.HeartbeatActivityWithTokenCallCount(), "Expected one heartbeat per tick")
+	actualTaskToken, actualActivityID, _ := fakeWorkflowClient.HeartbeatActivityWithTokenArgsForCall(0)
+	assert.Equal(t, taskToken, actualTaskToken, "Expected the persisted task token to be used for heartbeats")
+	assert.Equal(t, activityID, actualActivityID, "Expected activity ID passed to HeartbeatActivityWithToken")
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteSuccessfulActivityCallCount(), "Expected to call CompleteSuccessfulActivity once")
+	_, _, actualResult := fakeWorkflowClient.CompleteSuccessfulActivityArgsForCall(0)
+	assert.Equal(t, "the result", actualResult, "Expected result passed to CompleteSuccessfulActivity")
+}
+
+func TestResumeExpectsProgressAndLogsReportedBeforeCompletion(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+	resumed := worker.Resume(context.Background(), "workflow id", "activity id", "token")
+
+	// act
+	resumed.UpdatePercentComplete(60)
+	ReporterFromContext(resumed.Context()).Log("picked up from checkpoint")
+	err := resumed.Fail(errors.New("Some error"))
+
+	// assert
+	assert.Nil(t, err, "Expected no error failing the resumed activity")
+	assert.Equal(t, 1, fakeWorkflowClient.UpdateActivityPercentCompleteCallCount(), "Expected the percent complete to be sent")
+	assert.Equal(t, 1, fakeWorkflowClient.AppendActivityLogsCallCount(), "Expected the logged line to be sent")
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteFailedActivityCallCount(), "Expected to call CompleteFailedActivity once")
+	assert.NotNil(t, resumed.Context().Err(), "Expected the context to be closed once the completion was reported")
+}
+
+func TestResumeWhenServerHasPercentCompleteExpectsNoRedundantUpdate(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	fakeWorkflowClient.GetActivityReturns(&models.Activity{PercentComplete: 40}, nil)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+	resumed := worker.Resume(context.Background(), "workflow id", "activity id", "token")
+
+	// act
+	resumed.UpdatePercentComplete(40)
+	resumed.UpdatePercentComplete(45)
+	err := resumed.Complete("result")
+
+	// assert
+	assert.Nil(t, err, "Expected no error completing the resumed activity")
+	workflowID, activityID := fakeWorkflowClient.GetActivityArgsForCall(0)
+	assert.Equal(t, "workflow id", workflowID, "Expected workflow ID passed to GetActivity")
+	assert.Equal(t, "activity id", activityID, "Expected activity ID passed to GetActivity")
+	if assert.Equal(t, 1, fakeWorkflowClient.UpdateActivityPercentCompleteCallCount(), "Expected the 40% the server has to not be sent again") {
+		_, _, percentComplete := fakeWorkflowClient.UpdateActivityPercentCompleteArgsForCall(0)
+		assert.Equal(t, 45, percentComplete, "Expected the new percent complete to be sent")
+	}
+}
+
+func TestResumeWhenCancellationRequestedExpectsCompleteCancelledActivityCalled(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	fakeWorkflowClient.HeartbeatActivityWithTokenReturns(&models.Heartbeat{Cancelled: true}, nil)
+	ticks := make(chan time.Time)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatTicks: ticks, Logger: logger}
+	resumed := worker.Resume(context.Background(), "workflow id", "activity id", "token")
+
+	// act
+	ticks <- time.Now()
+	<-resumed.Context().Done()
+	err := resumed.Complete("the result")
+
+	// assert
+	assert.Nil(t, err, "Expected no error completing the resumed activity")
+	assert.Equal(t, 0, fakeWorkflowClient.CompleteSuccessfulActivityCallCount(), "Expected cancelled work to not be reported successful")
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteCancelledActivityCallCount(), "Expected to call CompleteCancelledActivity once")
+}
+
+func TestResumeWhenCompletedTwiceExpectsErrActivityFinished(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+	resumed := worker.Resume(context.Background(), "workflow id", "activity id", "token")
+	resumed.Complete("the result")
+
+	// act
+	err := resumed.Fail(errors.New("Some error"))
+	resumed.UpdatePercentComplete(100)
+
+	// assert
+	assert.Equal(t, ErrActivityFinished, err, "Expected ErrActivityFinished")
+	assert.Equal(t, 0, fakeWorkflowClient.CompleteFailedActivityCallCount(), "Expected the completion to be reported only once")
+	assert.Equal(t, 0, fakeWorkflowClient.UpdateActivityPercentCompleteCallCount(), "Expected the update to be dropped")
+}
@@ -3,9 +3,15 @@ package activity
 import (
 	"context"
 	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
 	"time"
+	"unicode/utf8"
 
+	"github.com/3dsim/workflow-goclient/models"
 	"github.com/3dsim/workflow-goclient/workflow"
+	"github.com/go-openapi/swag"
 	log "github.com/inconshreveable/log15"
 )
 
@@ -15,47 +21,202 @@ const (
 	timeoutErrorMessage        = "Work cancelled after timeout"
 	completedMessage           = "Work completed successfully"
 	cancelledReason            = "Cancel requested"
+	activityTimeoutReason      = "Activity timeout exceeded"
+	stoppedMessage             = "Worker stopped"
 )
 
+const (
+	defaultMaxDetailsLength     = 32 * 1024
+	truncatedSuffix             = "...(truncated)"
+	defaultMinHeartbeatInterval = 5 * time.Second
+	// adaptiveHeartbeatDivisor is how many heartbeats an adaptive heartbeat fits in the time left before the deadline
+	adaptiveHeartbeatDivisor = 10
+)
+
+// splayRandInt63n picks the offset of the first heartbeat with Worker.HeartbeatSplay, replaced by tests to pick
+// known offsets
+var splayRandInt63n = rand.Int63n
+
+// abandonedWork counts the WorkerFuncs that did not return within the cancellation timeout
+var abandonedWork int64
+
+// AbandonedWorkCount returns how many WorkerFuncs have been abandoned by Worker.Do since the process started.  See
+// Worker.Do for when work is abandoned.
+func AbandonedWorkCount() int64 {
+	return atomic.LoadInt64(&abandonedWork)
+}
+
 // Worker handles executing work and reporting status and progress to the workflow API via the WorkflowClient field.
 type Worker struct {
+	// WorkflowClient sends the heartbeats, progress and completion of the work.  Heartbeats are sent by a copy bound to
+	// the context given to Do or Resume with WithContext, which replaces the context the client may already be bound
+	// to, so a token to forward (see workflow.ContextWithToken) must be put in the context given to Do or Resume rather
+	// than in the context of the client.
 	WorkflowClient    workflow.Client
 	HeartbeatInterval time.Duration
+	// HeartbeatTimeout is the heartbeat timeout of the activity on the workflow API.  When set, Do warns if
+	// HeartbeatInterval is not shorter than it since the workflow API would fail the activity for missed heartbeats.
+	HeartbeatTimeout time.Duration
+	// HeartbeatTicks replaces the HeartbeatInterval ticker when set: one heartbeat is sent for each value received.  It
+	// is meant for tests that need an exact number of heartbeats, e.g. the WorkerFunc sends twice on an unbuffered
+	// channel and exactly two heartbeats have been sent once Do returns.
+	HeartbeatTicks <-chan time.Time
+	// HeartbeatDetailsFunc supplies the details sent with each heartbeat when set, e.g. to include the hostname or the
+	// current step of the work.  If not set, the details are "Heartbeat for activity <activity ID>".
+	HeartbeatDetailsFunc func() string
+	// AdaptiveHeartbeat shortens the interval between heartbeats as the deadline of the work nears (see ActivityTimeout)
+	// so that a cancellation requested near the end is noticed sooner: the interval is a tenth of the time left, kept
+	// between MinHeartbeatInterval and HeartbeatInterval.  Work without a deadline heartbeats every HeartbeatInterval,
+	// which is also the default when AdaptiveHeartbeat is not set.
+	AdaptiveHeartbeat bool
+	// MinHeartbeatInterval is the shortest interval between heartbeats with AdaptiveHeartbeat.  If not set, default is 5
+	// sec
+	MinHeartbeatInterval time.Duration
+	// HeartbeatSplay staggers the heartbeats of work started at the same time, e.g. a batch of tasks pulled when a
+	// service starts, so they don't all hit the workflow API at the same instant every HeartbeatInterval: the first
+	// heartbeat of each piece of work is sent after a random offset up to HeartbeatSplay, the next ones every
+	// HeartbeatInterval from there.  Set it to HeartbeatInterval to spread the heartbeats across the whole interval.  By
+	// default the first heartbeat is sent one HeartbeatInterval after Do is called.  Not used with AdaptiveHeartbeat.
+	HeartbeatSplay time.Duration
 	// Time to wait for a cancellation before forcefully exiting.  If not set, default is 1 min
 	CancellationTimeout time.Duration
+	// ActivityTimeout is the start to close timeout of the activity.  When set, the context given to the WorkerFunc has a
+	// deadline of ActivityTimeout after Do is called so the work stops before the workflow API times out the activity.
+	ActivityTimeout time.Duration
+	// LogFlushInterval is how often lines logged through the ProgressReporter are sent to the workflow API.  If not set,
+	// default is 10 sec
+	LogFlushInterval time.Duration
+	// ReportPartialResults sends the result the WorkerFunc returns after the context was closed along with the
+	// cancellation (see workflow.Client.CompleteCancelledActivityWithResult), e.g. so resumable work can persist its
+	// progress.  By default that result is discarded.  A nil result is never sent.
+	ReportPartialResults bool
+	// MaxWorkRetries is how many times Do calls the WorkerFunc again after it returned a retryable error (see
+	// WorkRetryable) before reporting the failure.  The percent complete is reset to 0 before each retry.  By default the
+	// first error is reported.  The WorkerFunc reads the retries left with RetryBudgetFromContext.
+	MaxWorkRetries int
+	// WorkRetryable tells if the WorkerFunc should be called again after it returned err, e.g. for the errors of a
+	// flaky dependency.  If not set, every error is retryable when MaxWorkRetries is set.
+	WorkRetryable func(err error) bool
+	// MaxDetailsLength is how many bytes of details are sent with a failure or a cancellation.  Longer details, e.g. an
+	// error message holding a whole stack trace, are cut and end with "...(truncated)" so the workflow API does not
+	// reject the report.  If not set, default is 32KB.
+	MaxDetailsLength int
+	// Stop stops the work of Do gracefully when closed, e.g. when the process is shutting down: the context of the
+	// WorkerFunc is closed and once it returns (or after CancellationTimeout) the activity is abandoned with a final
+	// heartbeat (see workflow.Client.AbandonActivity) instead of being completed, so the workflow API reschedules it
+	// right away rather than after the heartbeat timeout.  What the WorkerFunc returns is discarded.  If not set, the work
+	// is only stopped by the context given to Do, which reports a cancellation.
+	Stop <-chan struct{}
+	// Metrics receives how long each WorkerFunc took and how it ended when set (see WorkDurationMetric and
+	// WorkTotalMetric).  The duration is measured from the first call of the WorkerFunc to its last return, including
+	// retries.  If not set, no metrics are emitted.
+	Metrics Metrics
 	// Logger is exposed so that users of this Worker can set their own logger.  If none is set, no logs will be written.
 	Logger log.Logger
 }
 
 // WorkerFunc is a function that can be passed into Worker.Do to do work.  It should
 // listen for context cancellations and stop/cleanup/exit accordingly.  The channel given to the function should be used to
-// report back percent complete as an integer (e.g. send 5 on the channel when operation is 5% complete).
+// report back percent complete as an integer (e.g. send 5 on the channel when operation is 5% complete), use
+// ReporterFromContext(ctx).Report instead to report the stage of the work along with it.  Lines of output can be attached
+// to the activity with ReporterFromContext(ctx).Log and the IDs of the activity are returned by FromContext(ctx).
+// Nothing receives from the channel once the function returned: goroutines it leaves behind must send in a select with
+// ctx.Done(), which is closed when Do returns, and what they send then is dropped.
 type WorkerFunc func(ctx context.Context, percentCompleteChan chan<- int) (result interface{}, err error)
 
 // Do executes the given function and reports back status and progress to the workflow API.  It takes
 // care of heartbeating at the interval given by Worker.HeartbeatInterval or defaults to 1 min.
-// If the given WorkflowFunc returns a non-nil error, then this will report a failure to the
-// API.  Otherwise it will return a success back to the API.  If a heartbeat
-// returns that a cancellation has been requested, then this function will handle closing
-// the parent context and reporting the cancellation back to the workflow.  WorkflowFunc should
-// listen for context closing and cleanup/exit accordingly.
+// If the given WorkflowFunc returns a non-nil error (and is not retried, see Worker.MaxWorkRetries), then this will report
+// a failure to the API (see models.ActivityErrorFromError for how wrapped errors are reported).  Otherwise it will
+// return a success back to the API.  If a heartbeat returns that a cancellation has been requested, then this function will handle closing
+// the parent context and reporting the cancellation back to the workflow, with the cancellation reason of the heartbeat
+// as reason when it has one.  WorkflowFunc should
+// listen for context closing and cleanup/exit accordingly.  The details sent with a failure or a cancellation end with
+// the last percent complete and line (see ProgressReporter.Log) the work reported, if any, to tell how far it got.
+//
+// If WorkflowFunc does not return within Worker.CancellationTimeout after the context is closed, the work is abandoned:
+// the cancellation is reported, a warning is logged, AbandonedWorkCount is incremented and Do returns.  Go has no way to
+// stop a goroutine from the outside, so the goroutine running WorkflowFunc lingers until WorkflowFunc returns (if ever),
+// still holding whatever resources it uses.  Make sure WorkflowFunc listens to the context to avoid that.
+//
+// When the workflow API retries an activity, pass the attempt number in ctx with workflow.ContextWithAttempt so the
+// progress updates and the completion carry it and a late report of a previous attempt cannot overwrite this one.
 func (w *Worker) Do(ctx context.Context, workflowID, activityID, taskToken string, f WorkerFunc) {
-	if w.Logger == nil {
-		w.Logger = log.New()
-		w.Logger.SetHandler(log.DiscardHandler())
+	workLog := w.workLog(workflowID, activityID)
+	w = w.withAttempt(ctx, workLog)
+	if heartbeatInterval := w.heartbeatInterval(); w.HeartbeatTimeout > 0 && heartbeatInterval >= w.HeartbeatTimeout {
+		workLog.Warn("Heartbeat interval is not shorter than the heartbeat timeout, the workflow API will fail the activity for missed heartbeats",
+			"heartbeatInterval", heartbeatInterval, "heartbeatTimeout", w.HeartbeatTimeout)
 	}
-	workLog := w.Logger.New("workflowID", workflowID, "activityID", activityID)
 	pc := make(chan int)
-	ec := make(chan error)
-	rc := make(chan interface{})
-	stop := make(chan struct{})
-	childCtx, cancelFunc := context.WithCancel(ctx)
+	// work signals on reset that a retry starts over from 0
+	reset := make(chan struct{})
+	// buffered so that work that returns after being abandoned doesn't block forever
+	ec := make(chan error, 1)
+	rc := make(chan interface{}, 1)
+	abandoned := make(chan struct{})
+	// true abandons the activity after heartbeating stops
+	stop := make(chan bool)
+	heartbeatStopped := make(chan struct{})
+	var childCtx context.Context
+	var cancelFunc context.CancelFunc
+	if w.ActivityTimeout > 0 {
+		workLog.Debug("Bounding work by the activity timeout", "activityTimeout", w.ActivityTimeout)
+		childCtx, cancelFunc = context.WithTimeout(ctx, w.ActivityTimeout)
+	} else {
+		childCtx, cancelFunc = context.WithCancel(ctx)
+	}
+	defer cancelFunc()
+	reporter := newProgressReporter(w.WorkflowClient, workflowID, activityID, taskToken, workLog)
+	// sends remaining lines when the work is abandoned
+	defer reporter.close()
+	childCtx = withActivityInfo(context.WithValue(childCtx, reporterKey{}, reporter), workflowID, activityID, taskToken)
 
-	go w.heartbeat(workLog, taskToken, activityID, cancelFunc, stop)
-	go w.updatePercentComplete(workflowID, activityID, workLog, pc)
+	go func() {
+		w.heartbeat(childCtx, workLog, taskToken, activityID, cancelFunc, stop)
+		close(heartbeatStopped)
+	}()
+	stopProgress := make(chan struct{})
+	progressStopped := make(chan struct{})
+	// workReturned is closed once the WorkerFunc returned, after which Do itself sends nothing on pc or reset
+	workReturned := make(chan struct{})
+	go func() {
+		w.updatePercentComplete(workflowID, activityID, workLog, reporter, -1, pc, reset, stopProgress)
+		close(progressStopped)
+		// abandoned work may still send until it returns, drop its updates so it doesn't block forever
+		for {
+			select {
+			case percentComplete := <-pc:
+				workLog.Debug("Dropping percent complete update sent after the progress was stopped", "percentComplete", percentComplete)
+			case <-reset:
+				workLog.Debug("Dropping percent complete reset sent after the progress was stopped")
+			case <-workReturned:
+				return
+			}
+		}
+	}()
+	var stopProgressOnce sync.Once
+	// finishProgress waits for the update in flight, if any, so that no update is sent after the completion
+	finishProgress := func() {
+		stopProgressOnce.Do(func() { close(stopProgress) })
+		<-progressStopped
+	}
+	go reporter.run(w.logFlushInterval())
 
 	go func() {
-		result, err := f(childCtx, pc)
+		start := time.Now()
+		result, err := w.work(childCtx, workLog, f, pc, reset)
+		close(workReturned)
+		w.emitWorkMetrics(childCtx, workLog, start, err)
+		finishProgress()
+		// logs are sent before the completion so they are attached to the activity while it is still open
+		reporter.close()
+		select {
+		case <-abandoned:
+			workLog.Warn("Abandoned work returned after the cancellation timeout", "error", err)
+			return
+		default:
+		}
 		if err != nil {
 			// work has failed
 			ec <- err
@@ -67,11 +228,23 @@ func (w *Worker) Do(ctx context.Context, workflowID, activityID, taskToken strin
 
 	select {
 	case <-childCtx.Done():
-		w.handleCancellation(workflowID, activityID, workLog, ec, rc)
+		reason := reporter.cancellationReason()
+		if childCtx.Err() == context.DeadlineExceeded {
+			workLog.Info("Activity timeout exceeded")
+			reason = activityTimeoutReason
+		}
+		w.handleCancellation(workflowID, activityID, reason, workLog, reporter, ec, rc, abandoned, finishProgress)
+	case <-w.Stop:
+		w.handleStop(workLog, cancelFunc, ec, rc, abandoned, finishProgress)
+		// Stop heartbeating and abandon the activity
+		stop <- true
+		<-heartbeatStopped
+		return
 	case err := <-ec:
 		// Work has failed
 		workLog.Info("Sending failure message to workflow API", "error", err)
-		_, err = w.WorkflowClient.CompleteFailedActivity(workflowID, activityID, err.Error(), "")
+		activityError := models.ActivityErrorFromError(err)
+		_, err = w.WorkflowClient.CompleteFailedActivity(workflowID, activityID, *activityError.Reason, w.details(reporter, activityError.Details))
 		if err != nil {
 			workLog.Error("Problem sending failure message", "error", err)
 		}
@@ -84,73 +257,342 @@ func (w *Worker) Do(ctx context.Context, workflowID, activityID, taskToken strin
 		}
 	}
 	// Stop heartbeating
-	stop <- struct{}{}
+	stop <- false
 }
 
-func (w *Worker) heartbeat(workLog log.Logger, taskToken, activityID string, cancelFunc context.CancelFunc, stop <-chan struct{}) {
-	heartbeatInterval := defaultHeartbeatInterval
-	if w.HeartbeatInterval > 0 {
-		heartbeatInterval = w.HeartbeatInterval
+// work calls f, calling it again after a retryable error up to MaxWorkRetries times while ctx is open.  Each call gets
+// the retry budget left in its context, see RetryBudgetFromContext.  Before a retry the percent complete is reset through
+// reset.
+func (w *Worker) work(ctx context.Context, workLog log.Logger, f WorkerFunc, pc chan<- int, reset chan<- struct{}) (interface{}, error) {
+	for retry := 1; ; retry++ {
+		remaining := w.MaxWorkRetries - retry + 1
+		if remaining < 0 {
+			remaining = 0
+		}
+		result, err := f(withRetryBudget(ctx, retry, remaining), pc)
+		if err == nil || retry > w.MaxWorkRetries || ctx.Err() != nil || (w.WorkRetryable != nil && !w.WorkRetryable(err)) {
+			return result, err
+		}
+		workLog.Warn("Work failed, retrying", "error", err, "retry", retry, "maxWorkRetries", w.MaxWorkRetries)
+		// the retry starts over
+		reset <- struct{}{}
+	}
+}
+
+// heartbeat sends heartbeats with ctx so that cancelling the work aborts a heartbeat in flight.  It returns once a value
+// is received on stop, abandoning the activity first if the value is true.
+func (w *Worker) heartbeat(ctx context.Context, workLog log.Logger, taskToken, activityID string, cancelFunc context.CancelFunc, stop <-chan bool) {
+	heartbeatClient := w.WorkflowClient.WithContext(ctx)
+	if heartbeatClient == nil {
+		// fakes of workflow.Client return nil unless told otherwise
+		heartbeatClient = w.WorkflowClient
+	}
+	reporter := ReporterFromContext(ctx)
+	ticks := w.HeartbeatTicks
+	if deadline, ok := ctx.Deadline(); ticks == nil && ok && w.AdaptiveHeartbeat {
+		done := make(chan struct{})
+		defer close(done)
+		ticks = timedHeartbeatTicks(func() time.Duration { return w.adaptiveHeartbeatInterval(deadline, time.Now()) }, done)
+	}
+	if ticks == nil && w.HeartbeatSplay > 0 {
+		done := make(chan struct{})
+		defer close(done)
+		ticks = timedHeartbeatTicks(w.splayedHeartbeatIntervals(), done)
+	}
+	if ticks == nil {
+		heartbeats := time.NewTicker(w.heartbeatInterval())
+		defer heartbeats.Stop()
+		ticks = heartbeats.C
 	}
-	heartbeats := time.NewTicker(heartbeatInterval)
-	defer heartbeats.Stop()
 	for {
 		select {
-		case <-heartbeats.C:
+		case <-ticks:
+			if ctx.Err() != nil {
+				workLog.Debug("Not sending heartbeat because the work is being cancelled")
+				continue
+			}
+			if reporter.heartbeatsPaused(time.Now()) {
+				workLog.Debug("Not sending heartbeat because the heartbeat timeout is extended")
+				continue
+			}
 			workLog.Debug("Sending heartbeat")
-			details := fmt.Sprintf("Heartbeat for activity %v", activityID)
-			hb, err := w.WorkflowClient.HeartbeatActivityWithToken(taskToken, activityID, details)
+			details := w.heartbeatDetails(activityID)
+			hb, err := heartbeatClient.HeartbeatActivityWithToken(taskToken, activityID, details)
 			if err != nil {
 				workLog.Error("Problem sending heartbeat", "error", err, "taskToken", taskToken)
 			}
 			if hb != nil && hb.Cancelled {
-				workLog.Info("Cancellation requested via heartbeat")
+				workLog.Info("Cancellation requested via heartbeat", "cancellationReason", hb.CancellationReason)
+				// kept before closing the context so it is there when the cancellation is reported
+				reporter.setCancellationReason(hb.CancellationReason)
 				cancelFunc()
 			}
-		case <-stop:
+		case abandon := <-stop:
+			if abandon {
+				// not sent with ctx, which is closed by now
+				workLog.Info("Abandoning activity")
+				if err := w.WorkflowClient.AbandonActivity(taskToken, activityID, stoppedMessage); err != nil {
+					workLog.Error("Problem abandoning activity", "error", err, "taskToken", taskToken)
+				}
+			}
 			return
 		}
 	}
 
 }
 
-func (w *Worker) updatePercentComplete(workflowID, activityID string, workLog log.Logger, pc <-chan int) {
-	lastPercentComplete := -1
-	for percentComplete := range pc {
-		if percentComplete != lastPercentComplete {
-			workLog.Info("Sending percent complete update", "percentComplete", percentComplete)
-			_, err := w.WorkflowClient.UpdateActivityPercentComplete(workflowID, activityID, percentComplete)
-			if err != nil {
-				workLog.Error("Problem updating percent complete", "error", err, "percentComplete", percentComplete)
+// timedHeartbeatTicks sends a tick after every interval returned by next until done is closed
+func timedHeartbeatTicks(next func() time.Duration, done <-chan struct{}) <-chan time.Time {
+	ticks := make(chan time.Time)
+	go func() {
+		for {
+			timer := time.NewTimer(next())
+			select {
+			case tick := <-timer.C:
+				select {
+				case ticks <- tick:
+				case <-done:
+					return
+				}
+			case <-done:
+				timer.Stop()
+				return
+			}
+		}
+	}()
+	return ticks
+}
+
+// splayedHeartbeatIntervals returns the intervals between the heartbeats of one piece of work with HeartbeatSplay: a
+// random offset up to HeartbeatSplay before the first heartbeat, then HeartbeatInterval
+func (w *Worker) splayedHeartbeatIntervals() func() time.Duration {
+	first := true
+	return func() time.Duration {
+		if !first {
+			return w.heartbeatInterval()
+		}
+		first = false
+		return time.Duration(splayRandInt63n(int64(w.HeartbeatSplay)))
+	}
+}
+
+// adaptiveHeartbeatInterval returns the interval before the next heartbeat at now: a tenth of the time left before the
+// deadline, kept between MinHeartbeatInterval and HeartbeatInterval
+func (w *Worker) adaptiveHeartbeatInterval(deadline, now time.Time) time.Duration {
+	max := w.heartbeatInterval()
+	min := defaultMinHeartbeatInterval
+	if w.MinHeartbeatInterval > 0 {
+		min = w.MinHeartbeatInterval
+	}
+	if min > max {
+		min = max
+	}
+	interval := deadline.Sub(now) / adaptiveHeartbeatDivisor
+	if interval < min {
+		return min
+	}
+	if interval > max {
+		return max
+	}
+	return interval
+}
+
+// workLog returns the logger used for one activity, setting a discarding Worker.Logger if none was set
+func (w *Worker) workLog(workflowID, activityID string) log.Logger {
+	if w.Logger == nil {
+		w.Logger = log.New()
+		w.Logger.SetHandler(log.DiscardHandler())
+	}
+	return w.Logger.New("workflowID", workflowID, "activityID", activityID)
+}
+
+// withAttempt returns a copy of the Worker whose WorkflowClient reports the attempt number held by ctx, if any.  The
+// client is bound to a detached copy of ctx, keeping e.g. its token, so that the completion is still sent after ctx is
+// closed.
+func (w *Worker) withAttempt(ctx context.Context, workLog log.Logger) *Worker {
+	attempt, ok := workflow.AttemptFromContext(ctx)
+	if !ok {
+		return w
+	}
+	client := w.WorkflowClient.WithContext(workflow.DetachContext(ctx))
+	// fakes may not be set up to return a client
+	if client == nil {
+		return w
+	}
+	workLog.Debug("Reporting attempt", "attempt", attempt)
+	worker := *w
+	worker.WorkflowClient = client
+	return &worker
+}
+
+func (w *Worker) logFlushInterval() time.Duration {
+	if w.LogFlushInterval > 0 {
+		return w.LogFlushInterval
+	}
+	return defaultLogFlushInterval
+}
+
+// details returns the details sent with a failure or a cancellation: details followed by the last progress reported to
+// reporter, truncated to MaxDetailsLength
+func (w *Worker) details(reporter *ProgressReporter, details string) string {
+	max := defaultMaxDetailsLength
+	if w.MaxDetailsLength > 0 {
+		max = w.MaxDetailsLength
+	}
+	progress := reporter.lastProgress()
+	if len(progress) > max/2 {
+		// an unusually long last line, keep as much of the details as possible instead
+		return truncateDetails(details+progress, max)
+	}
+	// the last progress is kept whole, the details are cut to make room for it
+	return truncateDetails(details, max-len(progress)) + progress
+}
+
+// truncateDetails cuts details to at most max bytes, ending them with truncatedSuffix when cut.  Multi-byte characters
+// are never split.
+func truncateDetails(details string, max int) string {
+	if len(details) <= max {
+		return details
+	}
+	cut := max - len(truncatedSuffix)
+	if cut < 0 {
+		cut = 0
+	}
+	for cut > 0 && !utf8.RuneStart(details[cut]) {
+		cut--
+	}
+	return details[:cut] + truncatedSuffix
+}
+
+// completeCancelled reports the cancellation of work that returned result, sending result as a partial result if
+// ReportPartialResults is set
+func (w *Worker) completeCancelled(workflowID, activityID, reason, details string, result interface{}) (*models.Activity, error) {
+	if w.ReportPartialResults && result != nil {
+		return w.WorkflowClient.CompleteCancelledActivityWithResult(workflowID, activityID, reason, details, result)
+	}
+	return w.WorkflowClient.CompleteCancelledActivity(workflowID, activityID, reason, details)
+}
+
+func (w *Worker) heartbeatDetails(activityID string) string {
+	if w.HeartbeatDetailsFunc != nil {
+		return w.HeartbeatDetailsFunc()
+	}
+	return fmt.Sprintf("Heartbeat for activity %v", activityID)
+}
+
+func (w *Worker) heartbeatInterval() time.Duration {
+	if w.HeartbeatInterval > 0 {
+		return w.HeartbeatInterval
+	}
+	return defaultHeartbeatInterval
+}
+
+// updatePercentComplete sends the values received on pc and given to reporter.Report, and a percent complete of 0 for
+// every signal on reset, until pc is closed or stop is closed, recording them in reporter.  lastPercentComplete is the
+// percent complete the workflow API already has, -1 if not known.
+func (w *Worker) updatePercentComplete(workflowID, activityID string, workLog log.Logger, reporter *ProgressReporter, lastPercentComplete int, pc <-chan int, reset <-chan struct{}, stop <-chan struct{}) {
+	last := Progress{PercentComplete: lastPercentComplete}
+	for {
+		var progress Progress
+		isReset := false
+		select {
+		case percentComplete, ok := <-pc:
+			if !ok {
+				return
 			}
-			lastPercentComplete = percentComplete
+			// a percent complete alone is a Progress without stage
+			progress = Progress{PercentComplete: percentComplete}
+		case progress = <-reporter.progress:
+		case <-reset:
+			isReset = true
+		case <-stop:
+			return
+		}
+		reporter.recordPercentComplete(progress.PercentComplete)
+		if progress == last {
+			workLog.Debug("Not sending percent complete update because it is the same as last update", "percentComplete", progress.PercentComplete)
+			continue
+		}
+		workLog.Info("Sending percent complete update", "percentComplete", progress.PercentComplete, "stage", progress.Stage)
+		var err error
+		if isReset {
+			// UpdateActivityPercentComplete leaves a percent complete of 0 out of the request
+			_, err = w.WorkflowClient.PatchActivity(workflowID, activityID, map[string]interface{}{"percentComplete": 0})
 		} else {
-			workLog.Debug("Not sending percent complete update because it is the same as last update", "percentComplete", percentComplete)
+			err = w.sendProgress(workflowID, activityID, progress)
 		}
+		if err != nil {
+			workLog.Error("Problem updating percent complete", "error", err, "percentComplete", progress.PercentComplete)
+		}
+		last = progress
 	}
 }
 
-func (w *Worker) handleCancellation(workflowID, activityID string, workLog log.Logger, ec <-chan error, rc <-chan interface{}) {
-	workLog.Debug("Child context has been closed")
-	cancellationTimeout := defaultCancellationTimeout
-	if w.CancellationTimeout > 0 {
-		cancellationTimeout = w.CancellationTimeout
+// sendProgress sends progress to the workflow API.  A percent complete alone is sent with UpdateActivityPercentComplete,
+// the stage and message need UpdateActivity.
+func (w *Worker) sendProgress(workflowID, activityID string, progress Progress) error {
+	if progress.Stage == "" && progress.Message == "" {
+		_, err := w.WorkflowClient.UpdateActivityPercentComplete(workflowID, activityID, progress.PercentComplete)
+		return err
 	}
+	_, err := w.WorkflowClient.UpdateActivity(workflowID, &models.Activity{
+		ID:              swag.String(activityID),
+		Status:          swag.String(models.ActivityStatusRunning),
+		PercentComplete: int32(progress.PercentComplete),
+		Stage:           progress.Stage,
+		ProgressMessage: progress.Message,
+	})
+	return err
+}
+
+func (w *Worker) handleCancellation(workflowID, activityID, reason string, workLog log.Logger, reporter *ProgressReporter, ec <-chan error, rc <-chan interface{}, abandoned chan<- struct{}, finishProgress func()) {
+	workLog.Debug("Child context has been closed")
+	cancellationTimeout := w.cancellationTimeout()
 	select {
 	case err := <-ec: // work completed with an error
-		_, err = w.WorkflowClient.CompleteCancelledActivity(workflowID, activityID, cancelledReason, err.Error())
+		_, err = w.WorkflowClient.CompleteCancelledActivity(workflowID, activityID, reason, w.details(reporter, err.Error()))
 		if err != nil {
 			workLog.Error("Problem sending completed via cancellation message", "error", err)
 		}
-	case <-rc: // work completed
-		_, err := w.WorkflowClient.CompleteCancelledActivity(workflowID, activityID, cancelledReason, completedMessage)
+	case result := <-rc: // work completed
+		_, err := w.completeCancelled(workflowID, activityID, reason, w.details(reporter, completedMessage), result)
 		if err != nil {
 			workLog.Error("Problem sending completed via cancellation message", "error", err)
 		}
 	case <-time.After(cancellationTimeout): // Cancellation timed out
-		_, err := w.WorkflowClient.CompleteCancelledActivity(workflowID, activityID, cancelledReason, timeoutErrorMessage)
+		close(abandoned)
+		finishProgress()
+		atomic.AddInt64(&abandonedWork, 1)
+		workLog.Warn("Work did not stop within the cancellation timeout, abandoning it", "cancellationTimeout", cancellationTimeout)
+		_, err := w.WorkflowClient.CompleteCancelledActivity(workflowID, activityID, reason, w.details(reporter, timeoutErrorMessage))
 		if err != nil {
 			workLog.Error("Problem sending completed via cancellation message", "error", err)
 		}
 	}
 }
+
+// handleStop closes the context of the work and waits for the work to return, up to the cancellation timeout.  What the
+// work returns is discarded since the activity is abandoned rather than completed.
+func (w *Worker) handleStop(workLog log.Logger, cancelFunc context.CancelFunc, ec <-chan error, rc <-chan interface{}, abandoned chan<- struct{}, finishProgress func()) {
+	workLog.Info("Stopping work")
+	cancelFunc()
+	cancellationTimeout := w.cancellationTimeout()
+	select {
+	case err := <-ec:
+		workLog.Debug("Work stopped", "error", err)
+	case <-rc:
+		workLog.Debug("Work stopped")
+	case <-time.After(cancellationTimeout):
+		close(abandoned)
+		finishProgress()
+		atomic.AddInt64(&abandonedWork, 1)
+		workLog.Warn("Work did not stop within the cancellation timeout, abandoning it", "cancellationTimeout", cancellationTimeout)
+	}
+}
+
+func (w *Worker) cancellationTimeout() time.Duration {
+	if w.CancellationTimeout > 0 {
+		return w.CancellationTimeout
+	}
+	return defaultCancellationTimeout
+}
@@ -2,11 +2,20 @@ package activity
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/3dsim/auth0/auth0fakes"
 	"github.com/3dsim/workflow-goclient/models"
+	"github.com/3dsim/workflow-goclient/workflow"
 	"github.com/3dsim/workflow-goclient/workflow/workflowfakes"
 	"github.com/go-openapi/swag"
 	log "github.com/inconshreveable/log15"
@@ -43,6 +52,63 @@ func TestDoExpectsCompleteFailedActivityCalledWhenErrorOccurs(t *testing.T) {
 	assert.Equal(t, "", actualErrorDetails, "Expected error details passed to CompleteFailedActivity")
 }
 
+func TestDoWhenWrappedErrorReturnedExpectsCauseSentAsDetails(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+		return nil, &wrappedError{message: "running solver", cause: errors.New("part.stl not found")}
+	})
+
+	// assert
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteFailedActivityCallCount(), "Expected to call CompleteFailedActivity once")
+	_, _, actualErrorReason, actualErrorDetails := fakeWorkflowClient.CompleteFailedActivityArgsForCall(0)
+	assert.Equal(t, "running solver", actualErrorReason, "Expected the message of the outermost error as reason")
+	assert.Contains(t, actualErrorDetails, "part.stl not found", "Expected the cause in the details")
+}
+
+func TestDoWhenErrorMessageEnormousExpectsDetailsTruncatedToLimit(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, MaxDetailsLength: 1000, Logger: logger}
+	stackTrace := strings.Repeat("goroutine 1 [running]:\nmain.main()\n", 100000)
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+		return nil, &wrappedError{message: "running solver", cause: errors.New(stackTrace)}
+	})
+
+	// assert
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteFailedActivityCallCount(), "Expected to call CompleteFailedActivity once")
+	_, _, _, actualErrorDetails := fakeWorkflowClient.CompleteFailedActivityArgsForCall(0)
+	assert.Len(t, actualErrorDetails, 1000, "Expected the details to be truncated to the limit")
+	assert.True(t, strings.HasSuffix(actualErrorDetails, "...(truncated)"), "Expected the details to end with the truncation suffix")
+}
+
+func TestTruncateDetails(t *testing.T) {
+	// arrange
+	details := "héllo wörld"
+
+	// act
+	short, cut, multiByte := truncateDetails(details, 100), truncateDetails("0123456789abcdefghijklmnopqrstuvwxyz", 20), truncateDetails(details+details, 16)
+
+	// assert
+	assert.Equal(t, details, short, "Expected details under the limit to be kept")
+	assert.Equal(t, "012345...(truncated)", cut, "Expected the details cut to the limit with the suffix")
+	assert.Equal(t, "h...(truncated)", multiByte, "Expected multi-byte characters to not be split")
+}
+
+// wrappedError wraps a cause the way fmt.Errorf("%v: %w") does
+type wrappedError struct {
+	message string
+	cause   error
+}
+
+func (e *wrappedError) Error() string { return e.message + ": " + e.cause.Error() }
+func (e *wrappedError) Unwrap() error { return e.cause }
+
 func TestDoExpectsCompleteSuccessfulActivityCalledWhenNoErrorOccurs(t *testing.T) {
 	// arrange
 	fakeWorkflowClient := &workflowfakes.FakeClient{}
@@ -65,18 +131,263 @@ func TestDoExpectsCompleteSuccessfulActivityCalledWhenNoErrorOccurs(t *testing.T
 	assert.Equal(t, result, actualResult, "Expected result passed to CompleteSuccessfulActivity")
 }
 
+func TestDoExpectsActivityAbandonedWhenStopped(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	stop := make(chan struct{})
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Stop: stop, Logger: logger}
+	activityID := "activity id"
+	taskToken := "token"
+
+	// act
+	worker.Do(context.Background(), "workflow id", activityID, taskToken, func(ctx context.Context, _ chan<- int) (interface{}, error) {
+		close(stop)
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	// assert
+	if assert.Equal(t, 1, fakeWorkflowClient.AbandonActivityCallCount(), "Expected the activity to be abandoned once") {
+		actualTaskToken, actualActivityID, actualDetails := fakeWorkflowClient.AbandonActivityArgsForCall(0)
+		assert.Equal(t, taskToken, actualTaskToken, "Expected task token passed to AbandonActivity")
+		assert.Equal(t, activityID, actualActivityID, "Expected activity ID passed to AbandonActivity")
+		assert.Equal(t, stoppedMessage, actualDetails, "Expected details passed to AbandonActivity")
+	}
+	assert.Equal(t, 0, fakeWorkflowClient.CompleteCancelledActivityCallCount(), "Expected no cancellation to be reported")
+	assert.Equal(t, 0, fakeWorkflowClient.CompleteFailedActivityCallCount(), "Expected no failure to be reported")
+}
+
+func TestDoExpectsCompletionSentWithAttemptWhenContextHasAttempt(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	attemptWorkflowClient := &workflowfakes.FakeClient{}
+	fakeWorkflowClient.WithContextReturns(attemptWorkflowClient)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+	ctx := workflow.ContextWithAttempt(context.Background(), 3)
+
+	// act
+	worker.Do(ctx, "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+		return "the result", nil
+	})
+
+	// assert
+	assert.Equal(t, 0, fakeWorkflowClient.CompleteSuccessfulActivityCallCount(), "Expected no completion without the attempt")
+	assert.Equal(t, 1, attemptWorkflowClient.CompleteSuccessfulActivityCallCount(), "Expected the completion sent by the client bound to the attempt")
+	if assert.True(t, fakeWorkflowClient.WithContextCallCount() > 0, "Expected the client bound to a context") {
+		attempt, ok := workflow.AttemptFromContext(fakeWorkflowClient.WithContextArgsForCall(0))
+		assert.True(t, ok, "Expected the context to hold the attempt")
+		assert.EqualValues(t, 3, attempt, "Expected the attempt of the work")
+	}
+}
+
+func TestDoWhenContextHasTokenAndAttemptExpectsCompletionSentWithBoth(t *testing.T) {
+	// arrange
+	var mu sync.Mutex
+	var authorization string
+	var completion models.Activity
+	mux := http.NewServeMux()
+	mux.HandleFunc("/workflow-api/workflows/", func(w http.ResponseWriter, r *http.Request) {
+		var activity models.Activity
+		if err := json.NewDecoder(r.Body).Decode(&activity); err != nil {
+			t.Error(err)
+		}
+		if swag.StringValue(activity.Status) == models.ActivityStatusCompleted {
+			mu.Lock()
+			authorization = r.Header.Get("Authorization")
+			completion = activity
+			mu.Unlock()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"activity id","status":"Completed"}`))
+	})
+	testServer := httptest.NewServer(mux)
+	defer testServer.Close()
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("fetched token", nil)
+	client := workflow.NewClient(fakeTokenFetcher, testServer.URL, "workflow-api", "audience", logger)
+	worker := &Worker{WorkflowClient: client, Logger: logger}
+	ctx := workflow.ContextWithAttempt(workflow.ContextWithToken(context.Background(), "forwarded token"), 3)
+
+	// act
+	worker.Do(ctx, "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+		return "the result", nil
+	})
+
+	// assert
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, "Bearer forwarded token", authorization, "Expected the completion sent with the token of the context")
+	assert.EqualValues(t, 3, completion.Attempt, "Expected the completion sent with the attempt of the context")
+	assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no token fetched")
+}
+
+func TestDoWhenWorkFailsOnceWithMaxWorkRetriesExpectsSuccessReported(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, MaxWorkRetries: 2, Logger: logger}
+	calls := 0
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(_ context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		calls++
+		percentCompleteChan <- 50
+		if calls == 1 {
+			return nil, errors.New("Dependency unavailable")
+		}
+		return "the result", nil
+	})
+
+	// assert
+	assert.Equal(t, 2, calls, "Expected the work to be retried once")
+	assert.Equal(t, 0, fakeWorkflowClient.CompleteFailedActivityCallCount(), "Expected no failure to be reported")
+	if assert.Equal(t, 1, fakeWorkflowClient.CompleteSuccessfulActivityCallCount(), "Expected to call CompleteSuccessfulActivity once") {
+		_, _, actualResult := fakeWorkflowClient.CompleteSuccessfulActivityArgsForCall(0)
+		assert.Equal(t, "the result", actualResult, "Expected the result of the retry")
+	}
+	var sentPercentCompletes []int
+	for i := 0; i < fakeWorkflowClient.UpdateActivityPercentCompleteCallCount(); i++ {
+		_, _, percentComplete := fakeWorkflowClient.UpdateActivityPercentCompleteArgsForCall(i)
+		sentPercentCompletes = append(sentPercentCompletes, percentComplete)
+	}
+	assert.Equal(t, []int{50, 50}, sentPercentCompletes, "Expected the progress of both calls")
+	if assert.Equal(t, 1, fakeWorkflowClient.PatchActivityCallCount(), "Expected the reset to be patched") {
+		_, _, fields := fakeWorkflowClient.PatchActivityArgsForCall(0)
+		assert.Equal(t, map[string]interface{}{"percentComplete": 0}, fields, "Expected the progress to start over for the retry")
+	}
+}
+
+func TestDoWhenWorkSendsZeroPercentCompleteExpectsUpdateNotPatch(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(_ context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		percentCompleteChan <- 0
+		return "the result", nil
+	})
+
+	// assert
+	if assert.Equal(t, 1, fakeWorkflowClient.UpdateActivityPercentCompleteCallCount(), "Expected the percent complete to be updated") {
+		_, _, percentComplete := fakeWorkflowClient.UpdateActivityPercentCompleteArgsForCall(0)
+		assert.Equal(t, 0, percentComplete, "Expected the percent complete sent by the work")
+	}
+	assert.Equal(t, 0, fakeWorkflowClient.PatchActivityCallCount(), "Expected no patch without a retry")
+}
+
+func TestDoWhenWorkRetriedExpectsPercentCompleteResetSentToServer(t *testing.T) {
+	// arrange
+	var mu sync.Mutex
+	// sentPercentCompletes holds the percent complete of every progress update received, -1 when the body had none
+	var sentPercentCompletes []float64
+	mux := http.NewServeMux()
+	mux.HandleFunc("/workflow-api/workflows/", func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Error(err)
+		}
+		if status, _ := body["status"].(string); status == "" || status == models.ActivityStatusRunning {
+			percentComplete, ok := body["percentComplete"].(float64)
+			if !ok {
+				percentComplete = -1
+			}
+			mu.Lock()
+			sentPercentCompletes = append(sentPercentCompletes, percentComplete)
+			mu.Unlock()
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"activity id","status":"Running"}`))
+	})
+	testServer := httptest.NewServer(mux)
+	defer testServer.Close()
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("token", nil)
+	client := workflow.NewClient(fakeTokenFetcher, testServer.URL, "workflow-api", "audience", logger)
+	worker := &Worker{WorkflowClient: client, MaxWorkRetries: 1, Logger: logger}
+	calls := 0
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(_ context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		calls++
+		percentCompleteChan <- 50
+		if calls == 1 {
+			return nil, errors.New("Dependency unavailable")
+		}
+		return nil, nil
+	})
+
+	// assert
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, []float64{50, 0, 50}, sentPercentCompletes, "Expected the reset to 0 in the body of the request")
+}
+
+func TestDoWhenWorkErrorNotRetryableExpectsFailureReportedWithoutRetry(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	errInvalidInput := errors.New("Invalid input")
+	worker := &Worker{
+		WorkflowClient: fakeWorkflowClient,
+		MaxWorkRetries: 2,
+		WorkRetryable:  func(err error) bool { return err != errInvalidInput },
+		Logger:         logger,
+	}
+	calls := 0
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+		calls++
+		return nil, errInvalidInput
+	})
+
+	// assert
+	assert.Equal(t, 1, calls, "Expected the work to not be retried")
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteFailedActivityCallCount(), "Expected to call CompleteFailedActivity once")
+}
+
+func TestDoWhenWorkRetriedExpectsRetryBudgetDecreasingInContext(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, MaxWorkRetries: 2, ActivityTimeout: time.Minute, Logger: logger}
+	var budgets []RetryBudget
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		budget, ok := RetryBudgetFromContext(ctx)
+		if !ok {
+			t.Fatal("Expected the retry budget in the context")
+		}
+		budgets = append(budgets, budget)
+		time.Sleep(10 * time.Millisecond)
+		return nil, errors.New("Dependency unavailable")
+	})
+
+	// assert
+	if assert.Len(t, budgets, 3, "Expected the work to be called once and retried twice") {
+		for i, budget := range budgets {
+			assert.Equal(t, i+1, budget.Attempt, "Expected the number of the call")
+			assert.Equal(t, 2-i, budget.RemainingRetries, "Expected the retries left to decrease")
+			assert.True(t, budget.HasDeadline, "Expected the deadline of the activity timeout")
+			assert.True(t, budget.RemainingTime > 0 && budget.RemainingTime <= time.Minute, "Expected the time left before the activity timeout but got %v", budget.RemainingTime)
+		}
+		assert.True(t, budgets[2].RemainingTime < budgets[0].RemainingTime, "Expected the time left to decrease")
+	}
+	_, ok := RetryBudgetFromContext(context.Background())
+	assert.False(t, ok, "Expected no retry budget outside of the work")
+}
+
 func TestDoExpectsHeartbeatActivityWithTokenCalled(t *testing.T) {
 	// arrange
 	fakeWorkflowClient := &workflowfakes.FakeClient{}
-	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatInterval: 7 * time.Millisecond, Logger: logger}
+	ticks := make(chan time.Time)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatTicks: ticks, Logger: logger}
 	activityID := "activity id"
 	workflowID := "workflow id"
 	taskToken := "token"
 
 	// act
 	worker.Do(context.Background(), workflowID, activityID, taskToken, func(context.Context, chan<- int) (interface{}, error) {
-		// Wait a little time for heartbeat
-		time.Sleep(10 * time.Millisecond)
+		ticks <- time.Now()
 		return nil, nil
 	})
 
@@ -88,15 +399,276 @@ func TestDoExpectsHeartbeatActivityWithTokenCalled(t *testing.T) {
 	assert.NotEmpty(t, taskToken, actualDetails, "Expected details passed to HeartbeatActivityWithToken to not be empty")
 }
 
+func TestDoWhenHeartbeatTimeoutExtendedExpectsHeartbeatsPausedUntilExtensionEnds(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	ticks := make(chan time.Time)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatTicks: ticks, Logger: logger}
+	extension := 100 * time.Millisecond
+	var heartbeatsWhilePaused int
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		if err := ReporterFromContext(ctx).ExtendHeartbeatTimeout(extension); err != nil {
+			return nil, err
+		}
+		ticks <- time.Now()
+		heartbeatsWhilePaused = fakeWorkflowClient.HeartbeatActivityWithTokenCallCount()
+		time.Sleep(extension + 50*time.Millisecond)
+		ticks <- time.Now()
+		for fakeWorkflowClient.HeartbeatActivityWithTokenCallCount() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		return nil, nil
+	})
+
+	// assert
+	if assert.Equal(t, 1, fakeWorkflowClient.ExtendHeartbeatTimeoutCallCount(), "Expected to call ExtendHeartbeatTimeout once") {
+		actualTaskToken, actualActivityID, actualExtension := fakeWorkflowClient.ExtendHeartbeatTimeoutArgsForCall(0)
+		assert.Equal(t, "token", actualTaskToken, "Expected task token passed to ExtendHeartbeatTimeout")
+		assert.Equal(t, "activity id", actualActivityID, "Expected activity ID passed to ExtendHeartbeatTimeout")
+		assert.Equal(t, extension, actualExtension, "Expected extension passed to ExtendHeartbeatTimeout")
+	}
+	assert.Equal(t, 0, heartbeatsWhilePaused, "Expected no heartbeat while the heartbeat timeout is extended")
+	assert.Equal(t, 1, fakeWorkflowClient.HeartbeatActivityWithTokenCallCount(), "Expected heartbeats to resume after the extension")
+}
+
+func TestDoWhenHeartbeatCancelsWithReasonExpectsReasonReported(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	fakeWorkflowClient.HeartbeatActivityWithTokenReturns(&models.Heartbeat{Cancelled: true, CancellationReason: "Superseded by workflow sim-201"}, nil)
+	ticks := make(chan time.Time)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatTicks: ticks, Logger: logger}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		ticks <- time.Now()
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	// assert
+	if assert.Equal(t, 1, fakeWorkflowClient.CompleteCancelledActivityCallCount(), "Expected to call CompleteCancelledActivity once") {
+		_, _, actualReason, _ := fakeWorkflowClient.CompleteCancelledActivityArgsForCall(0)
+		assert.Equal(t, "Superseded by workflow sim-201", actualReason, "Expected the reason given by the heartbeat")
+	}
+}
+
+func TestDoWhenHeartbeatCancelsWithoutReasonExpectsGenericReasonReported(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	fakeWorkflowClient.HeartbeatActivityWithTokenReturns(&models.Heartbeat{Cancelled: true}, nil)
+	ticks := make(chan time.Time)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatTicks: ticks, Logger: logger}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		ticks <- time.Now()
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	// assert
+	if assert.Equal(t, 1, fakeWorkflowClient.CompleteCancelledActivityCallCount(), "Expected to call CompleteCancelledActivity once") {
+		_, _, actualReason, _ := fakeWorkflowClient.CompleteCancelledActivityArgsForCall(0)
+		assert.Equal(t, cancelledReason, actualReason, "Expected the generic reason")
+	}
+}
+
+func TestDoWhenCancelledWithReportPartialResultsExpectsPartialResultReported(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, ReportPartialResults: true, Logger: logger}
+	ctx, cancel := context.WithCancel(context.Background())
+	partialResult := map[string]int{"processedLayers": 42}
+
+	// act
+	worker.Do(ctx, "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		cancel()
+		<-ctx.Done()
+		return partialResult, nil
+	})
+
+	// assert
+	assert.Equal(t, 0, fakeWorkflowClient.CompleteCancelledActivityCallCount(), "Expected the cancellation to carry the partial result")
+	if assert.Equal(t, 1, fakeWorkflowClient.CompleteCancelledActivityWithResultCallCount(), "Expected to call CompleteCancelledActivityWithResult once") {
+		actualWorkflowID, actualActivityID, actualReason, _, actualResult := fakeWorkflowClient.CompleteCancelledActivityWithResultArgsForCall(0)
+		assert.Equal(t, "workflow id", actualWorkflowID, "Expected workflow ID passed to CompleteCancelledActivityWithResult")
+		assert.Equal(t, "activity id", actualActivityID, "Expected activity ID passed to CompleteCancelledActivityWithResult")
+		assert.Equal(t, cancelledReason, actualReason, "Expected to pass reason for the cancellation")
+		assert.Equal(t, partialResult, actualResult, "Expected the partial result returned by the WorkerFunc")
+	}
+}
+
+func TestDoWhenCancelledWithoutReportPartialResultsExpectsResultDiscarded(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	// act
+	worker.Do(ctx, "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		cancel()
+		<-ctx.Done()
+		return "partial", nil
+	})
+
+	// assert
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteCancelledActivityCallCount(), "Expected to call CompleteCancelledActivity once")
+	assert.Equal(t, 0, fakeWorkflowClient.CompleteCancelledActivityWithResultCallCount(), "Expected no partial result to be sent")
+}
+
+func TestDoWhenHeartbeatDetailsFuncSetExpectsCustomDetailsSent(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	ticks := make(chan time.Time)
+	step := "meshing"
+	worker := &Worker{
+		WorkflowClient:       fakeWorkflowClient,
+		HeartbeatTicks:       ticks,
+		HeartbeatDetailsFunc: func() string { return "host-1: " + step },
+		Logger:               logger,
+	}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+		ticks <- time.Now()
+		ticks <- time.Now()
+		return nil, nil
+	})
+
+	// assert
+	if assert.Equal(t, 2, fakeWorkflowClient.HeartbeatActivityWithTokenCallCount(), "Expected to call HeartbeatActivityWithToken twice") {
+		_, _, firstDetails := fakeWorkflowClient.HeartbeatActivityWithTokenArgsForCall(0)
+		_, _, secondDetails := fakeWorkflowClient.HeartbeatActivityWithTokenArgsForCall(1)
+		assert.Equal(t, "host-1: meshing", firstDetails, "Expected the details supplied by HeartbeatDetailsFunc")
+		assert.Equal(t, "host-1: meshing", secondDetails, "Expected the details to be supplied for every heartbeat")
+	}
+}
+
+func TestAdaptiveHeartbeatInterval(t *testing.T) {
+	// arrange
+	worker := &Worker{AdaptiveHeartbeat: true, HeartbeatInterval: time.Minute, MinHeartbeatInterval: 5 * time.Second}
+	now := time.Date(2017, 6, 1, 14, 0, 0, 0, time.UTC)
+	timesLeft := []time.Duration{2 * time.Hour, 10 * time.Minute, 5 * time.Minute, time.Minute, 10 * time.Second, -time.Second}
+
+	// act
+	var intervals []time.Duration
+	for _, timeLeft := range timesLeft {
+		intervals = append(intervals, worker.adaptiveHeartbeatInterval(now.Add(timeLeft), now))
+	}
+
+	// assert
+	assert.Equal(t, []time.Duration{time.Minute, time.Minute, 30 * time.Second, 6 * time.Second, 5 * time.Second, 5 * time.Second},
+		intervals, "Expected a tenth of the time left, between the min and max interval")
+}
+
+func TestDoWhenAdaptiveHeartbeatNearDeadlineExpectsHeartbeatsAtMinInterval(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{
+		WorkflowClient:       fakeWorkflowClient,
+		AdaptiveHeartbeat:    true,
+		HeartbeatInterval:    time.Minute,
+		MinHeartbeatInterval: 10 * time.Millisecond,
+		ActivityTimeout:      time.Second,
+		Logger:               logger,
+	}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+		time.Sleep(200 * time.Millisecond)
+		return nil, nil
+	})
+
+	// assert
+	assert.True(t, fakeWorkflowClient.HeartbeatActivityWithTokenCallCount() >= 2,
+		"Expected heartbeats well before the 1 min heartbeat interval but got %v", fakeWorkflowClient.HeartbeatActivityWithTokenCallCount())
+}
+
+func TestDoWhenHeartbeatSplaySetExpectsFirstHeartbeatsDistributed(t *testing.T) {
+	// arrange
+	defer func(randInt63n func(int64) int64) { splayRandInt63n = randInt63n }(splayRandInt63n)
+	var mu sync.Mutex
+	offsets := 0
+	// picks 0, 100ms, 200ms in turn
+	splayRandInt63n = func(n int64) int64 {
+		mu.Lock()
+		defer mu.Unlock()
+		offsets++
+		return int64(offsets-1) * int64(100*time.Millisecond)
+	}
+	firstHeartbeats := map[string]time.Time{}
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	fakeWorkflowClient.HeartbeatActivityWithTokenStub = func(taskToken, activityID, details string) (*models.Heartbeat, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if _, ok := firstHeartbeats[activityID]; !ok {
+			firstHeartbeats[activityID] = time.Now()
+		}
+		return &models.Heartbeat{}, nil
+	}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatInterval: time.Hour, HeartbeatSplay: 300 * time.Millisecond, Logger: logger}
+
+	// act
+	var wg sync.WaitGroup
+	for _, activityID := range []string{"activity 1", "activity 2", "activity 3"} {
+		wg.Add(1)
+		go func(activityID string) {
+			defer wg.Done()
+			worker.Do(context.Background(), "workflow id", activityID, "token", func(context.Context, chan<- int) (interface{}, error) {
+				time.Sleep(400 * time.Millisecond)
+				return nil, nil
+			})
+		}(activityID)
+	}
+	wg.Wait()
+
+	// assert
+	mu.Lock()
+	defer mu.Unlock()
+	if assert.Len(t, firstHeartbeats, 3, "Expected a heartbeat for every activity well before the 1 hour heartbeat interval") {
+		var earliest, latest time.Time
+		for _, first := range firstHeartbeats {
+			if earliest.IsZero() || first.Before(earliest) {
+				earliest = first
+			}
+			if first.After(latest) {
+				latest = first
+			}
+		}
+		assert.True(t, latest.Sub(earliest) >= 150*time.Millisecond, "Expected the first heartbeats to be spread out but they were %v apart", latest.Sub(earliest))
+	}
+}
+
+func TestDoWhenHeartbeatTicksSentExpectsOneHeartbeatPerTick(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	ticks := make(chan time.Time)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatTicks: ticks, Logger: logger}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+		for i := 0; i < 3; i++ {
+			ticks <- time.Now()
+		}
+		return nil, nil
+	})
+
+	// assert
+	assert.Equal(t, 3, fakeWorkflowClient.HeartbeatActivityWithTokenCallCount(), "Expected to call HeartbeatActivityWithToken once per tick")
+}
+
 func TestDoWhenCancellationRequestedExpectsCompleteCancelledActivityCalled(t *testing.T) {
 	// arrange
 
-	// This test sets up a worker that will heartbeat every 7 ms.  The heartbeat response is mocked out to return
-	// cancelled = true.  At that point the worker should close the context that was passed to the worker function and
-	// the worker function will return.  The worker function is setup to timeout after 30 ms if nothing has happened
+	// This test sets up a worker whose function triggers a single heartbeat.  The heartbeat response is mocked out to
+	// return cancelled = true.  At that point the worker should close the context that was passed to the worker function
+	// and the worker function will return.  The worker function is setup to timeout after 1 sec if nothing has happened
 	// by then.
 	fakeWorkflowClient := &workflowfakes.FakeClient{}
-	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatInterval: 7 * time.Millisecond, Logger: logger}
+	ticks := make(chan time.Time)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatTicks: ticks, Logger: logger}
 	activityID := "activity id"
 	workflowID := "workflow id"
 	taskToken := "token"
@@ -110,16 +682,17 @@ func TestDoWhenCancellationRequestedExpectsCompleteCancelledActivityCalled(t *te
 
 	// act
 	worker.Do(context.Background(), workflowID, activityID, taskToken, func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		ticks <- time.Now()
 		select {
 		case <-ctx.Done():
-		case <-time.After(30 * time.Millisecond):
+		case <-time.After(1 * time.Second):
 			t.Error("Did not receive the cancellation in time")
 		}
 		return nil, nil
 	})
 
 	// assert
-	assert.True(t, fakeWorkflowClient.HeartbeatActivityWithTokenCallCount() >= 1, "Expected to call HeartbeatActivityWithToken at least once")
+	assert.Equal(t, 1, fakeWorkflowClient.HeartbeatActivityWithTokenCallCount(), "Expected to call HeartbeatActivityWithToken once")
 	actualTaskToken, actualActivityID, actualDetails := fakeWorkflowClient.HeartbeatActivityWithTokenArgsForCall(0)
 	assert.Equal(t, taskToken, actualTaskToken, "Expected task token passed to HeartbeatActivityWithToken")
 	assert.Equal(t, activityID, actualActivityID, "Expected activityID passed to HeartbeatActivityWithToken")
@@ -132,10 +705,39 @@ func TestDoWhenCancellationRequestedExpectsCompleteCancelledActivityCalled(t *te
 	assert.Equal(t, cancelledReason, actualReason, "Expected to pass reason for the cancellation")
 }
 
+func TestDoWhenCancelledAfterProgressExpectsLastProgressInDetails(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	ticks := make(chan time.Time)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatTicks: ticks, Logger: logger}
+	fakeWorkflowClient.HeartbeatActivityWithTokenReturns(&models.Heartbeat{Cancelled: true}, nil)
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		percentCompleteChan <- 45
+		ReporterFromContext(ctx).Log("Meshing part")
+		ticks <- time.Now()
+		select {
+		case <-ctx.Done():
+		case <-time.After(1 * time.Second):
+			t.Error("Did not receive the cancellation in time")
+		}
+		return nil, nil
+	})
+
+	// assert
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteCancelledActivityCallCount(), "Expected to call CompleteCancelledActivity once")
+	_, _, _, actualDetails := fakeWorkflowClient.CompleteCancelledActivityArgsForCall(0)
+	assert.Contains(t, actualDetails, completedMessage, "Expected to pass details that the work finished")
+	assert.Contains(t, actualDetails, "45%", "Expected the last percent complete in the details")
+	assert.Contains(t, actualDetails, "Meshing part", "Expected the last message in the details")
+}
+
 func TestDoWhenCancellationRequestedAndFunctionErrorsExpectsCompleteCancelledActivityCalled(t *testing.T) {
 	// arrange
 	fakeWorkflowClient := &workflowfakes.FakeClient{}
-	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatInterval: 7 * time.Millisecond, Logger: logger}
+	ticks := make(chan time.Time)
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, HeartbeatTicks: ticks, Logger: logger}
 	activityID := "activity id"
 	workflowID := "workflow id"
 	taskToken := "token"
@@ -148,16 +750,17 @@ func TestDoWhenCancellationRequestedAndFunctionErrorsExpectsCompleteCancelledAct
 
 	// act
 	worker.Do(context.Background(), workflowID, activityID, taskToken, func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		ticks <- time.Now()
 		select {
 		case <-ctx.Done():
-		case <-time.After(30 * time.Millisecond):
+		case <-time.After(1 * time.Second):
 			t.Error("Did not receive the cancellation in time")
 		}
 		return nil, errors.New(errMsg)
 	})
 
 	// assert
-	assert.True(t, fakeWorkflowClient.HeartbeatActivityWithTokenCallCount() >= 1, "Expected to call HeartbeatActivityWithToken at least once")
+	assert.Equal(t, 1, fakeWorkflowClient.HeartbeatActivityWithTokenCallCount(), "Expected to call HeartbeatActivityWithToken once")
 	actualTaskToken, actualActivityID, actualDetails := fakeWorkflowClient.HeartbeatActivityWithTokenArgsForCall(0)
 	assert.Equal(t, taskToken, actualTaskToken, "Expected task token passed to HeartbeatActivityWithToken")
 	assert.Equal(t, activityID, actualActivityID, "Expected activityID passed to HeartbeatActivityWithToken")
@@ -173,9 +776,10 @@ func TestDoWhenCancellationRequestedAndFunctionErrorsExpectsCompleteCancelledAct
 func TestDoWhenCancellationRequestedAndFunctionBlocksForeverExpectsCompleteCancelledActivityCalledAfterTimeout(t *testing.T) {
 	// arrange
 	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	ticks := make(chan time.Time)
 	worker := &Worker{
 		WorkflowClient:      fakeWorkflowClient,
-		HeartbeatInterval:   7 * time.Millisecond,
+		HeartbeatTicks:      ticks,
 		CancellationTimeout: 10 * time.Millisecond,
 		Logger:              logger,
 	}
@@ -190,13 +794,14 @@ func TestDoWhenCancellationRequestedAndFunctionBlocksForeverExpectsCompleteCance
 
 	// act
 	worker.Do(context.Background(), workflowID, activityID, taskToken, func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		ticks <- time.Now()
 		<-ctx.Done()
 		time.Sleep(30 * time.Millisecond)
 		return nil, errors.New("Unexpected error")
 	})
 
 	// assert
-	assert.True(t, fakeWorkflowClient.HeartbeatActivityWithTokenCallCount() >= 1, "Expected to call HeartbeatActivityWithToken at least once")
+	assert.Equal(t, 1, fakeWorkflowClient.HeartbeatActivityWithTokenCallCount(), "Expected to call HeartbeatActivityWithToken once")
 	actualTaskToken, actualActivityID, actualDetails := fakeWorkflowClient.HeartbeatActivityWithTokenArgsForCall(0)
 	assert.Equal(t, taskToken, actualTaskToken, "Expected task token passed to HeartbeatActivityWithToken")
 	assert.Equal(t, activityID, actualActivityID, "Expected activityID passed to HeartbeatActivityWithToken")
@@ -233,6 +838,63 @@ func TestDoExpectsUpdateActivityPercentCompleteCalledWhenProgressIsMade(t *testi
 	assert.Equal(t, 30, actualPercentComplete, "Expected percent complete passed to UpdateActivityPercentComplete")
 }
 
+func TestDoWhenProgressSentAfterFunctionReturnedExpectsNoUpdateAfterCompletion(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	updatesAtCompletion := -1
+	fakeWorkflowClient.CompleteSuccessfulActivityStub = func(string, string, interface{}) (*models.Activity, error) {
+		updatesAtCompletion = fakeWorkflowClient.UpdateActivityPercentCompleteCallCount()
+		return nil, nil
+	}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+	release := make(chan struct{})
+	lateSendDone := make(chan struct{})
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		percentCompleteChan <- 50
+		go func() {
+			// lingering goroutine that reports progress after the work returned
+			<-release
+			select {
+			case percentCompleteChan <- 80:
+			case <-ctx.Done():
+			}
+			close(lateSendDone)
+		}()
+		return nil, nil
+	})
+	close(release)
+	<-lateSendDone
+
+	// assert
+	assert.Equal(t, 1, updatesAtCompletion, "Expected the update sent before returning to be made before the completion")
+	assert.Equal(t, 1, fakeWorkflowClient.UpdateActivityPercentCompleteCallCount(), "Expected the late update to be dropped")
+}
+
+func TestDoExpectsNoGoroutineLeftRunning(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+	before := runtime.NumGoroutine()
+
+	// act
+	for i := 0; i < 20; i++ {
+		worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+			percentCompleteChan <- 50
+			return nil, nil
+		})
+	}
+
+	// assert
+	// the goroutines of the last calls may still be on their way out
+	after := runtime.NumGoroutine()
+	for deadline := time.Now().Add(time.Second); after > before && time.Now().Before(deadline); after = runtime.NumGoroutine() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.True(t, after <= before, "Expected the goroutines of Do to end but %v are left", after-before)
+}
+
 func TestDoExpectsUpdateActivityPercentCompleteCalledOnceWhenSameValuesAreSentConsecutively(t *testing.T) {
 	// arrange
 	fakeWorkflowClient := &workflowfakes.FakeClient{}
@@ -256,3 +918,341 @@ func TestDoExpectsUpdateActivityPercentCompleteCalledOnceWhenSameValuesAreSentCo
 	assert.Equal(t, activityID, actualActivityID, "Expected activity ID passed to UpdateActivityPercentComplete")
 	assert.Equal(t, 30, actualPercentComplete, "Expected percent complete passed to UpdateActivityPercentComplete")
 }
+
+func TestDoWhenActivityTimeoutSetExpectsDeadlineOnContext(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	activityTimeout := 1 * time.Hour
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, ActivityTimeout: activityTimeout, Logger: logger}
+	var deadline time.Time
+	var hasDeadline bool
+	start := time.Now()
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		deadline, hasDeadline = ctx.Deadline()
+		return "result", nil
+	})
+
+	// assert
+	assert.True(t, hasDeadline, "Expected the context to have a deadline")
+	assert.WithinDuration(t, start.Add(activityTimeout), deadline, 1*time.Second, "Expected the deadline to come from the activity timeout")
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteSuccessfulActivityCallCount(), "Expected to call CompleteSuccessfulActivity once")
+}
+
+func TestDoWhenActivityTimeoutExceededExpectsCompleteCancelledActivityCalledWithTimeoutReason(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, ActivityTimeout: 10 * time.Millisecond, Logger: logger}
+	activityID := "activity id"
+	workflowID := "workflow id"
+
+	// act
+	worker.Do(context.Background(), workflowID, activityID, "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	// assert
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteCancelledActivityCallCount(), "Expected to call CompleteCancelledActivity once")
+	actualWorkflowID, actualActivityID, actualReason, actualDetails := fakeWorkflowClient.CompleteCancelledActivityArgsForCall(0)
+	assert.Equal(t, workflowID, actualWorkflowID, "Expected workflow ID passed to CompleteCancelledActivity")
+	assert.Equal(t, activityID, actualActivityID, "Expected activity ID passed to CompleteCancelledActivity")
+	assert.Equal(t, activityTimeoutReason, actualReason, "Expected to pass the activity timeout as the reason")
+	assert.Equal(t, context.DeadlineExceeded.Error(), actualDetails, "Expected to pass the error from the work as details")
+}
+
+func TestDoWhenNoActivityTimeoutExpectsNoDeadlineOnContext(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+	hasDeadline := true
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		_, hasDeadline = ctx.Deadline()
+		return "result", nil
+	})
+
+	// assert
+	assert.False(t, hasDeadline, "Expected the context to not have a deadline")
+}
+
+func TestDoWhenFunctionIgnoresCancellationExpectsDoToReturnPromptlyAndWorkAbandoned(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	ticks := make(chan time.Time)
+	worker := &Worker{
+		WorkflowClient:      fakeWorkflowClient,
+		HeartbeatTicks:      ticks,
+		CancellationTimeout: 10 * time.Millisecond,
+		Logger:              logger,
+	}
+	fakeWorkflowClient.HeartbeatActivityWithTokenReturns(&models.Heartbeat{ActivityID: swag.String("activity id"), Cancelled: true}, nil)
+	release := make(chan struct{})
+	defer close(release)
+	abandonedBefore := AbandonedWorkCount()
+	done := make(chan struct{})
+
+	// act
+	go func() {
+		worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+			ticks <- time.Now()
+			// never looks at ctx
+			<-release
+			return "result", nil
+		})
+		close(done)
+	}()
+
+	// assert
+	select {
+	case <-done:
+	case <-time.After(1 * time.Second):
+		t.Fatal("Expected Do to return after the cancellation timeout")
+	}
+	assert.Equal(t, abandonedBefore+1, AbandonedWorkCount(), "Expected the work to be counted as abandoned")
+	assert.Equal(t, 1, fakeWorkflowClient.CompleteCancelledActivityCallCount(), "Expected to call CompleteCancelledActivity once")
+	_, _, _, actualDetails := fakeWorkflowClient.CompleteCancelledActivityArgsForCall(0)
+	assert.Equal(t, timeoutErrorMessage, actualDetails, "Expected to report that the cancellation timed out")
+}
+
+func TestDoWhenLinesLoggedExpectsAppendActivityLogsCalledBeforeCompletion(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+	completedBeforeLogs := false
+	fakeWorkflowClient.AppendActivityLogsStub = func(string, string, []*models.LogLine) error {
+		completedBeforeLogs = fakeWorkflowClient.CompleteSuccessfulActivityCallCount() > 0
+		return nil
+	}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		reporter := ReporterFromContext(ctx)
+		reporter.Log("first line")
+		reporter.Log("second line")
+		return "result", nil
+	})
+
+	// assert
+	assert.Equal(t, 1, fakeWorkflowClient.AppendActivityLogsCallCount(), "Expected to call AppendActivityLogs once")
+	actualWorkflowID, actualActivityID, actualLines := fakeWorkflowClient.AppendActivityLogsArgsForCall(0)
+	assert.Equal(t, "workflow id", actualWorkflowID, "Expected workflow ID passed to AppendActivityLogs")
+	assert.Equal(t, "activity id", actualActivityID, "Expected activity ID passed to AppendActivityLogs")
+	if assert.Len(t, actualLines, 2, "Expected both lines to be sent") {
+		assert.Equal(t, "first line", actualLines[0].Line, "Expected lines in the order they were logged")
+		assert.Equal(t, "second line", actualLines[1].Line, "Expected lines in the order they were logged")
+		assert.False(t, time.Time(actualLines[0].Time).IsZero(), "Expected lines to be timestamped")
+	}
+	assert.False(t, completedBeforeLogs, "Expected logs to be sent before the activity is completed")
+}
+
+func TestDoWhenBatchFillsExpectsLinesSentBeforeFlushInterval(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, LogFlushInterval: time.Hour, Logger: logger}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		reporter := ReporterFromContext(ctx)
+		for i := 0; i < maxLogBatchSize; i++ {
+			reporter.Log("line")
+		}
+		for fakeWorkflowClient.AppendActivityLogsCallCount() == 0 {
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Millisecond):
+			}
+		}
+		return "result", nil
+	})
+
+	// assert
+	assert.Equal(t, 1, fakeWorkflowClient.AppendActivityLogsCallCount(), "Expected a full batch to be sent right away")
+	_, _, actualLines := fakeWorkflowClient.AppendActivityLogsArgsForCall(0)
+	assert.Len(t, actualLines, maxLogBatchSize, "Expected the full batch to be sent")
+}
+
+func TestDoWhenNothingLoggedExpectsAppendActivityLogsNotCalled(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, LogFlushInterval: time.Millisecond, Logger: logger}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		time.Sleep(10 * time.Millisecond)
+		return "result", nil
+	})
+
+	// assert
+	assert.Equal(t, 0, fakeWorkflowClient.AppendActivityLogsCallCount(), "Expected no empty batches to be sent")
+}
+
+func TestDoWhenStagedProgressReportedExpectsStageSentWithUpdate(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		percentCompleteChan <- 10
+		ReporterFromContext(ctx).Report(Progress{PercentComplete: 40, Stage: "meshing", Message: "Meshed 3 of 8 parts"})
+		return "result", nil
+	})
+
+	// assert
+	assert.Equal(t, 1, fakeWorkflowClient.UpdateActivityPercentCompleteCallCount(), "Expected the percent complete alone to be sent as before")
+	if assert.Equal(t, 1, fakeWorkflowClient.UpdateActivityCallCount(), "Expected the staged progress to be sent with UpdateActivity") {
+		actualWorkflowID, actualActivity := fakeWorkflowClient.UpdateActivityArgsForCall(0)
+		assert.Equal(t, "workflow id", actualWorkflowID, "Expected workflow ID passed to UpdateActivity")
+		assert.Equal(t, "activity id", swag.StringValue(actualActivity.ID), "Expected the activity ID")
+		assert.Equal(t, models.ActivityStatusRunning, swag.StringValue(actualActivity.Status), "Expected the activity to be running")
+		assert.EqualValues(t, 40, actualActivity.PercentComplete, "Expected the percent complete of the progress")
+		assert.Equal(t, "meshing", actualActivity.Stage, "Expected the stage of the progress")
+		assert.Equal(t, "Meshed 3 of 8 parts", actualActivity.ProgressMessage, "Expected the message of the progress")
+	}
+}
+
+func TestReporterFromContextWhenNotFromDoExpectsNilReporterThatDiscards(t *testing.T) {
+	// act
+	reporter := ReporterFromContext(context.Background())
+
+	// assert
+	assert.Nil(t, reporter, "Expected no reporter")
+	assert.NotPanics(t, func() { reporter.Log("line") }, "Expected a nil reporter to discard lines")
+	assert.NotPanics(t, func() { reporter.Report(Progress{PercentComplete: 10}) }, "Expected a nil reporter to discard progress")
+}
+
+func TestDoExpectsActivityIDsInContextGivenToWorkerFunc(t *testing.T) {
+	// arrange
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{WorkflowClient: fakeWorkflowClient, Logger: logger}
+	var actualWorkflowID, actualActivityID, actualTaskToken string
+	var actualOK bool
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		actualWorkflowID, actualActivityID, actualTaskToken, actualOK = FromContext(ctx)
+		return "result", nil
+	})
+
+	// assert
+	assert.True(t, actualOK, "Expected the activity to be found in the context")
+	assert.Equal(t, "workflow id", actualWorkflowID, "Expected the workflow ID given to Do")
+	assert.Equal(t, "activity id", actualActivityID, "Expected the activity ID given to Do")
+	assert.Equal(t, "token", actualTaskToken, "Expected the task token given to Do")
+}
+
+func TestFromContextWhenNotFromDoExpectsNotOK(t *testing.T) {
+	// act
+	workflowID, activityID, taskToken, ok := FromContext(context.Background())
+
+	// assert
+	assert.False(t, ok, "Expected no activity in the context")
+	assert.Empty(t, workflowID+activityID+taskToken, "Expected empty IDs")
+}
+
+func TestDoWhenHeartbeatIntervalNotShorterThanHeartbeatTimeoutExpectsWarning(t *testing.T) {
+	// arrange
+	var warnings []string
+	warningLogger := log.New()
+	warningLogger.SetHandler(log.FuncHandler(func(r *log.Record) error {
+		if r.Lvl == log.LvlWarn {
+			warnings = append(warnings, r.Msg)
+		}
+		return nil
+	}))
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{
+		WorkflowClient:    fakeWorkflowClient,
+		HeartbeatInterval: 2 * time.Minute,
+		HeartbeatTimeout:  1 * time.Minute,
+		Logger:            warningLogger,
+	}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+		return "result", nil
+	})
+
+	// assert
+	if assert.Len(t, warnings, 1, "Expected a warning") {
+		assert.Contains(t, warnings[0], "heartbeat timeout", "Expected the warning to be about the heartbeat timeout")
+	}
+}
+
+func TestDoWhenHeartbeatIntervalShorterThanHeartbeatTimeoutExpectsNoWarning(t *testing.T) {
+	// arrange
+	var warnings []string
+	warningLogger := log.New()
+	warningLogger.SetHandler(log.FuncHandler(func(r *log.Record) error {
+		if r.Lvl == log.LvlWarn {
+			warnings = append(warnings, r.Msg)
+		}
+		return nil
+	}))
+	fakeWorkflowClient := &workflowfakes.FakeClient{}
+	worker := &Worker{
+		WorkflowClient:    fakeWorkflowClient,
+		HeartbeatInterval: 30 * time.Second,
+		HeartbeatTimeout:  1 * time.Minute,
+		Logger:            warningLogger,
+	}
+
+	// act
+	worker.Do(context.Background(), "workflow id", "activity id", "token", func(context.Context, chan<- int) (interface{}, error) {
+		return "result", nil
+	})
+
+	// assert
+	assert.Empty(t, warnings, "Expected no warning")
+}
+
+func TestDoWhenCancelledDuringSlowHeartbeatExpectsHeartbeatAborted(t *testing.T) {
+	// arrange
+	heartbeatStarted := make(chan struct{})
+	heartbeatAborted := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/workflow-api/heartbeats", func(w http.ResponseWriter, r *http.Request) {
+		// the server only notices the client going away once the body was read
+		ioutil.ReadAll(r.Body)
+		close(heartbeatStarted)
+		select {
+		case <-r.Context().Done():
+			close(heartbeatAborted)
+		case <-time.After(5 * time.Second):
+		}
+	})
+	mux.HandleFunc("/workflow-api/workflows/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"activity id","status":"Cancelled"}`))
+	})
+	testServer := httptest.NewServer(mux)
+	defer testServer.Close()
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("token", nil)
+	client := workflow.NewClient(fakeTokenFetcher, testServer.URL, "workflow-api", "audience", logger)
+	ticks := make(chan time.Time)
+	worker := &Worker{WorkflowClient: client, HeartbeatTicks: ticks, Logger: logger}
+	ctx, cancel := context.WithCancel(context.Background())
+	start := time.Now()
+
+	// act
+	worker.Do(ctx, "workflow id", "activity id", "token", func(ctx context.Context, percentCompleteChan chan<- int) (interface{}, error) {
+		ticks <- time.Now()
+		<-heartbeatStarted
+		cancel()
+		<-ctx.Done()
+		return nil, ctx.Err()
+	})
+
+	// assert
+	assert.True(t, time.Since(start) < 2*time.Second, "Expected Do to return without waiting for the slow heartbeat")
+	select {
+	case <-heartbeatAborted:
+	case <-time.After(1 * time.Second):
+		t.Error("Expected the heartbeat request to be aborted")
+	}
+}
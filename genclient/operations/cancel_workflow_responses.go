@@ -53,6 +53,13 @@ func (o *CancelWorkflowReader) ReadResponse(response runtime.ClientResponse, con
 		}
 		return nil, result
 
+	case 409:
+		result := NewCancelWorkflowConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		result := NewCancelWorkflowDefault(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -165,6 +172,35 @@ func (o *CancelWorkflowNotFound) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewCancelWorkflowConflict creates a CancelWorkflowConflict with default headers values
+func NewCancelWorkflowConflict() *CancelWorkflowConflict {
+	return &CancelWorkflowConflict{}
+}
+
+/*CancelWorkflowConflict handles this case with default header values.
+
+Workflow already finished and cannot be cancelled
+*/
+type CancelWorkflowConflict struct {
+	Payload *models.Error
+}
+
+func (o *CancelWorkflowConflict) Error() string {
+	return fmt.Sprintf("[PUT /workflows/{id}/cancel][%d] cancelWorkflowConflict  %+v", 409, o.Payload)
+}
+
+func (o *CancelWorkflowConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewCancelWorkflowDefault creates a CancelWorkflowDefault with default headers values
 func NewCancelWorkflowDefault(code int) *CancelWorkflowDefault {
 	return &CancelWorkflowDefault{
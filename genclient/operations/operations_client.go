@@ -24,24 +24,488 @@ type Client struct {
 	formats   strfmt.Registry
 }
 
+/*
+AppendActivityLogs Append log lines to an activity
+*/
+func (a *Client) AppendActivityLogs(params *AppendActivityLogsParams, authInfo runtime.ClientAuthInfoWriter) (*AppendActivityLogsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewAppendActivityLogsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "appendActivityLogs",
+		Method:             "POST",
+		PathPattern:        "/workflows/{id}/activities/{activityId}/logs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &AppendActivityLogsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*AppendActivityLogsOK), nil
+
+}
+
 /*
 CancelWorkflow Cancel a workflow
 */
-func (a *Client) CancelWorkflow(params *CancelWorkflowParams, authInfo runtime.ClientAuthInfoWriter) (*CancelWorkflowOK, error) {
+func (a *Client) CancelWorkflow(params *CancelWorkflowParams, authInfo runtime.ClientAuthInfoWriter) (*CancelWorkflowOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewCancelWorkflowParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "cancelWorkflow",
+		Method:             "PUT",
+		PathPattern:        "/workflows/{id}/cancel",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &CancelWorkflowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*CancelWorkflowOK), nil
+
+}
+
+/*
+GetActivity Get an activity of a workflow
+*/
+func (a *Client) GetActivity(params *GetActivityParams, authInfo runtime.ClientAuthInfoWriter) (*GetActivityOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetActivityParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getActivity",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}/activities/{activityId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetActivityReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetActivityOK), nil
+
+}
+
+/*
+GetActivityCancellation Tell whether the cancellation of an activity has been requested, without heartbeating it
+*/
+func (a *Client) GetActivityCancellation(params *GetActivityCancellationParams, authInfo runtime.ClientAuthInfoWriter) (*GetActivityCancellationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetActivityCancellationParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getActivityCancellation",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}/activities/{activityId}/cancellation",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetActivityCancellationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetActivityCancellationOK), nil
+
+}
+
+/*
+GetActivityLogs Get the lines of output of an activity
+*/
+func (a *Client) GetActivityLogs(params *GetActivityLogsParams, authInfo runtime.ClientAuthInfoWriter) (*GetActivityLogsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetActivityLogsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getActivityLogs",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}/activities/{activityId}/logs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetActivityLogsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetActivityLogsOK), nil
+
+}
+
+/*
+GetActivityTaskToken Get the task token of a running activity
+*/
+func (a *Client) GetActivityTaskToken(params *GetActivityTaskTokenParams, authInfo runtime.ClientAuthInfoWriter) (*GetActivityTaskTokenOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetActivityTaskTokenParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getActivityTaskToken",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}/activities/{activityId}/taskToken",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetActivityTaskTokenReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetActivityTaskTokenOK), nil
+
+}
+
+/*
+GetChildWorkflows Get the workflows started by a workflow
+*/
+func (a *Client) GetChildWorkflows(params *GetChildWorkflowsParams, authInfo runtime.ClientAuthInfoWriter) (*GetChildWorkflowsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetChildWorkflowsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getChildWorkflows",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}/children",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetChildWorkflowsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetChildWorkflowsOK), nil
+
+}
+
+/*
+GetSignalStatus Get the delivery status of the most recent signal of a name sent to a workflow
+*/
+func (a *Client) GetSignalStatus(params *GetSignalStatusParams, authInfo runtime.ClientAuthInfoWriter) (*GetSignalStatusOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetSignalStatusParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getSignalStatus",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}/signals/{name}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetSignalStatusReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetSignalStatusOK), nil
+
+}
+
+/*
+GetWorkflow Get a workflow
+*/
+func (a *Client) GetWorkflow(params *GetWorkflowParams, authInfo runtime.ClientAuthInfoWriter) (*GetWorkflowOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetWorkflowParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getWorkflow",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetWorkflowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetWorkflowOK), nil
+
+}
+
+/*
+GetWorkflowByExternalID Get the workflow started with an external ID
+*/
+func (a *Client) GetWorkflowByExternalID(params *GetWorkflowByExternalIDParams, authInfo runtime.ClientAuthInfoWriter) (*GetWorkflowByExternalIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetWorkflowByExternalIDParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getWorkflowByExternalId",
+		Method:             "GET",
+		PathPattern:        "/workflows/external/{externalId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetWorkflowByExternalIDReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetWorkflowByExternalIDOK), nil
+
+}
+
+/*
+GetWorkflowHistory Get the history of a workflow
+*/
+func (a *Client) GetWorkflowHistory(params *GetWorkflowHistoryParams, authInfo runtime.ClientAuthInfoWriter) (*GetWorkflowHistoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetWorkflowHistoryParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getWorkflowHistory",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}/history",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetWorkflowHistoryReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetWorkflowHistoryOK), nil
+
+}
+
+/*
+GetWorkflowParameters Get the parameters a workflow was started with
+*/
+func (a *Client) GetWorkflowParameters(params *GetWorkflowParametersParams, authInfo runtime.ClientAuthInfoWriter) (*GetWorkflowParametersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetWorkflowParametersParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getWorkflowParameters",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}/parameters",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetWorkflowParametersReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetWorkflowParametersOK), nil
+
+}
+
+/*
+GetWorkflowType Describe a workflow type
+*/
+func (a *Client) GetWorkflowType(params *GetWorkflowTypeParams, authInfo runtime.ClientAuthInfoWriter) (*GetWorkflowTypeOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetWorkflowTypeParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "getWorkflowType",
+		Method:             "GET",
+		PathPattern:        "/workflow-types/{workflowType}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetWorkflowTypeReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*GetWorkflowTypeOK), nil
+
+}
+
+/*
+Heartbeat Send a heartbeat to the workflow api to let it know that the activity is still running
+*/
+func (a *Client) Heartbeat(params *HeartbeatParams, authInfo runtime.ClientAuthInfoWriter) (*HeartbeatOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewHeartbeatParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "heartbeat",
+		Method:             "PUT",
+		PathPattern:        "/heartbeats",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &HeartbeatReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*HeartbeatOK), nil
+
+}
+
+/*
+HeartbeatActivity Signal workflow that activity is still running
+*/
+func (a *Client) HeartbeatActivity(params *HeartbeatActivityParams, authInfo runtime.ClientAuthInfoWriter) (*HeartbeatActivityOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewHeartbeatActivityParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "heartbeatActivity",
+		Method:             "PUT",
+		PathPattern:        "/workflows/{id}/activities/{activityId}/heartbeat",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &HeartbeatActivityReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*HeartbeatActivityOK), nil
+
+}
+
+/*
+ListActivities List the activities of a workflow one page at a time
+*/
+func (a *Client) ListActivities(params *ListActivitiesParams, authInfo runtime.ClientAuthInfoWriter) (*ListActivitiesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListActivitiesParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "listActivities",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}/activities",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListActivitiesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ListActivitiesOK), nil
+
+}
+
+/*
+ListWorkflowHistory List the history events of a workflow one page at a time
+*/
+func (a *Client) ListWorkflowHistory(params *ListWorkflowHistoryParams, authInfo runtime.ClientAuthInfoWriter) (*ListWorkflowHistoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewCancelWorkflowParams()
+		params = NewListWorkflowHistoryParams()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "cancelWorkflow",
-		Method:             "PUT",
-		PathPattern:        "/workflows/{id}/cancel",
+		ID:                 "listWorkflowHistory",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}/events",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &CancelWorkflowReader{formats: a.formats},
+		Reader:             &ListWorkflowHistoryReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -49,28 +513,28 @@ func (a *Client) CancelWorkflow(params *CancelWorkflowParams, authInfo runtime.C
 	if err != nil {
 		return nil, err
 	}
-	return result.(*CancelWorkflowOK), nil
+	return result.(*ListWorkflowHistoryOK), nil
 
 }
 
 /*
-Heartbeat Send a heartbeat to the workflow api to let it know that the activity is still running
+ListWorkflows List workflows matching the given criteria
 */
-func (a *Client) Heartbeat(params *HeartbeatParams, authInfo runtime.ClientAuthInfoWriter) (*HeartbeatOK, error) {
+func (a *Client) ListWorkflows(params *ListWorkflowsParams, authInfo runtime.ClientAuthInfoWriter) (*ListWorkflowsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewHeartbeatParams()
+		params = NewListWorkflowsParams()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "heartbeat",
-		Method:             "PUT",
-		PathPattern:        "/heartbeats",
+		ID:                 "listWorkflows",
+		Method:             "GET",
+		PathPattern:        "/workflows",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &HeartbeatReader{formats: a.formats},
+		Reader:             &ListWorkflowsReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -78,28 +542,28 @@ func (a *Client) Heartbeat(params *HeartbeatParams, authInfo runtime.ClientAuthI
 	if err != nil {
 		return nil, err
 	}
-	return result.(*HeartbeatOK), nil
+	return result.(*ListWorkflowsOK), nil
 
 }
 
 /*
-HeartbeatActivity Signal workflow that activity is still running
+LookupTaskToken Find the workflow and activity a task token belongs to
 */
-func (a *Client) HeartbeatActivity(params *HeartbeatActivityParams, authInfo runtime.ClientAuthInfoWriter) (*HeartbeatActivityOK, error) {
+func (a *Client) LookupTaskToken(params *LookupTaskTokenParams, authInfo runtime.ClientAuthInfoWriter) (*LookupTaskTokenOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewHeartbeatActivityParams()
+		params = NewLookupTaskTokenParams()
 	}
 
 	result, err := a.transport.Submit(&runtime.ClientOperation{
-		ID:                 "heartbeatActivity",
-		Method:             "PUT",
-		PathPattern:        "/workflows/{id}/activities/{activityId}/heartbeat",
+		ID:                 "lookupTaskToken",
+		Method:             "POST",
+		PathPattern:        "/taskTokens/lookup",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &HeartbeatActivityReader{formats: a.formats},
+		Reader:             &LookupTaskTokenReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -107,7 +571,152 @@ func (a *Client) HeartbeatActivity(params *HeartbeatActivityParams, authInfo run
 	if err != nil {
 		return nil, err
 	}
-	return result.(*HeartbeatActivityOK), nil
+	return result.(*LookupTaskTokenOK), nil
+
+}
+
+/*
+PatchActivity changes only the given fields of an activity
+*/
+func (a *Client) PatchActivity(params *PatchActivityParams, authInfo runtime.ClientAuthInfoWriter) (*PatchActivityOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPatchActivityParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "patchActivity",
+		Method:             "PATCH",
+		PathPattern:        "/workflows/{id}/activities/{activityId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/merge-patch+json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PatchActivityReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*PatchActivityOK), nil
+
+}
+
+/*
+RegisterWebhook subscribes to the events of a workflow
+*/
+func (a *Client) RegisterWebhook(params *RegisterWebhookParams, authInfo runtime.ClientAuthInfoWriter) (*RegisterWebhookCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRegisterWebhookParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "registerWebhook",
+		Method:             "POST",
+		PathPattern:        "/workflows/{id}/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RegisterWebhookReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RegisterWebhookCreated), nil
+
+}
+
+/*
+RetryActivity reschedules a failed activity
+*/
+func (a *Client) RetryActivity(params *RetryActivityParams, authInfo runtime.ClientAuthInfoWriter) (*RetryActivityOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRetryActivityParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "retryActivity",
+		Method:             "POST",
+		PathPattern:        "/workflows/{id}/activities/{activityId}/retry",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RetryActivityReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*RetryActivityOK), nil
+
+}
+
+/*
+SearchWorkflows Search workflows by partial entity ID or free text
+*/
+func (a *Client) SearchWorkflows(params *SearchWorkflowsParams, authInfo runtime.ClientAuthInfoWriter) (*SearchWorkflowsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSearchWorkflowsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "searchWorkflows",
+		Method:             "GET",
+		PathPattern:        "/workflows/search",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SearchWorkflowsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SearchWorkflowsOK), nil
+
+}
+
+/*
+SignalWorkflow Send a signal to a workflow
+*/
+func (a *Client) SignalWorkflow(params *SignalWorkflowParams, authInfo runtime.ClientAuthInfoWriter) (*SignalWorkflowOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSignalWorkflowParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "signalWorkflow",
+		Method:             "POST",
+		PathPattern:        "/workflows/{id}/signals",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SignalWorkflowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*SignalWorkflowOK), nil
 
 }
 
@@ -140,6 +749,64 @@ func (a *Client) StartWorkflow(params *StartWorkflowParams, authInfo runtime.Cli
 
 }
 
+/*
+TransferWorkflow Move a workflow to another organization
+*/
+func (a *Client) TransferWorkflow(params *TransferWorkflowParams, authInfo runtime.ClientAuthInfoWriter) (*TransferWorkflowOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTransferWorkflowParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "transferWorkflow",
+		Method:             "POST",
+		PathPattern:        "/workflows/{id}/transfer",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &TransferWorkflowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*TransferWorkflowOK), nil
+
+}
+
+/*
+UnregisterWebhook removes a webhook subscription
+*/
+func (a *Client) UnregisterWebhook(params *UnregisterWebhookParams, authInfo runtime.ClientAuthInfoWriter) (*UnregisterWebhookNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUnregisterWebhookParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "unregisterWebhook",
+		Method:             "DELETE",
+		PathPattern:        "/webhooks/{webhookId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UnregisterWebhookReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UnregisterWebhookNoContent), nil
+
+}
+
 /*
 UpdateActivity Create or update an activity
 */
@@ -169,6 +836,93 @@ func (a *Client) UpdateActivity(params *UpdateActivityParams, authInfo runtime.C
 
 }
 
+/*
+UpdateWorkflowMetadata Replace the description and metadata of a workflow
+*/
+func (a *Client) UpdateWorkflowMetadata(params *UpdateWorkflowMetadataParams, authInfo runtime.ClientAuthInfoWriter) (*UpdateWorkflowMetadataOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateWorkflowMetadataParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "updateWorkflowMetadata",
+		Method:             "PUT",
+		PathPattern:        "/workflows/{id}/metadata",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateWorkflowMetadataReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*UpdateWorkflowMetadataOK), nil
+
+}
+
+/*
+ValidateWorkflow Check a workflow request without starting the workflow
+*/
+func (a *Client) ValidateWorkflow(params *ValidateWorkflowParams, authInfo runtime.ClientAuthInfoWriter) (*ValidateWorkflowNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewValidateWorkflowParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "validateWorkflow",
+		Method:             "POST",
+		PathPattern:        "/workflows/validate",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ValidateWorkflowReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*ValidateWorkflowNoContent), nil
+
+}
+
+/*
+WaitForCapacityEvents Wait for the capacity of an organization to become available.  Answers as soon as there are events after the cursor or when wait seconds passed without any.
+*/
+func (a *Client) WaitForCapacityEvents(params *WaitForCapacityEventsParams, authInfo runtime.ClientAuthInfoWriter) (*WaitForCapacityEventsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewWaitForCapacityEventsParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "waitForCapacityEvents",
+		Method:             "GET",
+		PathPattern:        "/organizations/{organizationId}/capacity/events",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &WaitForCapacityEventsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*WaitForCapacityEventsOK), nil
+
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
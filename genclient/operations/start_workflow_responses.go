@@ -53,6 +53,20 @@ func (o *StartWorkflowReader) ReadResponse(response runtime.ClientResponse, cons
 		}
 		return nil, result
 
+	case 409:
+		result := NewStartWorkflowConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 429:
+		result := NewStartWorkflowTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		result := NewStartWorkflowDefault(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -179,6 +193,64 @@ func (o *StartWorkflowNotFound) readResponse(response runtime.ClientResponse, co
 	return nil
 }
 
+// NewStartWorkflowConflict creates a StartWorkflowConflict with default headers values
+func NewStartWorkflowConflict() *StartWorkflowConflict {
+	return &StartWorkflowConflict{}
+}
+
+/*StartWorkflowConflict handles this case with default header values.
+
+The organization is at capacity and the workflow asked not to be queued
+*/
+type StartWorkflowConflict struct {
+	Payload *models.Error
+}
+
+func (o *StartWorkflowConflict) Error() string {
+	return fmt.Sprintf("[POST /workflows][%d] startWorkflowConflict  %+v", 409, o.Payload)
+}
+
+func (o *StartWorkflowConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewStartWorkflowTooManyRequests creates a StartWorkflowTooManyRequests with default headers values
+func NewStartWorkflowTooManyRequests() *StartWorkflowTooManyRequests {
+	return &StartWorkflowTooManyRequests{}
+}
+
+/*StartWorkflowTooManyRequests handles this case with default header values.
+
+The organization is over its quota of workflows
+*/
+type StartWorkflowTooManyRequests struct {
+	Payload *models.QuotaExceeded
+}
+
+func (o *StartWorkflowTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /workflows][%d] startWorkflowTooManyRequests  %+v", 429, o.Payload)
+}
+
+func (o *StartWorkflowTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.QuotaExceeded)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewStartWorkflowDefault creates a StartWorkflowDefault with default headers values
 func NewStartWorkflowDefault(code int) *StartWorkflowDefault {
 	return &StartWorkflowDefault{
@@ -76,6 +76,11 @@ type UpdateActivityParams struct {
 
 	*/
 	ID string
+	/*IfMatch
+	  Only update the activity if its version still matches
+
+	*/
+	IfMatch *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -148,6 +153,17 @@ func (o *UpdateActivityParams) SetID(id string) {
 	o.ID = id
 }
 
+// WithIfMatch adds the ifMatch to the update activity params
+func (o *UpdateActivityParams) WithIfMatch(ifMatch *string) *UpdateActivityParams {
+	o.SetIfMatch(ifMatch)
+	return o
+}
+
+// SetIfMatch adds the If-Match to the update activity params
+func (o *UpdateActivityParams) SetIfMatch(ifMatch *string) {
+	o.IfMatch = ifMatch
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *UpdateActivityParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -174,6 +190,15 @@ func (o *UpdateActivityParams) WriteToRequest(r runtime.ClientRequest, reg strfm
 		return err
 	}
 
+	if o.IfMatch != nil {
+
+		// header param If-Match
+		if err := r.SetHeaderParam("If-Match", *o.IfMatch); err != nil {
+			return err
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -53,6 +53,20 @@ func (o *UpdateActivityReader) ReadResponse(response runtime.ClientResponse, con
 		}
 		return nil, result
 
+	case 409:
+		result := NewUpdateActivityConflict()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
+	case 412:
+		result := NewUpdateActivityPreconditionFailed()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+
 	default:
 		result := NewUpdateActivityDefault(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -173,6 +187,64 @@ func (o *UpdateActivityNotFound) readResponse(response runtime.ClientResponse, c
 	return nil
 }
 
+// NewUpdateActivityConflict creates a UpdateActivityConflict with default headers values
+func NewUpdateActivityConflict() *UpdateActivityConflict {
+	return &UpdateActivityConflict{}
+}
+
+/*UpdateActivityConflict handles this case with default header values.
+
+The activity already reached a terminal state, returns the activity as it is stored
+*/
+type UpdateActivityConflict struct {
+	Payload *models.Activity
+}
+
+func (o *UpdateActivityConflict) Error() string {
+	return fmt.Sprintf("[PUT /workflows/{id}/activities/{activityId}][%d] updateActivityConflict  %+v", 409, o.Payload)
+}
+
+func (o *UpdateActivityConflict) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Activity)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewUpdateActivityPreconditionFailed creates a UpdateActivityPreconditionFailed with default headers values
+func NewUpdateActivityPreconditionFailed() *UpdateActivityPreconditionFailed {
+	return &UpdateActivityPreconditionFailed{}
+}
+
+/*UpdateActivityPreconditionFailed handles this case with default header values.
+
+The activity was changed since the given version was read
+*/
+type UpdateActivityPreconditionFailed struct {
+	Payload *models.Error
+}
+
+func (o *UpdateActivityPreconditionFailed) Error() string {
+	return fmt.Sprintf("[PUT /workflows/{id}/activities/{activityId}][%d] updateActivityPreconditionFailed  %+v", 412, o.Payload)
+}
+
+func (o *UpdateActivityPreconditionFailed) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewUpdateActivityDefault creates a UpdateActivityDefault with default headers values
 func NewUpdateActivityDefault(code int) *UpdateActivityDefault {
 	return &UpdateActivityDefault{
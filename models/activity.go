@@ -19,6 +19,10 @@ import (
 // swagger:model activity
 type Activity struct {
 
+	// Number of the attempt of the activity the update is for, starting at 1.  Updates for an attempt other than the
+	// current one are rejected.
+	Attempt int32 `json:"attempt,omitempty"`
+
 	// Error explanation
 	Error *ActivityError `json:"error,omitempty"`
 
@@ -26,15 +30,27 @@ type Activity struct {
 	// Required: true
 	ID *string `json:"id"`
 
+	// True if the failure of the activity must not fail the workflow, only used when the activity is failed
+	NonBlocking bool `json:"nonBlocking,omitempty"`
+
 	// Completion percentage for activity
 	PercentComplete int32 `json:"percentComplete,omitempty"`
 
+	// Description of the progress of a running activity
+	ProgressMessage string `json:"progressMessage,omitempty"`
+
 	// Activity output serialized into a json string
 	Result string `json:"result,omitempty"`
 
+	// Name of the step a running activity is at
+	Stage string `json:"stage,omitempty"`
+
 	// Status of activity
 	// Required: true
 	Status *string `json:"status"`
+
+	// Version of the activity, changes every time the activity is updated
+	Version string `json:"version,omitempty"`
 }
 
 // Validate validates this activity
@@ -0,0 +1,198 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ErrEmptySignalName is returned by NewSignalWithPayload when the name of the signal is empty
+var ErrEmptySignalName = errors.New("Signal name must not be empty")
+
+// maxErrorChainDepth bounds how many causes ActivityErrorFromError follows, in case an error returns itself as its cause
+const maxErrorChainDepth = 32
+
+// The constructors in this file are not generated.  They fill in the pointer fields of the generated models so that
+// callers do not need to import github.com/go-openapi/swag just to build a request.
+
+// PostWorkflowOption sets an optional field of the PostWorkflow built by NewPostWorkflow
+type PostWorkflowOption func(*PostWorkflow)
+
+// NewPostWorkflow returns a PostWorkflow with the required fields set.  workflowType should be one of the
+// PostWorkflowWorkflowType... or WorkflowType... constants, see WorkflowType.Validate.  opts set the optional fields, e.g.
+//
+//	NewPostWorkflow(PostWorkflowWorkflowTypePart, entityID, organizationID, WithSupportOptimization())
+func NewPostWorkflow(workflowType string, entityID, organizationID int32, opts ...PostWorkflowOption) *PostWorkflow {
+	postWorkflow := &PostWorkflow{
+		WorkflowType:   swag.String(workflowType),
+		EntityID:       swag.Int32(entityID),
+		OrganizationID: swag.Int32(organizationID),
+	}
+	for _, opt := range opts {
+		opt(postWorkflow)
+	}
+	return postWorkflow
+}
+
+// WithDescription sets Description, a human-readable description to identify the workflow by
+func WithDescription(description string) PostWorkflowOption {
+	return func(p *PostWorkflow) {
+		p.Description = description
+	}
+}
+
+// WithDistortionCompensation sets RunDistortionCompensation
+func WithDistortionCompensation() PostWorkflowOption {
+	return func(p *PostWorkflow) {
+		p.RunDistortionCompensation = true
+	}
+}
+
+// WithDistortionCompensationAfterCutoff sets RunDistortionCompensationAfterCutoff
+func WithDistortionCompensationAfterCutoff() PostWorkflowOption {
+	return func(p *PostWorkflow) {
+		p.RunDistortionCompensationAfterCutoff = true
+	}
+}
+
+// WithExternalID sets ExternalID, the ID of the workflow in the system of the caller (e.g. a job ID) to look it up by
+// with Client.GetWorkflowByExternalID
+func WithExternalID(externalID string) PostWorkflowOption {
+	return func(p *PostWorkflow) {
+		p.ExternalID = externalID
+	}
+}
+
+// WithSupportOptimization sets RunSupportOptimization
+func WithSupportOptimization() PostWorkflowOption {
+	return func(p *PostWorkflow) {
+		p.RunSupportOptimization = true
+	}
+}
+
+// WithMetadata sets Metadata, arbitrary key/value pairs to identify the workflow by
+func WithMetadata(metadata map[string]string) PostWorkflowOption {
+	return func(p *PostWorkflow) {
+		p.Metadata = metadata
+	}
+}
+
+// WithNoQueue sets NoQueue so the workflow API rejects the workflow instead of queuing it when the organization is at
+// capacity
+func WithNoQueue() PostWorkflowOption {
+	return func(p *PostWorkflow) {
+		p.NoQueue = true
+	}
+}
+
+// WithPriority sets Priority, one of the PostWorkflowPriority... constants
+func WithPriority(priority string) PostWorkflowOption {
+	return func(p *PostWorkflow) {
+		p.Priority = priority
+	}
+}
+
+// WithRetryPolicy sets RetryPolicy, how the workflow API retries the activities of the workflow that fail
+func WithRetryPolicy(policy *RetryPolicy) PostWorkflowOption {
+	return func(p *PostWorkflow) {
+		p.RetryPolicy = policy
+	}
+}
+
+// WithStartAt sets StartAt so the workflow API defers the start of the workflow until startAt, e.g. to run it off-peak
+func WithStartAt(startAt time.Time) PostWorkflowOption {
+	return func(p *PostWorkflow) {
+		dateTime := strfmt.DateTime(startAt)
+		p.StartAt = &dateTime
+	}
+}
+
+// WithTimeout sets TimeoutSeconds so the workflow API cancels the workflow if it still runs after timeout.  timeout is
+// rounded up to whole seconds.
+func WithTimeout(timeout time.Duration) PostWorkflowOption {
+	return func(p *PostWorkflow) {
+		p.TimeoutSeconds = int64((timeout + time.Second - 1) / time.Second)
+	}
+}
+
+// NewActivity returns an Activity with the given ID and status.  status should be one of the ActivityStatus... constants.
+func NewActivity(activityID, status string) *Activity {
+	return &Activity{
+		ID:     swag.String(activityID),
+		Status: swag.String(status),
+	}
+}
+
+// NewActivityError returns an ActivityError with the given reason and details
+func NewActivityError(reason, details string) *ActivityError {
+	return &ActivityError{
+		Reason:  swag.String(reason),
+		Details: details,
+	}
+}
+
+// ActivityErrorFromError returns an ActivityError describing err, or nil if err is nil.  Errors that wrap a cause
+// (through an Unwrap() error method, or Cause() error like github.com/pkg/errors) are followed: Reason is the message
+// added by the outermost error and Details lists the message of every error in the chain, one per line.  Details is
+// empty for errors that do not wrap a cause.
+func ActivityErrorFromError(err error) *ActivityError {
+	if err == nil {
+		return nil
+	}
+	var messages []string
+	for cause := err; cause != nil && len(messages) < maxErrorChainDepth; cause = unwrapError(cause) {
+		messages = append(messages, cause.Error())
+	}
+	// wrappers usually prefix the message of their cause, keep only what each one added
+	for i := 0; i < len(messages)-1; i++ {
+		if own := strings.TrimSuffix(messages[i], ": "+messages[i+1]); own != "" {
+			messages[i] = own
+		}
+	}
+	if len(messages) == 1 {
+		return NewActivityError(messages[0], "")
+	}
+	return NewActivityError(messages[0], strings.Join(messages, "\ncaused by: "))
+}
+
+// unwrapError returns the cause wrapped by err, or nil if there is none
+func unwrapError(err error) error {
+	switch wrapper := err.(type) {
+	case interface {
+		Unwrap() error
+	}:
+		return wrapper.Unwrap()
+	case interface {
+		Cause() error
+	}:
+		return wrapper.Cause()
+	}
+	return nil
+}
+
+// NewSignal returns a Signal with the given name and serialized input
+func NewSignal(name, input string) *Signal {
+	return &Signal{
+		Name:  swag.String(name),
+		Input: input,
+	}
+}
+
+// NewSignalWithPayload returns a Signal with the given name whose input is payload serialized as JSON.  It returns
+// ErrEmptySignalName when name is empty and the marshalling error when payload cannot be serialized, e.g.
+//
+//	signal, err := NewSignalWithPayload("pause", map[string]bool{"force": true})
+func NewSignalWithPayload(name string, payload interface{}) (*Signal, error) {
+	if name == "" {
+		return nil, ErrEmptySignalName
+	}
+	input, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	return NewSignal(name, string(input)), nil
+}
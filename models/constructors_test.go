@@ -0,0 +1,174 @@
+package models
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewPostWorkflowExpectsRequiredFieldsSet(t *testing.T) {
+	// act
+	postWorkflow := NewPostWorkflow(PostWorkflowWorkflowTypePart, 5, 7)
+
+	// assert
+	assert.Equal(t, PostWorkflowWorkflowTypePart, *postWorkflow.WorkflowType, "Expected workflow type to be set")
+	assert.EqualValues(t, 5, *postWorkflow.EntityID, "Expected entity ID to be set")
+	assert.EqualValues(t, 7, *postWorkflow.OrganizationID, "Expected organization ID to be set")
+	assert.Nil(t, postWorkflow.Validate(strfmt.Default), "Expected post workflow to be valid")
+}
+
+func TestNewPostWorkflowWithOptionsExpectsOnlyChosenFlagsSet(t *testing.T) {
+	// act
+	postWorkflow := NewPostWorkflow(PostWorkflowWorkflowTypePart, 5, 7, WithSupportOptimization(), WithPriority(PostWorkflowPriorityHigh))
+
+	// assert
+	assert.True(t, postWorkflow.RunSupportOptimization, "Expected support optimization to be set")
+	assert.False(t, postWorkflow.RunDistortionCompensation, "Expected distortion compensation to not be set")
+	assert.False(t, postWorkflow.RunDistortionCompensationAfterCutoff, "Expected distortion compensation after cutoff to not be set")
+	assert.Equal(t, PostWorkflowPriorityHigh, postWorkflow.Priority, "Expected priority to be set")
+	assert.Nil(t, postWorkflow.Validate(strfmt.Default), "Expected post workflow to be valid")
+}
+
+func TestNewPostWorkflowWithDistortionCompensationOptionsExpectsFlagsSet(t *testing.T) {
+	// act
+	postWorkflow := NewPostWorkflow(PostWorkflowWorkflowTypeBuildFile, 5, 7, WithDistortionCompensation(), WithDistortionCompensationAfterCutoff())
+
+	// assert
+	assert.True(t, postWorkflow.RunDistortionCompensation, "Expected distortion compensation to be set")
+	assert.True(t, postWorkflow.RunDistortionCompensationAfterCutoff, "Expected distortion compensation after cutoff to be set")
+	assert.False(t, postWorkflow.RunSupportOptimization, "Expected support optimization to not be set")
+	assert.Equal(t, "", postWorkflow.Priority, "Expected priority to be left to the workflow API")
+}
+
+func TestNewPostWorkflowWithTimeoutExpectsTimeoutSecondsRoundedUp(t *testing.T) {
+	// act
+	postWorkflow := NewPostWorkflow(PostWorkflowWorkflowTypePart, 5, 7, WithTimeout(90*time.Minute+500*time.Millisecond))
+
+	// assert
+	assert.EqualValues(t, 5401, postWorkflow.TimeoutSeconds, "Expected the timeout in whole seconds")
+	assert.Nil(t, postWorkflow.Validate(strfmt.Default), "Expected post workflow to be valid")
+}
+
+func TestPostWorkflowValidateWhenTimeoutNegativeExpectsError(t *testing.T) {
+	// arrange
+	postWorkflow := NewPostWorkflow(PostWorkflowWorkflowTypePart, 5, 7)
+	postWorkflow.TimeoutSeconds = -1
+
+	// act
+	err := postWorkflow.Validate(strfmt.Default)
+
+	// assert
+	assert.NotNil(t, err, "Expected a negative timeout to be invalid")
+}
+
+func TestNewActivityExpectsIDAndStatusSet(t *testing.T) {
+	// act
+	activity := NewActivity("activity id", ActivityStatusCompleted)
+
+	// assert
+	assert.Equal(t, "activity id", *activity.ID, "Expected activity ID to be set")
+	assert.Equal(t, ActivityStatusCompleted, *activity.Status, "Expected status to be set")
+	assert.Nil(t, activity.Validate(strfmt.Default), "Expected activity to be valid")
+}
+
+func TestNewActivityErrorExpectsReasonAndDetailsSet(t *testing.T) {
+	// act
+	activityError := NewActivityError("reason", "details")
+
+	// assert
+	assert.Equal(t, "reason", *activityError.Reason, "Expected reason to be set")
+	assert.Equal(t, "details", activityError.Details, "Expected details to be set")
+}
+
+// unwrapper wraps a cause the way fmt.Errorf("%v: %w") does
+type unwrapper struct {
+	message string
+	cause   error
+}
+
+func (e *unwrapper) Error() string { return e.message + ": " + e.cause.Error() }
+func (e *unwrapper) Unwrap() error { return e.cause }
+
+// causer wraps a cause the way github.com/pkg/errors.Wrap does
+type causer struct {
+	message string
+	cause   error
+}
+
+func (e *causer) Error() string { return e.message + ": " + e.cause.Error() }
+func (e *causer) Cause() error  { return e.cause }
+
+func TestActivityErrorFromErrorWhenWrappedExpectsOutermostReasonAndChainInDetails(t *testing.T) {
+	// arrange
+	err := &unwrapper{message: "running solver", cause: &causer{message: "reading mesh", cause: errors.New("part.stl not found")}}
+
+	// act
+	activityError := ActivityErrorFromError(err)
+
+	// assert
+	assert.Equal(t, "running solver", *activityError.Reason, "Expected the message of the outermost error as reason")
+	assert.Equal(t, "running solver\ncaused by: reading mesh\ncaused by: part.stl not found", activityError.Details, "Expected every error of the chain in details")
+	assert.Nil(t, activityError.Validate(strfmt.Default), "Expected activity error to be valid")
+}
+
+func TestActivityErrorFromErrorWhenNotWrappedExpectsMessageAsReasonAndNoDetails(t *testing.T) {
+	// act
+	activityError := ActivityErrorFromError(errors.New("Out of memory"))
+
+	// assert
+	assert.Equal(t, "Out of memory", *activityError.Reason, "Expected the message as reason")
+	assert.Empty(t, activityError.Details, "Expected no details")
+}
+
+func TestActivityErrorFromErrorWhenNilExpectsNil(t *testing.T) {
+	// act
+	activityError := ActivityErrorFromError(nil)
+
+	// assert
+	assert.Nil(t, activityError, "Expected no activity error for a nil error")
+}
+
+func TestNewSignalExpectsNameAndInputSet(t *testing.T) {
+	// act
+	signal := NewSignal("pause", `{"force":true}`)
+
+	// assert
+	assert.Equal(t, "pause", *signal.Name, "Expected name to be set")
+	assert.Equal(t, `{"force":true}`, signal.Input, "Expected input to be set")
+	assert.Nil(t, signal.Validate(strfmt.Default), "Expected signal to be valid")
+}
+
+func TestNewSignalWithPayload(t *testing.T) {
+	t.Run("WhenPayloadSerializableExpectsInputSetToJSON", func(t *testing.T) {
+		// act
+		signal, err := NewSignalWithPayload("pause", map[string]bool{"force": true})
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.Equal(t, "pause", *signal.Name, "Expected name to be set")
+		assert.Equal(t, `{"force":true}`, signal.Input, "Expected input to be the serialized payload")
+		assert.Nil(t, signal.Validate(strfmt.Default), "Expected signal to be valid")
+	})
+
+	t.Run("WhenPayloadNotSerializableExpectsMarshallingError", func(t *testing.T) {
+		// act
+		signal, err := NewSignalWithPayload("pause", make(chan int))
+
+		// assert
+		assert.Nil(t, signal, "Expected no signal")
+		assert.IsType(t, &json.UnsupportedTypeError{}, err, "Expected the marshalling error")
+	})
+
+	t.Run("WhenNameEmptyExpectsError", func(t *testing.T) {
+		// act
+		signal, err := NewSignalWithPayload("", nil)
+
+		// assert
+		assert.Nil(t, signal, "Expected no signal")
+		assert.Equal(t, ErrEmptySignalName, err, "Expected an empty name error")
+	})
+}
@@ -17,6 +17,9 @@ import (
 // swagger:model heartbeat
 type Heartbeat struct {
 
+	// Only valid in request message. True if the worker relinquishes the activity so that it is rescheduled without waiting for the heartbeat timeout.
+	Abandoned bool `json:"abandoned,omitempty"`
+
 	// activity ID
 	// Required: true
 	ActivityID *string `json:"activityId"`
@@ -24,9 +27,15 @@ type Heartbeat struct {
 	// Only valid in return message. True if activity has been cancelled, false otherwise.
 	Cancelled bool `json:"cancelled,omitempty"`
 
+	// Only valid in return message. Why the activity has been cancelled, e.g. requested by a user or superseded by another workflow. Empty when not given.
+	CancellationReason string `json:"cancellationReason,omitempty"`
+
 	// details
 	Details string `json:"details,omitempty"`
 
+	// Only valid in request message. Seconds the workflow API waits for the next heartbeat of the activity instead of its heartbeat timeout, once.
+	ExtendTimeoutSeconds int64 `json:"extendTimeoutSeconds,omitempty"`
+
 	// task token
 	// Required: true
 	TaskToken *string `json:"taskToken"`
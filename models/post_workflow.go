@@ -19,6 +19,9 @@ import (
 // swagger:model postWorkflow
 type PostWorkflow struct {
 
+	// human-readable description of the workflow
+	Description string `json:"description,omitempty"`
+
 	// the serialized JSON represnetation of the dynamic workflow graph
 	DynamicWorkflowGraph *DynamicWorkflow `json:"dynamicWorkflowGraph,omitempty"`
 
@@ -26,10 +29,25 @@ type PostWorkflow struct {
 	// Required: true
 	EntityID *int32 `json:"entityId"`
 
+	// identifier of the workflow in the system of the caller, e.g. a job ID, to look the workflow up by.  Unique within the organization.
+	ExternalID string `json:"externalId,omitempty"`
+
+	// arbitrary key/value pairs identifying the workflow
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// True to reject the workflow with a 409 instead of queuing it when the organization is at capacity
+	NoQueue bool `json:"noQueue,omitempty"`
+
 	// organization Id
 	// Required: true
 	OrganizationID *int32 `json:"organizationId"`
 
+	// scheduling priority of the workflow when it waits on capacity.  Normal when empty.
+	Priority string `json:"priority,omitempty"`
+
+	// how the activities of the workflow are retried when they fail.  The workflow API default when empty.
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
 	// True if distortion compensation needs to be performed
 	RunDistortionCompensation bool `json:"runDistortionCompensation,omitempty"`
 
@@ -39,6 +57,13 @@ type PostWorkflow struct {
 	// True if support optimization needs to be performed
 	RunSupportOptimization bool `json:"runSupportOptimization,omitempty"`
 
+	// when the workflow API starts the workflow, it must be in the future.  Right away when empty.
+	StartAt *strfmt.DateTime `json:"startAt,omitempty"`
+
+	// seconds after which the workflow API cancels the workflow if it is still running.  No deadline when empty.
+	// Minimum: 1
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty"`
+
 	// workflow type
 	// Required: true
 	WorkflowType *string `json:"workflowType"`
@@ -63,6 +88,26 @@ func (m *PostWorkflow) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validatePriority(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateRetryPolicy(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateStartAt(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateTimeoutSeconds(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if err := m.validateWorkflowType(formats); err != nil {
 		// prop
 		res = append(res, err)
@@ -111,6 +156,49 @@ func (m *PostWorkflow) validateOrganizationID(formats strfmt.Registry) error {
 	return nil
 }
 
+var postWorkflowTypePriorityPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Low","Normal","High"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		postWorkflowTypePriorityPropEnum = append(postWorkflowTypePriorityPropEnum, v)
+	}
+}
+
+const (
+	// PostWorkflowPriorityLow captures enum value "Low"
+	PostWorkflowPriorityLow string = "Low"
+	// PostWorkflowPriorityNormal captures enum value "Normal"
+	PostWorkflowPriorityNormal string = "Normal"
+	// PostWorkflowPriorityHigh captures enum value "High"
+	PostWorkflowPriorityHigh string = "High"
+)
+
+// prop value enum
+func (m *PostWorkflow) validatePriorityEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, postWorkflowTypePriorityPropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PostWorkflow) validatePriority(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Priority) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validatePriorityEnum("priority", "body", m.Priority); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 var postWorkflowTypeWorkflowTypePropEnum []interface{}
 
 func init() {
@@ -156,6 +244,51 @@ func (m *PostWorkflow) validateWorkflowTypeEnum(path, location string, value str
 	return nil
 }
 
+func (m *PostWorkflow) validateRetryPolicy(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RetryPolicy) { // not required
+		return nil
+	}
+
+	if m.RetryPolicy != nil {
+
+		if err := m.RetryPolicy.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("retryPolicy")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *PostWorkflow) validateStartAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.StartAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("startAt", "body", "date-time", m.StartAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PostWorkflow) validateTimeoutSeconds(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.TimeoutSeconds) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("timeoutSeconds", "body", int64(m.TimeoutSeconds), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PostWorkflow) validateWorkflowType(formats strfmt.Registry) error {
 
 	if err := validate.Required("workflowType", "body", m.WorkflowType); err != nil {
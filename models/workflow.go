@@ -6,12 +6,14 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
 	"strconv"
 
 	strfmt "github.com/go-openapi/strfmt"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Workflow workflow
@@ -21,10 +23,44 @@ type Workflow struct {
 	// list of activities associated with this workflow
 	Activities []*Activity `json:"activities"`
 
+	// when the workflow was started
+	// Read Only: true
+	CreatedAt strfmt.DateTime `json:"createdAt,omitempty"`
+
+	// human-readable description of the workflow
+	Description string `json:"description,omitempty"`
+
+	// when the workflow ended (completed, failed, cancelled or timed out), unset while it has not ended
+	// Read Only: true
+	EndedAt strfmt.DateTime `json:"endedAt,omitempty"`
+
+	// identifier of the workflow in the system that started it, see PostWorkflow.ExternalID
+	// Read Only: true
+	ExternalID string `json:"externalId,omitempty"`
+
 	// id of workflow
 	// Read Only: true
 	ID string `json:"id,omitempty"`
 
+	// arbitrary key/value pairs identifying the workflow
+	Metadata map[string]string `json:"metadata,omitempty"`
+
+	// organization the workflow belongs to
+	// Read Only: true
+	OrganizationID int32 `json:"organizationId,omitempty"`
+
+	// id of the workflow that started this workflow, empty for a top-level workflow
+	// Read Only: true
+	ParentID string `json:"parentId,omitempty"`
+
+	// output of a completed workflow serialized into a json string, empty until the workflow completed
+	// Read Only: true
+	Result string `json:"result,omitempty"`
+
+	// when the workflow started executing after being queued, unset while it is queued
+	// Read Only: true
+	StartedAt strfmt.DateTime `json:"startedAt,omitempty"`
+
 	// the current state of this workflow
 	// Read Only: true
 	State string `json:"state,omitempty"`
@@ -42,6 +78,26 @@ func (m *Workflow) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCreatedAt(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateEndedAt(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		// prop
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -75,6 +131,92 @@ func (m *Workflow) validateActivities(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Workflow) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Workflow) validateEndedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.EndedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("endedAt", "body", "date-time", m.EndedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Workflow) validateStartedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.StartedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("startedAt", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var workflowTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["Running","Completed","Failed","Cancelled","TimedOut"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		workflowTypeStatePropEnum = append(workflowTypeStatePropEnum, v)
+	}
+}
+
+const (
+	// WorkflowStateRunning captures enum value "Running"
+	WorkflowStateRunning string = "Running"
+	// WorkflowStateCompleted captures enum value "Completed"
+	WorkflowStateCompleted string = "Completed"
+	// WorkflowStateFailed captures enum value "Failed"
+	WorkflowStateFailed string = "Failed"
+	// WorkflowStateCancelled captures enum value "Cancelled"
+	WorkflowStateCancelled string = "Cancelled"
+	// WorkflowStateTimedOut captures enum value "TimedOut"
+	WorkflowStateTimedOut string = "TimedOut"
+)
+
+// prop value enum
+func (m *Workflow) validateStateEnum(path, location string, value string) error {
+	if err := validate.Enum(path, location, value, workflowTypeStatePropEnum); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Workflow) validateState(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *Workflow) MarshalBinary() ([]byte, error) {
 	if m == nil {
@@ -0,0 +1,43 @@
+package models
+
+import (
+	strfmt "github.com/go-openapi/strfmt"
+
+	"github.com/go-openapi/swag"
+)
+
+// WorkflowBundle is not generated, the workflow API has no such resource.  It is assembled by
+// workflow.Client.ExportWorkflow from several responses so that everything known about a workflow can be saved as one
+// JSON document, e.g. to attach it to a support ticket.
+type WorkflowBundle struct {
+
+	// every activity of the workflow, with their errors and results
+	Activities []*Activity `json:"activities"`
+
+	// when the bundle was assembled
+	ExportedAt strfmt.DateTime `json:"exportedAt"`
+
+	// the events of the workflow, oldest first
+	History []*HistoryEvent `json:"history"`
+
+	// the workflow as returned by the workflow API
+	Workflow *Workflow `json:"workflow"`
+}
+
+// MarshalBinary interface implementation
+func (m *WorkflowBundle) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *WorkflowBundle) UnmarshalBinary(b []byte) error {
+	var res WorkflowBundle
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// The methods in this file are not generated.  They derive the time a workflow spent queued and executing from the
+// CreatedAt, StartedAt and EndedAt timestamps returned by the workflow API.
+
+// QueuedDuration returns how long the workflow waited before it started executing: from CreatedAt to StartedAt, or to
+// now while the workflow is still queued.  Pass time.Now() as now, a fixed time is only useful in tests.  0 is returned
+// when CreatedAt is not set.
+func (m *Workflow) QueuedDuration(now time.Time) time.Duration {
+	if isZeroDateTime(m.CreatedAt) {
+		return 0
+	}
+	end := now
+	if !isZeroDateTime(m.StartedAt) {
+		end = time.Time(m.StartedAt)
+	} else if !isZeroDateTime(m.EndedAt) {
+		// ended, e.g. cancelled, without ever leaving the queue
+		end = time.Time(m.EndedAt)
+	}
+	return nonNegative(end.Sub(time.Time(m.CreatedAt)))
+}
+
+// ExecutionDuration returns how long the workflow has been executing: from StartedAt to EndedAt, or to now while the
+// workflow is still running.  Pass time.Now() as now, a fixed time is only useful in tests.  0 is returned while the
+// workflow is queued.
+func (m *Workflow) ExecutionDuration(now time.Time) time.Duration {
+	if isZeroDateTime(m.StartedAt) {
+		return 0
+	}
+	end := now
+	if !isZeroDateTime(m.EndedAt) {
+		end = time.Time(m.EndedAt)
+	}
+	return nonNegative(end.Sub(time.Time(m.StartedAt)))
+}
+
+func isZeroDateTime(t strfmt.DateTime) bool {
+	return time.Time(t).IsZero()
+}
+
+// nonNegative clamps d to 0, in case the clocks of the workflow API and of the caller disagree
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
@@ -0,0 +1,70 @@
+package models
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowDurations(t *testing.T) {
+	// arrange
+	now := time.Date(2018, 3, 1, 12, 0, 0, 0, time.UTC)
+	// newWorkflow decodes a workflow the way it is returned by GetWorkflow
+	newWorkflow := func(body string) *Workflow {
+		var workflow Workflow
+		if err := json.Unmarshal([]byte(body), &workflow); err != nil {
+			t.Fatal(err)
+		}
+		return &workflow
+	}
+
+	t.Run("WhenEndedExpectsQueuedAndExecutionDurations", func(t *testing.T) {
+		// arrange
+		workflow := newWorkflow(`{"createdAt":"2018-03-01T10:00:00Z","startedAt":"2018-03-01T10:05:00Z","endedAt":"2018-03-01T11:05:00Z","state":"Completed"}`)
+
+		// act
+		queued, execution := workflow.QueuedDuration(now), workflow.ExecutionDuration(now)
+
+		// assert
+		assert.Equal(t, 5*time.Minute, queued, "Expected the time from creation to start")
+		assert.Equal(t, time.Hour, execution, "Expected the time from start to end")
+	})
+
+	t.Run("WhenRunningExpectsExecutionUntilNow", func(t *testing.T) {
+		// arrange
+		workflow := newWorkflow(`{"createdAt":"2018-03-01T10:00:00Z","startedAt":"2018-03-01T11:30:00Z","state":"Running"}`)
+
+		// act
+		queued, execution := workflow.QueuedDuration(now), workflow.ExecutionDuration(now)
+
+		// assert
+		assert.Equal(t, 90*time.Minute, queued, "Expected the time from creation to start")
+		assert.Equal(t, 30*time.Minute, execution, "Expected the time from start to now")
+	})
+
+	t.Run("WhenQueuedExpectsQueuedUntilNowAndNoExecution", func(t *testing.T) {
+		// arrange
+		workflow := newWorkflow(`{"createdAt":"2018-03-01T11:00:00Z","state":"Running","waitingOnCapacity":true}`)
+
+		// act
+		queued, execution := workflow.QueuedDuration(now), workflow.ExecutionDuration(now)
+
+		// assert
+		assert.Equal(t, time.Hour, queued, "Expected the time from creation to now")
+		assert.Equal(t, time.Duration(0), execution, "Expected no execution while queued")
+	})
+
+	t.Run("WhenCancelledWhileQueuedExpectsQueuedUntilEnd", func(t *testing.T) {
+		// arrange
+		workflow := newWorkflow(`{"createdAt":"2018-03-01T11:00:00Z","endedAt":"2018-03-01T11:10:00Z","state":"Cancelled"}`)
+
+		// act
+		queued, execution := workflow.QueuedDuration(now), workflow.ExecutionDuration(now)
+
+		// assert
+		assert.Equal(t, 10*time.Minute, queued, "Expected the time from creation to end")
+		assert.Equal(t, time.Duration(0), execution, "Expected no execution")
+	})
+}
@@ -0,0 +1,123 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+)
+
+// This file is not generated.  It adds a type for the workflow types so they can be checked before a workflow is
+// started.
+
+// maxWorkflowTypeSuggestionDistance is how many letters a workflow type may differ from a known one to be suggested
+const maxWorkflowTypeSuggestionDistance = 2
+
+// WorkflowType is a type of workflow the workflow API can start.  The WorkflowType... constants hold every known type,
+// they have the values of the generated PostWorkflowWorkflowType... constants.
+type WorkflowType string
+
+// The known workflow types
+const (
+	WorkflowTypeAssumedStrain  WorkflowType = "AssumedStrain"
+	WorkflowTypePart           WorkflowType = "Part"
+	WorkflowTypeBuildFile      WorkflowType = "BuildFile"
+	WorkflowTypeScanPattern    WorkflowType = "ScanPattern"
+	WorkflowTypeThermalStrain  WorkflowType = "ThermalStrain"
+	WorkflowTypeThermal        WorkflowType = "Thermal"
+	WorkflowTypeSingleBead     WorkflowType = "SingleBead"
+	WorkflowTypePorosity       WorkflowType = "Porosity"
+	WorkflowTypePartSupport    WorkflowType = "PartSupport"
+	WorkflowTypeDynamic        WorkflowType = "Dynamic"
+	WorkflowTypeMicrostructure WorkflowType = "Microstructure"
+)
+
+var workflowTypes = []WorkflowType{
+	WorkflowTypeAssumedStrain,
+	WorkflowTypePart,
+	WorkflowTypeBuildFile,
+	WorkflowTypeScanPattern,
+	WorkflowTypeThermalStrain,
+	WorkflowTypeThermal,
+	WorkflowTypeSingleBead,
+	WorkflowTypePorosity,
+	WorkflowTypePartSupport,
+	WorkflowTypeDynamic,
+	WorkflowTypeMicrostructure,
+}
+
+// WorkflowTypes returns every known workflow type
+func WorkflowTypes() []WorkflowType {
+	return append([]WorkflowType(nil), workflowTypes...)
+}
+
+// Validate returns a *WorkflowTypeError if t is not one of the known workflow types.  The check is case sensitive like
+// the one made by the workflow API.
+func (t WorkflowType) Validate() error {
+	for _, known := range workflowTypes {
+		if t == known {
+			return nil
+		}
+	}
+	return &WorkflowTypeError{WorkflowType: string(t), Suggestion: suggestWorkflowType(string(t))}
+}
+
+// WorkflowTypeError is returned when a workflow type is not one of the known workflow types
+type WorkflowTypeError struct {
+	WorkflowType string
+	// Suggestion is the known workflow type closest to WorkflowType, empty if none is close
+	Suggestion WorkflowType
+}
+
+func (e *WorkflowTypeError) Error() string {
+	if e.Suggestion != "" {
+		return fmt.Sprintf("Workflow type %q is not valid, did you mean %q?", e.WorkflowType, e.Suggestion)
+	}
+	names := make([]string, len(workflowTypes))
+	for i, known := range workflowTypes {
+		names[i] = string(known)
+	}
+	return fmt.Sprintf("Workflow type %q is not valid, it must be one of %v", e.WorkflowType, strings.Join(names, ", "))
+}
+
+// suggestWorkflowType returns the known workflow type that differs the least from workflowType (ignoring case), or ""
+// if they all differ by more than a few letters
+func suggestWorkflowType(workflowType string) WorkflowType {
+	var suggestion WorkflowType
+	best := maxWorkflowTypeSuggestionDistance + 1
+	for _, known := range workflowTypes {
+		if distance := editDistance(strings.ToLower(workflowType), strings.ToLower(string(known))); distance < best {
+			suggestion, best = known, distance
+		}
+	}
+	return suggestion
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	current := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,43 @@
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWorkflowTypeValidate(t *testing.T) {
+	t.Run("WhenEveryKnownTypeExpectsNoError", func(t *testing.T) {
+		for _, workflowType := range WorkflowTypes() {
+			// act
+			err := workflowType.Validate()
+
+			// assert
+			assert.Nil(t, err, "Expected %v to be valid", workflowType)
+		}
+	})
+
+	t.Run("WhenTypeMisspelledExpectsErrorSuggestingKnownType", func(t *testing.T) {
+		// arrange
+		workflowType := WorkflowType("thermalstrian")
+
+		// act
+		err := workflowType.Validate()
+
+		// assert
+		assert.Equal(t, &WorkflowTypeError{WorkflowType: "thermalstrian", Suggestion: WorkflowTypeThermalStrain}, err, "Expected the closest type to be suggested")
+		assert.EqualError(t, err, `Workflow type "thermalstrian" is not valid, did you mean "ThermalStrain"?`)
+	})
+
+	t.Run("WhenTypeUnknownExpectsErrorListingKnownTypes", func(t *testing.T) {
+		// arrange
+		workflowType := WorkflowType("Casting")
+
+		// act
+		err := workflowType.Validate()
+
+		// assert
+		assert.Equal(t, &WorkflowTypeError{WorkflowType: "Casting"}, err, "Expected no suggestion for an unrelated type")
+		assert.Contains(t, err.Error(), "AssumedStrain, Part, BuildFile", "Expected the known types to be listed")
+	})
+}
@@ -0,0 +1,74 @@
+package workflow
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/swag"
+)
+
+// artifactReference is the result of an activity whose result is too large to be stored inline, it points to where
+// the result is stored instead
+type artifactReference struct {
+	ArtifactURL string `json:"artifactUrl"`
+}
+
+// artifactURL returns the URL of the artifact result refers to, if it is an artifact reference
+func artifactURL(result string) (*url.URL, bool) {
+	trimmed := strings.TrimSpace(result)
+	if !strings.HasPrefix(trimmed, "{") {
+		return nil, false
+	}
+	var reference artifactReference
+	if err := json.Unmarshal([]byte(trimmed), &reference); err != nil || reference.ArtifactURL == "" {
+		return nil, false
+	}
+	artifact, err := url.Parse(reference.ArtifactURL)
+	if err != nil || (artifact.Scheme != "http" && artifact.Scheme != "https") || artifact.Host == "" {
+		return nil, false
+	}
+	return artifact, true
+}
+
+func (c *client) DownloadActivityResult(activity *models.Activity, w io.Writer) error {
+	artifact, ok := artifactURL(activity.Result)
+	if !ok {
+		_, err := io.WriteString(w, activity.Result)
+		return err
+	}
+	req, err := http.NewRequest(http.MethodGet, artifact.String(), nil)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(c.ctx)
+	// the token is only sent to the API gateway, storage elsewhere gets a URL that grants access on its own
+	if gateway, err := url.Parse(c.apiGatewayURL); err == nil && gateway.Host == artifact.Host {
+		token, err := c.token()
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	c.logger.Info("Downloading activity result", "activityID", swag.StringValue(activity.ID), "url", artifact.String())
+	httpClient := &http.Client{}
+	if c.options.transport != nil {
+		httpClient.Transport = c.options.transport
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		c.logger.Error("Problem downloading activity result", "url", artifact.String(), "error", err)
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		err := &ArtifactDownloadError{URL: artifact.String(), StatusCode: resp.StatusCode}
+		c.logger.Error("Problem downloading activity result", "url", artifact.String(), "error", err)
+		return err
+	}
+	_, err = io.Copy(w, resp.Body)
+	return err
+}
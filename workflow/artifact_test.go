@@ -0,0 +1,106 @@
+package workflow
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDownloadActivityResult(t *testing.T) {
+	// arrange
+	artifactPath := "/artifacts/result-1"
+	artifact := `{"mesh":"` + string(bytes.Repeat([]byte("x"), 100000)) + `"}`
+	// newArtifactServer serves the artifact, keeping the authorization header of the request
+	newArtifactServer := func(authorization *string) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(artifactPath, func(w http.ResponseWriter, r *http.Request) {
+			*authorization = r.Header.Get("Authorization")
+			w.Write([]byte(artifact))
+		}).Methods("GET")
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenResultInlineExpectsResultWritten", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+		activity := &models.Activity{ID: swag.String("activity-1"), Result: `{"volume":42}`}
+		var buf bytes.Buffer
+
+		// act
+		err := client.DownloadActivityResult(activity, &buf)
+
+		// assert
+		assert.Nil(t, err, "Expected no error writing an inline result")
+		assert.Equal(t, `{"volume":42}`, buf.String(), "Expected the inline result")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no token to be fetched")
+	})
+
+	t.Run("WhenResultOnGatewayExpectsArtifactDownloadedWithToken", func(t *testing.T) {
+		// arrange
+		var authorization string
+		testServer := newArtifactServer(&authorization)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		activity := &models.Activity{ID: swag.String("activity-1"), Result: `{"artifactUrl":"` + testServer.URL + artifactPath + `"}`}
+		var buf bytes.Buffer
+
+		// act
+		err := client.DownloadActivityResult(activity, &buf)
+
+		// assert
+		assert.Nil(t, err, "Expected no error downloading the artifact")
+		assert.Equal(t, artifact, buf.String(), "Expected the artifact to be written")
+		assert.Equal(t, "Bearer token", authorization, "Expected the bearer token to be sent to the API gateway")
+	})
+
+	t.Run("WhenResultElsewhereExpectsArtifactDownloadedWithoutToken", func(t *testing.T) {
+		// arrange
+		var authorization string
+		testServer := newArtifactServer(&authorization)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+		activity := &models.Activity{ID: swag.String("activity-1"), Result: `{"artifactUrl":"` + testServer.URL + artifactPath + `"}`}
+		var buf bytes.Buffer
+
+		// act
+		err := client.DownloadActivityResult(activity, &buf)
+
+		// assert
+		assert.Nil(t, err, "Expected no error downloading the artifact")
+		assert.Equal(t, artifact, buf.String(), "Expected the artifact to be written")
+		assert.Empty(t, authorization, "Expected the token to not be sent outside of the API gateway")
+	})
+
+	t.Run("WhenArtifactMissingExpectsArtifactDownloadError", func(t *testing.T) {
+		// arrange
+		var authorization string
+		testServer := newArtifactServer(&authorization)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		missingURL := testServer.URL + "/artifacts/missing"
+		activity := &models.Activity{ID: swag.String("activity-1"), Result: `{"artifactUrl":"` + missingURL + `"}`}
+		var buf bytes.Buffer
+
+		// act
+		err := client.DownloadActivityResult(activity, &buf)
+
+		// assert
+		assert.Equal(t, &ArtifactDownloadError{URL: missingURL, StatusCode: http.StatusNotFound}, err, "Expected an *ArtifactDownloadError")
+		assert.Empty(t, buf.String(), "Expected nothing to be written")
+	})
+}
@@ -0,0 +1,107 @@
+package workflow
+
+import (
+	"context"
+	"time"
+
+	"github.com/3dsim/workflow-goclient/genclient/operations"
+	"github.com/3dsim/workflow-goclient/models"
+	openapiclient "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/swag"
+)
+
+// capacityWait is how long the workflow API holds a capacity request open when no capacity becomes available.  It is a
+// variable so tests can shorten it.
+var capacityWait = 20 * time.Second
+
+// capacityRetryDelay is the delay before making a failed capacity request again, it doubles with every consecutive
+// failure up to maxCapacityRetryDelay.  It is a variable so tests can shorten it.
+var capacityRetryDelay = 1 * time.Second
+
+const maxCapacityRetryDelay = 1 * time.Minute
+
+func (c *client) WatchCapacity(ctx context.Context, organizationID int32) (<-chan *models.CapacityEvent, error) {
+	if organizationID <= 0 {
+		return nil, &OrganizationIDError{OrganizationID: organizationID}
+	}
+	if err := c.checkOrganization(organizationID); err != nil {
+		return nil, err
+	}
+	c.logger.Info("Watching capacity", "organizationID", organizationID)
+	events := make(chan *models.CapacityEvent)
+	go c.WithContext(ctx).(*client).watchCapacity(organizationID, events)
+	return events, nil
+}
+
+// watchCapacity long-polls the capacity events of the organization and sends them on events until c.ctx is closed
+func (c *client) watchCapacity(organizationID int32, events chan<- *models.CapacityEvent) {
+	defer close(events)
+	cursor := ""
+	delay := capacityRetryDelay
+	for {
+		page, err := c.waitForCapacityEvents(organizationID, cursor)
+		if c.ctx.Err() != nil {
+			c.logger.Info("Stopped watching capacity", "organizationID", organizationID)
+			return
+		}
+		if err != nil {
+			if isCapacityRefused(err) {
+				c.logger.Error("Capacity request refused, stopped watching capacity", "organizationID", organizationID, "error", err)
+				return
+			}
+			c.logger.Warn("Problem waiting for capacity, reconnecting", "organizationID", organizationID, "error", err, "delay", delay)
+			if !sleep(c.ctx, delay) {
+				return
+			}
+			if delay *= 2; delay > maxCapacityRetryDelay {
+				delay = maxCapacityRetryDelay
+			}
+			continue
+		}
+		delay = capacityRetryDelay
+		for _, event := range page.Events {
+			select {
+			case events <- event:
+			case <-c.ctx.Done():
+				return
+			}
+		}
+		if page.NextCursor != "" {
+			cursor = page.NextCursor
+		}
+	}
+}
+
+func (c *client) waitForCapacityEvents(organizationID int32, cursor string) (*models.CapacityEventPage, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	params := operations.NewWaitForCapacityEventsParams().
+		WithContext(c.ctx).
+		WithTimeout(capacityWait + openapiclient.DefaultTimeout).
+		WithOrganizationID(organizationID).
+		WithWait(swag.Int32(int32(capacityWait / time.Second)))
+	if cursor != "" {
+		params.SetAfter(swag.String(cursor))
+	}
+	c.logger.Debug("Waiting for capacity", "organizationID", organizationID, "cursor", cursor)
+	response, err := c.client.Operations.WaitForCapacityEvents(params, openapiclient.BearerToken(token))
+	if err != nil {
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+// isCapacityRefused tells if err is an answer of the workflow API that making the capacity request again would not
+// change, or that the client was closed
+func isCapacityRefused(err error) bool {
+	if err == ErrClientClosed {
+		return true
+	}
+	switch err.(type) {
+	case *operations.WaitForCapacityEventsUnauthorized, *operations.WaitForCapacityEventsForbidden, *operations.WaitForCapacityEventsNotFound:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,143 @@
+package workflow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchCapacity(t *testing.T) {
+	// arrange
+	defer func(wait, delay time.Duration) { capacityWait, capacityRetryDelay = wait, delay }(capacityWait, capacityRetryDelay)
+	capacityWait = 20 * time.Millisecond
+	capacityRetryDelay = 10 * time.Millisecond
+	organizationID := int32(7)
+	endpoint := "/" + workflowAPIBasePath + "/organizations/{organizationId}/capacity/events"
+	// newServer answers the first request with the status, then sends the canned event and then waits for capacity
+	// that never becomes available
+	newServer := func(firstStatus int, requests *int32) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			request := atomic.AddInt32(requests, 1)
+			if request == 1 && firstStatus != http.StatusOK {
+				w.WriteHeader(firstStatus)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("after") == "" {
+				w.Write([]byte(`{"events":[{"organizationId":7,"availableSlots":3}],"nextCursor":"cursor-1"}`))
+				return
+			}
+			time.Sleep(capacityWait)
+			w.Write([]byte(`{"events":[],"nextCursor":"cursor-1"}`))
+		})
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenCapacityBecomesAvailableExpectsEventSent", func(t *testing.T) {
+		// arrange
+		var requests int32
+		testServer := newServer(http.StatusOK, &requests)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// act
+		events, err := client.WatchCapacity(ctx, organizationID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		event, ok := <-events
+		if assert.True(t, ok, "Expected an event before the channel was closed") {
+			assert.Equal(t, &models.CapacityEvent{OrganizationID: organizationID, AvailableSlots: 3}, event, "Expected the canned event")
+		}
+	})
+
+	t.Run("WhenRequestFailsExpectsReconnected", func(t *testing.T) {
+		// arrange
+		var requests int32
+		testServer := newServer(http.StatusInternalServerError, &requests)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// act
+		events, _ := client.WatchCapacity(ctx, organizationID)
+
+		// assert
+		_, ok := <-events
+		assert.True(t, ok, "Expected the event sent after reconnecting")
+		assert.True(t, atomic.LoadInt32(&requests) >= 2, "Expected the request to be made again")
+	})
+
+	t.Run("WhenContextClosedExpectsChannelClosed", func(t *testing.T) {
+		// arrange
+		var requests int32
+		testServer := newServer(http.StatusOK, &requests)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		ctx, cancel := context.WithCancel(context.Background())
+		events, _ := client.WatchCapacity(ctx, organizationID)
+		<-events
+
+		// act
+		cancel()
+
+		// assert
+		select {
+		case _, ok := <-events:
+			assert.False(t, ok, "Expected no event after the context was closed")
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "Expected the channel to be closed")
+		}
+	})
+
+	t.Run("WhenRequestForbiddenExpectsChannelClosed", func(t *testing.T) {
+		// arrange
+		var requests int32
+		testServer := newServer(http.StatusForbidden, &requests)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// act
+		events, _ := client.WatchCapacity(ctx, organizationID)
+
+		// assert
+		_, ok := <-events
+		assert.False(t, ok, "Expected the channel to be closed without an event")
+		assert.EqualValues(t, 1, atomic.LoadInt32(&requests), "Expected the request to not be made again")
+	})
+
+	t.Run("WhenOrganizationIDNotPositiveExpectsError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		events, err := client.WatchCapacity(context.Background(), 0)
+
+		// assert
+		assert.Nil(t, events, "Expected no channel")
+		assert.IsType(t, &OrganizationIDError{}, err, "Expected an organization ID error")
+	})
+}
@@ -0,0 +1,173 @@
+package workflow
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+)
+
+// WithCassette records the interactions of the client with the workflow API to the cassette file at path, or replays
+// them when the file exists, so code using the client can be tested against recorded API behavior without a live
+// server.  To record, run once against a real API gateway with a path that does not exist yet: every request that gets
+// a response is written to the file along with the response.  Request headers are left out so the token is not kept.
+//
+// When replaying, no request reaches the network.  A request is answered with the first recorded response not replayed
+// yet whose request has the same method, path (along with the query) and body, or with the last one when they were all
+// replayed, e.g. when polling.  A request that was not recorded fails with a *CassetteMissError.  Bodies are kept in
+// memory whole, use it in tests only.
+func WithCassette(path string) Option {
+	return func(o *options) {
+		o.cassettePath = path
+	}
+}
+
+// cassette is the content of a cassette file
+type cassette struct {
+	Interactions []*interaction `json:"interactions"`
+}
+
+type interaction struct {
+	Request  cassetteRequest  `json:"request"`
+	Response cassetteResponse `json:"response"`
+	// replayed is set once the interaction answered a request
+	replayed bool
+}
+
+type cassetteRequest struct {
+	Method string `json:"method"`
+	// Path is the path of the request along with its query
+	Path string `json:"path"`
+	Body string `json:"body,omitempty"`
+}
+
+type cassetteResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+// cassetteTransport is a http.RoundTripper that records the interactions with the workflow API to a cassette file, or
+// replays them when the file exists
+type cassetteTransport struct {
+	path   string
+	next   http.RoundTripper
+	replay bool
+	// err is the error reading the cassette file, returned for every request
+	err error
+
+	mu       sync.Mutex
+	cassette cassette
+}
+
+func newCassetteTransport(path string, next http.RoundTripper) *cassetteTransport {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	t := &cassetteTransport{path: path, next: next}
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return t
+	}
+	t.replay = true
+	if err == nil {
+		err = json.Unmarshal(content, &t.cassette)
+	}
+	t.err = err
+	return t
+}
+
+func (t *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.err != nil {
+		return nil, t.err
+	}
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	recorded := cassetteRequest{Method: req.Method, Path: req.URL.RequestURI(), Body: string(body)}
+	if t.replay {
+		return t.replayResponse(req, recorded)
+	}
+	return t.record(req, recorded, body)
+}
+
+// replayResponse returns the recorded response to recorded
+func (t *cassetteTransport) replayResponse(req *http.Request, recorded cassetteRequest) (*http.Response, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	// the first match not replayed yet, or the last match
+	var match *interaction
+	for _, i := range t.cassette.Interactions {
+		if i.Request == recorded {
+			match = i
+			if !i.replayed {
+				break
+			}
+		}
+	}
+	if match == nil {
+		return nil, &CassetteMissError{Method: recorded.Method, Path: recorded.Path}
+	}
+	match.replayed = true
+	header := make(http.Header, len(match.Response.Header))
+	for k, v := range match.Response.Header {
+		header[k] = v
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", match.Response.StatusCode, http.StatusText(match.Response.StatusCode)),
+		StatusCode:    match.Response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          ioutil.NopCloser(strings.NewReader(match.Response.Body)),
+		ContentLength: int64(len(match.Response.Body)),
+		Request:       req,
+	}, nil
+}
+
+// record sends the request and writes it to the cassette file along with its response
+func (t *cassetteTransport) record(req *http.Request, recorded cassetteRequest, body []byte) (*http.Response, error) {
+	if req.Body != nil {
+		// don't modify the caller's request, see http.RoundTripper
+		clone := new(http.Request)
+		*clone = *req
+		clone.Body = ioutil.NopCloser(bytes.NewReader(body))
+		req = clone
+	}
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	respBody, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(respBody))
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cassette.Interactions = append(t.cassette.Interactions, &interaction{
+		Request:  recorded,
+		Response: cassetteResponse{StatusCode: resp.StatusCode, Header: resp.Header, Body: string(respBody)},
+	})
+	content, err := json.MarshalIndent(&t.cassette, "", "  ")
+	if err == nil {
+		err = ioutil.WriteFile(t.path, content, 0644)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
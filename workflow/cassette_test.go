@@ -0,0 +1,104 @@
+package workflow
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithCassette(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	// record gets the workflow from a live server with a client recording to a new cassette and returns the path
+	record := func(t *testing.T, dir string) string {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"my-workflow","state":"Running"}`))
+		}).Methods("GET")
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("secret-token", nil)
+		path := filepath.Join(dir, "cassette.json")
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithCassette(path))
+		if _, err := client.GetWorkflow(workflowID); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	t.Run("WhenRecordingExpectsInteractionWrittenWithoutToken", func(t *testing.T) {
+		// arrange
+		dir, err := ioutil.TempDir("", "cassette")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		// act
+		path := record(t, dir)
+
+		// assert
+		content, err := ioutil.ReadFile(path)
+		assert.Nil(t, err, "Expected the cassette to be written")
+		assert.Contains(t, string(content), "/"+workflowAPIBasePath+"/workflows/my-workflow", "Expected the request to be recorded")
+		assert.Contains(t, string(content), `\"state\":\"Running\"`, "Expected the response to be recorded")
+		assert.NotContains(t, string(content), "secret-token", "Expected the token not to be recorded")
+	})
+
+	t.Run("WhenReplayingExpectsRecordedWorkflowWithoutServer", func(t *testing.T) {
+		// arrange
+		dir, err := ioutil.TempDir("", "cassette")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		path := record(t, dir)
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		// the host does not resolve, requests must not reach the network
+		client := NewClient(fakeTokenFetcher, "http://workflow-api.invalid", workflowAPIBasePath, audience, logger, WithCassette(path))
+
+		// act
+		workflow, err := client.GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected the recorded response to be replayed")
+		if assert.NotNil(t, workflow, "Expected the recorded workflow") {
+			assert.Equal(t, workflowID, workflow.ID, "Expected the ID of the recorded workflow")
+			assert.Equal(t, "Running", workflow.State, "Expected the state of the recorded workflow")
+		}
+	})
+
+	t.Run("WhenRequestNotRecordedExpectsCassetteMissError", func(t *testing.T) {
+		// arrange
+		dir, err := ioutil.TempDir("", "cassette")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+		path := record(t, dir)
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, "http://workflow-api.invalid", workflowAPIBasePath, audience, logger, WithCassette(path))
+
+		// act
+		_, err = client.GetWorkflow("other-workflow")
+
+		// assert
+		urlErr, ok := err.(*url.Error)
+		if assert.True(t, ok, "Expected the error of the transport but got %v", err) {
+			assert.Equal(t, &CassetteMissError{Method: "GET", Path: "/" + workflowAPIBasePath + "/workflows/other-workflow"}, urlErr.Err, "Expected a *CassetteMissError")
+		}
+	})
+}
@@ -3,9 +3,17 @@
 package workflow
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/3dsim/auth0"
@@ -13,33 +21,260 @@ import (
 	"github.com/3dsim/workflow-goclient/genclient/operations"
 	"github.com/3dsim/workflow-goclient/models"
 	"github.com/PuerkitoBio/rehttp"
+	"github.com/go-openapi/runtime"
 	openapiclient "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	log "github.com/inconshreveable/log15"
 )
 
+// retryBaseDelay is the base of the exponential backoff used between retries.  It is a variable so tests can shorten it.
+var retryBaseDelay = 1 * time.Second
+
+// defaultTokenRetries is how many times fetching the token is retried unless WithTokenRetries is used
+const defaultTokenRetries = 2
+
+// tokenRetryDelay is the delay before the first token fetch retry, it doubles for every retry after that.  It is a
+// variable so tests can shorten it.
+var tokenRetryDelay = 100 * time.Millisecond
+
+// mergePatchMediaType is the content type of the body sent by PatchActivity
+const mergePatchMediaType = "application/merge-patch+json"
+
+// maxConcurrentHeartbeats is how many heartbeats HeartbeatActivities sends at the same time
+const maxConcurrentHeartbeats = 8
+
+// maxConcurrentUpdates is how many updates UpdateActivitiesPercentComplete sends at the same time
+const maxConcurrentUpdates = 8
+
 // Client is a wrapper around the generated client found in the "genclient" package.  It provides convenience methods
 // for common operations.  If the operation needed is not found in Client, use the "genclient" package using this client
 // as an example of how to utilize the genclient.  PRs are welcome if more functionality is wanted in this client package.
 type Client interface {
-	// StartWorkflow begins a new workflow and returns the workflow ID
+	// StartWorkflow begins a new workflow and returns the workflow ID.  A *models.WorkflowTypeError is returned without
+	// starting the workflow when its WorkflowType is not one of the known types (see models.WorkflowType.Validate), a
+	// *PriorityError when its Priority is not one of the models.PostWorkflowPriority... constants, and a
+	// *WorkflowTimeoutError when its TimeoutSeconds is negative, a *StartTimeError when its StartAt is not in the future
+	// and a *RetryPolicyError when a value of its RetryPolicy is out of range.  A nil OrganizationID is set to the
+	// organization of WithDefaultOrganization, if any.
 	StartWorkflow(*models.PostWorkflow) (string, error)
+	// StartWorkflowNoQueue starts the workflow like StartWorkflow, but with models.PostWorkflow.NoQueue set so that a
+	// *CapacityUnavailableError is returned right away when the organization is at capacity instead of the workflow
+	// waiting on capacity.  The workflow passed in is not changed.
+	StartWorkflowNoQueue(*models.PostWorkflow) (string, error)
+	// StartWorkflowIfNotExists starts the workflow like StartWorkflow unless a workflow of the same entity and
+	// organization is running (including waiting on capacity), in which case the ID of that workflow is returned with
+	// created false, the oldest one when there are several.  The workflow API has no conditional start: the running
+	// workflows are listed before starting, so a workflow started by another client in between is not seen.  Set an
+	// ExternalID on the workflow too when duplicates must be ruled out, the workflow API keeps it unique within the
+	// organization.
+	StartWorkflowIfNotExists(*models.PostWorkflow) (workflowID string, created bool, err error)
+	// CloneWorkflow starts a new workflow with the parameters of the source workflow changed by the non-zero fields of
+	// overrides (nil keeps every parameter) and returns the ID of the new workflow.  Since only non-zero fields
+	// override, a flag set on the source workflow cannot be turned off this way.  The clone starts right away unless
+	// overrides has a StartAt, the scheduled start of the source is not kept.
+	CloneWorkflow(sourceWorkflowID string, overrides *models.PostWorkflow) (string, error)
+	// GetWorkflowParameters returns the parameters the workflow was started with
+	GetWorkflowParameters(workflowID string) (*models.PostWorkflow, error)
+	// ValidateWorkflow asks the workflow API to check the workflow request without starting the workflow, so no quota
+	// is used.  A *WorkflowValidationError listing the offending fields is returned when the request is not valid.
+	ValidateWorkflow(*models.PostWorkflow) error
 	CancelWorkflow(workflowID string) error
+	// TransferWorkflow moves the workflow to another organization, e.g. when an account is migrated.  An
+	// *OrganizationIDError is returned when newOrganizationID is not positive, a *SameOrganizationError when the workflow
+	// already belongs to it and a *TransferForbiddenError when the caller is not allowed to transfer workflows.
+	TransferWorkflow(workflowID string, newOrganizationID int32) error
+	// UpdateWorkflowMetadata replaces the description and metadata of the workflow (see PostWorkflow.Description and
+	// PostWorkflow.Metadata), an empty description or nil metadata clears them
+	UpdateWorkflowMetadata(workflowID string, description string, metadata map[string]string) error
+	GetWorkflow(workflowID string) (*models.Workflow, error)
+	// GetWorkflowByExternalID returns the workflow of the organization started with externalID (see
+	// PostWorkflow.ExternalID).  A *WorkflowNotFoundError is returned when the organization has no such workflow and
+	// an *OrganizationIDError when organizationID is not positive.
+	GetWorkflowByExternalID(externalID string, organizationID int32) (*models.Workflow, error)
+	// GetChildWorkflows returns the workflows started by the workflow (their ParentID is parentWorkflowID), an empty
+	// slice when it has none.  Only direct children are returned, call it for every child to walk the whole hierarchy.
+	GetChildWorkflows(parentWorkflowID string) ([]*models.Workflow, error)
+	// GetWorkflowHistory returns the events of the workflow, oldest first
+	GetWorkflowHistory(workflowID string) ([]*models.HistoryEvent, error)
+	// ListWorkflowHistoryPage returns a single page of at most limit history events of the workflow starting at cursor,
+	// oldest first.  Pass an empty cursor for the first page.  An empty nextCursor signals that there are no more pages.
+	ListWorkflowHistoryPage(workflowID, cursor string, limit int) (events []*models.HistoryEvent, nextCursor string, err error)
+	// ForEachHistoryEvent calls fn for every history event of the workflow matching the filter, oldest first, fetching
+	// one page at a time so only a page is held in memory even for enormous histories.  It stops and returns the error
+	// as soon as fn returns one, and stops fetching pages once the events are past filter.Until.
+	ForEachHistoryEvent(workflowID string, filter HistoryFilter, fn func(*models.HistoryEvent) error) error
+	// ExportWorkflow returns the workflow, all of its activities and its history in one models.WorkflowBundle that can
+	// be saved as JSON, e.g. to attach to a support ticket
+	ExportWorkflow(workflowID string) (*models.WorkflowBundle, error)
+	// SignalWorkflow sends the signal to the workflow whatever state the workflow is in.  See models.NewSignal.
+	SignalWorkflow(workflowID string, signal *models.Signal) error
+	// SignalWorkflowIfRunning sends the signal only if the workflow is running, otherwise a *WorkflowNotRunningError is
+	// returned.  It costs an extra request to get the workflow first.
+	SignalWorkflowIfRunning(workflowID string, signal *models.Signal) error
+	// GetSignalStatus returns whether the most recent signal named signalName sent to the workflow was delivered and
+	// processed by it, and when.  A *SignalNotFoundError is returned when no such signal was sent to the workflow.
+	GetSignalStatus(workflowID, signalName string) (*models.SignalStatus, error)
+	// RegisterWebhook asks the workflow API to post the given events of the workflow to callbackURL, events being
+	// models.WebhookRegistrationEvents... constants.  It returns the ID of the webhook to give to UnregisterWebhook.
+	RegisterWebhook(workflowID, callbackURL string, events []string) (webhookID string, err error)
+	UnregisterWebhook(webhookID string) error
+	// WorkflowRaw returns the JSON of the workflow exactly as the workflow API sent it, including any fields the models
+	// in this package do not know about yet
+	WorkflowRaw(workflowID string) (json.RawMessage, error)
+	// UpdateActivity sends the activity to the workflow API.  When activity.Version is set, the update only happens if
+	// the stored activity still has that version, otherwise an *ActivityVersionConflictError is returned so the caller
+	// can fetch the activity again and retry.  Only ID and Status are always sent, the other fields are left out of the
+	// request while they have their zero value (e.g. a PercentComplete of 0) so they do not overwrite what the workflow
+	// API has.  Use PatchActivity to set a field back to its zero value.
 	UpdateActivity(workflowID string, activity *models.Activity) (*models.Activity, error)
 	UpdateActivityPercentComplete(workflowID, activityID string, percentComplete int) (*models.Activity, error)
+	// UpdateActivitiesPercentComplete updates the percent complete of several activities of the workflow at once,
+	// progress maps the activity ID to its percent complete.  The updates are sent concurrently and each value is checked
+	// like UpdateActivityPercentComplete does.  The activities and errors are returned in the order of the sorted activity
+	// IDs: for each activity either the updated activity or an *ActivityError is set, the other is nil.
+	UpdateActivitiesPercentComplete(workflowID string, progress map[string]int) ([]*models.Activity, []error)
+	// PatchActivity changes only the given fields of the activity, leaving the others (e.g. a status set by another
+	// process) untouched.  fields is sent as a JSON merge patch (RFC 7396) so its keys are the JSON names of the
+	// models.Activity fields, e.g. {"percentComplete": 50}, and a nil value removes the field.
+	PatchActivity(workflowID, activityID string, fields map[string]interface{}) (*models.Activity, error)
+	// CompleteSuccessfulActivity completes the activity with result serialized to JSON.  Numbers are sent exactly as
+	// they are held, so keep large integers in integer types or json.Number rather than float64.  A json.RawMessage
+	// result is sent verbatim.  A nil result is not sent as the JSON null, the activity gets an empty result instead
+	// (or the one set with WithNilResult).
 	CompleteSuccessfulActivity(workflowID, activityID string, result interface{}) (*models.Activity, error)
+	// CompleteSuccessfulActivityStream completes the activity with a pre-serialized result that is streamed from r
+	CompleteSuccessfulActivityStream(workflowID, activityID string, r io.Reader) (*models.Activity, error)
 	CompleteCancelledActivity(workflowID, activityID, reason, details string) (*models.Activity, error)
+	// CompleteCancelledActivityWithResult completes the activity as cancelled like CompleteCancelledActivity and sends
+	// the partial result produced before the cancellation, serialized to JSON like CompleteSuccessfulActivity does, so
+	// resumable work can carry on from it.
+	CompleteCancelledActivityWithResult(workflowID, activityID, reason, details string, partialResult interface{}) (*models.Activity, error)
 	CompleteFailedActivity(workflowID, activityID, reason, details string) (*models.Activity, error)
+	// CompleteFailedActivityNonBlocking fails a best-effort activity: the activity is marked non-blocking so that the
+	// workflow API does not fail the workflow because of it.
+	CompleteFailedActivityNonBlocking(workflowID, activityID, reason, details string) (*models.Activity, error)
+	// CompleteActivity moves the activity to the terminal status of outcome along with its result, error and percent
+	// complete in a single update, for the transitions the other Complete...Activity methods do not cover, e.g. a
+	// completion with a warning.  An *ActivityOutcomeError is returned without sending a request when outcome does not
+	// pass ActivityOutcome.Validate.  Like the other Complete...Activity methods, a retried completion is not an error.
+	CompleteActivity(workflowID, activityID string, outcome ActivityOutcome) (*models.Activity, error)
+	// RetryActivity reschedules a failed activity without re-running the rest of the workflow and returns the activity
+	// as rescheduled.  An *ActivityNotFailedError is returned if the activity is not failed.
+	RetryActivity(workflowID, activityID string) (*models.Activity, error)
 	HeartbeatActivity(workflowID, activityID string) (*models.Heartbeat, error)
+	// AppendActivityLogs attaches output lines to the activity so operators can see what it is doing
+	AppendActivityLogs(workflowID, activityID string, lines []*models.LogLine) error
+	// GetActivityLogs returns the output lines attached to the activity, oldest first.  Only the lines written after
+	// since are returned so the output can be tailed by passing the time of the last line received, a zero since returns
+	// every line.  An activity without output has no lines, an *ActivityNotFoundError is returned when the workflow
+	// API does not know the activity.
+	GetActivityLogs(workflowID, activityID string, since time.Time) ([]*models.LogLine, error)
+	// DownloadActivityResult writes the result of the activity to w.  Results too large to be stored inline are a JSON
+	// object with an artifactUrl field, e.g. {"artifactUrl":"https://..."}, the artifact is then downloaded and streamed
+	// to w.  The bearer token is only sent along when the artifact is served by the API gateway, other storage is
+	// expected to accept the URL on its own, e.g. a pre-signed URL.  An *ArtifactDownloadError is returned when the
+	// download gets an error status.
+	DownloadActivityResult(activity *models.Activity, w io.Writer) error
 	HeartbeatActivityWithToken(taskToken, activityID, details string) (*models.Heartbeat, error)
+	// AbandonActivity sends a final heartbeat telling the workflow API that the worker relinquishes the activity, e.g.
+	// because it is shutting down, so the activity is rescheduled right away instead of after the heartbeat timeout.  The
+	// task token must not be used after that.
+	AbandonActivity(taskToken, activityID, details string) error
+	// ExtendHeartbeatTimeout heartbeats the activity asking the workflow API to wait up to extension (rounded up to
+	// whole seconds) for the next heartbeat instead of the heartbeat timeout, e.g. before a blocking call during which
+	// the activity can't heartbeat.  Check Cancelled of the returned heartbeat like for HeartbeatActivityWithToken.  A
+	// *HeartbeatExtensionError is returned when extension is not positive.
+	ExtendHeartbeatTimeout(taskToken, activityID string, extension time.Duration) (*models.Heartbeat, error)
+	// IsCancellationRequested tells if the cancellation of the activity has been requested, like the Cancelled flag of a
+	// heartbeat but without sending one, so it does not reset the heartbeat timeout of the activity.  An
+	// *ActivityNotFoundError is returned when the workflow API does not know the activity.
+	IsCancellationRequested(workflowID, activityID string) (bool, error)
+	// GetActivityTaskToken returns the task token of a running activity, e.g. so a worker that restarted can resume it
+	// with activity.Worker.Resume.  A *NoActiveTaskTokenError is returned when the activity is not running.
+	GetActivityTaskToken(workflowID, activityID string) (string, error)
+	// LookupByTaskToken returns the workflow and activity a task token belongs to, e.g. for a task token found in a
+	// dead-letter message.  An *UnknownTaskTokenError is returned when the workflow API does not know the task token,
+	// e.g. because it expired.
+	LookupByTaskToken(taskToken string) (workflowID, activityID string, err error)
+	// HeartbeatActivities heartbeats every activity of taskTokens (activity ID to task token) and returns the heartbeat
+	// of each activity by activity ID, check Cancelled to know which activities must stop.  The heartbeats are sent
+	// concurrently.  If some heartbeats fail, the heartbeats that succeeded are returned along with a *MultiError holding
+	// an *ActivityError for each activity that failed.
+	HeartbeatActivities(taskTokens map[string]string) (map[string]*models.Heartbeat, error)
+	// GetActivity returns an activity of the workflow.  An *ActivityNotFoundError is returned when the workflow API does
+	// not know the activity.
+	GetActivity(workflowID, activityID string) (*models.Activity, error)
+	// ListActivities returns every activity of the workflow, fetching as many pages as needed
+	ListActivities(workflowID string) ([]*models.Activity, error)
+	// WorkflowProgress returns the progress of the whole workflow from 0 to 100: the average percent complete of its
+	// activities, where activities that ended (completed, failed or cancelled) count as 100.  A workflow without
+	// activities has a progress of 0.
+	WorkflowProgress(workflowID string) (int, error)
+	// CurrentActivity returns the running activity of the workflow, the first one when several run in parallel.  It only
+	// gets the workflow, not every page of its activities.  A *NoCurrentActivityError is returned when no activity is
+	// running, e.g. because the workflow ended or is waiting on capacity.
+	CurrentActivity(workflowID string) (*models.Activity, error)
+	// ListActivitiesPage returns a single page of at most limit activities starting at cursor.  Pass an empty cursor
+	// for the first page.  An empty nextCursor signals that there are no more pages.
+	ListActivitiesPage(workflowID, cursor string, limit int) (activities []*models.Activity, nextCursor string, err error)
+	// ListWorkflows returns every workflow matching the filter, fetching as many pages as needed
+	ListWorkflows(filter WorkflowFilter) ([]*models.Workflow, error)
+	// SearchWorkflows returns the workflows of the organization matching query, e.g. part of an entity ID or free text,
+	// best match first as ranked by the workflow API.  ErrEmptySearchQuery is returned for a blank query rather than
+	// every workflow of the organization.
+	SearchWorkflows(query string, organizationID int32) ([]*models.Workflow, error)
+	// ListWorkflowsPage returns a single page of at most limit workflows matching the filter starting at cursor.  Pass an
+	// empty cursor for the first page.  An empty nextCursor signals that there are no more pages.
+	ListWorkflowsPage(filter WorkflowFilter, cursor string, limit int) (workflows []*models.Workflow, nextCursor string, err error)
+	// ForEachWorkflow calls fn for every workflow matching the filter, fetching one page at a time so only a page is
+	// held in memory.  It stops and returns the error as soon as fn returns one, without fetching the remaining pages.
+	ForEachWorkflow(filter WorkflowFilter, fn func(*models.Workflow) error) error
+	// CancelWorkflowsForEntity cancels every running workflow of the entity and returns the IDs of the workflows that
+	// were cancelled.  Workflows that finish before they can be cancelled are skipped.  If some workflows could not be
+	// cancelled, the error is a *MultiError holding a *WorkflowError for each of them.
+	CancelWorkflowsForEntity(entityID, organizationID int32) ([]string, error)
+	// RecentRequests returns the requests kept by WithRequestRecorder from oldest to newest.  It returns nil when the
+	// client was created without WithRequestRecorder.
+	RecentRequests() []RecordedRequest
+	// GetWorkflowType returns what a workflow type supports (flags, required inputs and a description), e.g. to build a
+	// form for starting workflows of that type
+	GetWorkflowType(workflowType string) (*models.WorkflowTypeInfo, error)
+	// WatchCapacity sends an event every time capacity of the organization becomes available, e.g. so a scheduler
+	// can start workflows that are WaitingOnCapacity instead of polling each of them.  The events are long-polled and
+	// the request is made again when it fails.  The channel is closed when ctx is closed or when the workflow API
+	// refuses the request.
+	WatchCapacity(ctx context.Context, organizationID int32) (<-chan *models.CapacityEvent, error)
+	// WaitForState gets the workflow every pollInterval (10 sec if not positive) until it is in the target state, one
+	// of the models.WorkflowState... constants or a state the workflow API added since, and returns it.  Requests are
+	// made with ctx, ctx.Err() is returned when it is closed first.  When the workflow reaches a terminal state other
+	// than target, it is returned along with a *StateNotReachedError.
+	WaitForState(ctx context.Context, workflowID, target string, pollInterval time.Duration) (*models.Workflow, error)
+	// APIGatewayURL returns the API gateway URL the client was created with, e.g. to log it at startup
+	APIGatewayURL() string
+	// APIBasePath returns the base path of the workflow API the client was created with
+	APIBasePath() string
+	// Audience returns the audience tokens are fetched for
+	Audience() string
+	// WithContext returns a copy of the client whose requests are made with ctx: cancelling ctx aborts them and a token
+	// stored in ctx by ContextWithToken is used instead of fetching one.  The client it is called on is not changed.
+	WithContext(ctx context.Context) Client
+	// Close closes the idle connections of the transport created for the client, clients using http.DefaultTransport
+	// leave it alone since it is shared.  Calls made after Close return ErrClientClosed.  Close the client when it is
+	// replaced, e.g. on a configuration reload, so its connections are not leaked.
+	Close() error
 }
 
 type client struct {
-	tokenFetcher auth0.TokenFetcher
-	client       *genclient.Workflow
-	audience     string
-	logger       log.Logger
+	tokenFetcher  auth0.TokenFetcher
+	client        *genclient.Workflow
+	apiGatewayURL string
+	apiBasePath   string
+	audience      string
+	logger        log.Logger
+	options       *options
+	// ctx is the context of every request, see WithContext
+	ctx context.Context
 }
 
 // NewClient creates a client for interacting with the 3DSIM workflow api.  See the auth0 package for how to construct
@@ -60,34 +295,52 @@ type client struct {
 // their own log handler.  If nil is passed, this logger will be initialized to use the DiscardHandler, which discards log statements.
 // See: https://godoc.org/github.com/inconshreveable/log15#hdr-Library_Use
 //
-func NewClient(tokenFetcher auth0.TokenFetcher, apiGatewayURL, apiBasePath, audience string, logger log.Logger) Client {
-	return newClient(tokenFetcher, apiGatewayURL, apiBasePath, audience, nil, openapiclient.DefaultTimeout, logger)
+// opts are optional settings, see the With... functions in this package.
+//
+func NewClient(tokenFetcher auth0.TokenFetcher, apiGatewayURL, apiBasePath, audience string, logger log.Logger, opts ...Option) Client {
+	o := newOptions(opts)
+	return newClient(tokenFetcher, apiGatewayURL, apiBasePath, audience, o.buildTransport(true), false, openapiclient.DefaultTimeout, logger, o)
 }
 
 // NewClientWithRetry creates the same type of client as NewClient, but allows for retrying any temporary errors or
-// any responses with status >= 400 and < 600 for a specified amount of time.
+// any responses with status >= 400 and < 600 for a specified amount of time.  When the context given to WithContext
+// has a deadline, retrying stops once the deadline would pass before another attempt completes and the outcome of the
+// last attempt is returned.  A "Retries exhausted" error with the number of attempts and the elapsed time is logged
+// when that last attempt failed too.
 //
 // See NewClient for more information
-func NewClientWithRetry(tokenFetcher auth0.TokenFetcher, apiGatewayURL, apiBasePath, audience string, retryTimeout time.Duration, logger log.Logger) Client {
+func NewClientWithRetry(tokenFetcher auth0.TokenFetcher, apiGatewayURL, apiBasePath, audience string, retryTimeout time.Duration, logger log.Logger, opts ...Option) Client {
+	o := newOptions(opts)
+	budget := newRetryBudget(o.buildTransport(false), o.retriesExhausted) // nil will use http.DefaultTransport
 	tr := rehttp.NewTransport(
-		nil, // will use http.DefaultTransport
-		rehttp.RetryAny(rehttp.RetryStatusInterval(400, 600), rehttp.RetryTemporaryErr()),
-		rehttp.ExpJitterDelay(1*time.Second, retryTimeout),
+		budget,
+		o.retryFn(budget.retryFn(
+			rehttp.RetryAny(rehttp.RetryStatusInterval(400, 600), rehttp.RetryTemporaryErr()),
+			expJitterMaxDelay(retryBaseDelay, retryTimeout),
+		)),
+		rehttp.ExpJitterDelay(retryBaseDelay, retryTimeout),
 	)
-	return newClient(tokenFetcher, apiGatewayURL, apiBasePath, audience, tr, retryTimeout, logger)
+	return newClient(tokenFetcher, apiGatewayURL, apiBasePath, audience, budget.scoped(tr), true, retryTimeout, logger, o)
 }
 
 func newClient(tokenFetcher auth0.TokenFetcher, apiGatewayURL, apiBasePath, audience string,
-	roundTripper http.RoundTripper, defaultRequestTimeout time.Duration, logger log.Logger) Client {
+	roundTripper http.RoundTripper, retry bool, defaultRequestTimeout time.Duration, logger log.Logger, o *options) Client {
 	if logger == nil {
 		logger = log.New()
 		logger.SetHandler(log.DiscardHandler())
 	}
-	if roundTripper == nil {
+	o.logger = logger
+	if !retry {
 		logger.Info("Creating workflow client with retry disabled")
+		if o.retryCallback != nil {
+			logger.Warn("A retry callback was given to a client without retry, it will never be called")
+		}
 	} else {
 		logger.Info("Creating workflow client with retry enabled")
 	}
+	if o.insecureSkipVerify {
+		logger.Warn("TLS certificate verification is disabled by WithInsecureSkipVerify, this must only be used for development and testing")
+	}
 
 	parsedURL, err := url.Parse(apiGatewayURL)
 	if err != nil {
@@ -96,30 +349,122 @@ func newClient(tokenFetcher auth0.TokenFetcher, apiGatewayURL, apiBasePath, audi
 		panic(message + " " + err.Error())
 	}
 
+	if o.maxResponseSize > 0 {
+		roundTripper = newResponseLimiter(o.maxResponseSize, roundTripper)
+	}
 	workflowTransport := openapiclient.New(parsedURL.Host, apiBasePath, []string{parsedURL.Scheme})
 	if roundTripper != nil {
 		workflowTransport.Transport = roundTripper
 	}
+	workflowTransport.Producers[mergePatchMediaType] = runtime.JSONProducer()
 	openapiclient.DefaultTimeout = defaultRequestTimeout
 	workflowTransport.Debug = true
-	workflowClient := genclient.New(workflowTransport, strfmt.Default)
-	return &client{
-		tokenFetcher: tokenFetcher,
-		client:       workflowClient,
-		audience:     audience,
-		logger:       logger,
+	workflowClient := genclient.New(&timeoutTransport{next: newRateLimitTransport(workflowTransport, o)}, strfmt.Default)
+	c := &client{
+		tokenFetcher:  tokenFetcher,
+		client:        workflowClient,
+		apiGatewayURL: apiGatewayURL,
+		apiBasePath:   apiBasePath,
+		audience:      audience,
+		logger:        logger,
+		options:       o,
+		ctx:           context.Background(),
+	}
+	if o.operationErrors {
+		return &operationErrorClient{next: c}
+	}
+	return c
+}
+
+func (c *client) APIGatewayURL() string {
+	return c.apiGatewayURL
+}
+
+func (c *client) APIBasePath() string {
+	return c.apiBasePath
+}
+
+func (c *client) Audience() string {
+	return c.audience
+}
+
+func (c *client) WithContext(ctx context.Context) Client {
+	if ctx == nil {
+		panic("nil context")
+	}
+	copied := *c
+	copied.ctx = ctx
+	return &copied
+}
+
+func (c *client) Close() error {
+	if !atomic.CompareAndSwapInt32(&c.options.closed, 0, 1) {
+		return nil
+	}
+	c.logger.Info("Closing workflow client")
+	if c.options.transport != nil {
+		c.options.transport.CloseIdleConnections()
+	}
+	return nil
+}
+
+// token returns the token stored in the client's context by ContextWithToken, or fetches one.  Every request gets its
+// token here, so this is also where requests made after Close are stopped.
+func (c *client) token() (string, error) {
+	if atomic.LoadInt32(&c.options.closed) == 1 {
+		return "", ErrClientClosed
+	}
+	if token, ok := tokenFromContext(c.ctx); ok {
+		return token, nil
 	}
+	token, err := c.tokenFetcher.Token(c.audience)
+	delay := tokenRetryDelay
+	for retry := 1; err != nil && retry <= c.options.tokenRetries; retry++ {
+		c.logger.Warn("Problem fetching token, retrying", "error", err, "retry", retry, "delay", delay)
+		select {
+		case <-c.ctx.Done():
+			return "", c.ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+		token, err = c.tokenFetcher.Token(c.audience)
+	}
+	return token, err
 }
 
 // StartWorkflow creates a new workflow and returns the workflow ID
 func (c *client) StartWorkflow(workflow *models.PostWorkflow) (workflowID string, err error) {
-	token, err := c.tokenFetcher.Token(c.audience)
+	workflow = c.withDefaultOrganization(workflow)
+	if err := checkPostWorkflow(workflow); err != nil {
+		return "", err
+	}
+	if err := c.checkOrganization(swag.Int32Value(workflow.OrganizationID)); err != nil {
+		return "", err
+	}
+	token, err := c.token()
 	if err != nil {
 		return "", err
 	}
 	c.logger.Info("Starting workflow", "type", workflow.WorkflowType, "entityID", *workflow.EntityID)
-	params := operations.NewStartWorkflowParams().WithWorkflow(workflow)
+	params := operations.NewStartWorkflowParams().WithContext(c.ctx).WithWorkflow(workflow)
 	response, err := c.client.Operations.StartWorkflow(params, openapiclient.BearerToken(token))
+	if tooMany, ok := err.(*operations.StartWorkflowTooManyRequests); ok {
+		quotaErr := &QuotaExceededError{}
+		if tooMany.Payload != nil {
+			quotaErr.Limit = tooMany.Payload.Limit
+			quotaErr.Usage = tooMany.Payload.Usage
+			quotaErr.ResetTime = time.Time(tooMany.Payload.ResetTime)
+			quotaErr.Message = tooMany.Payload.Message
+		}
+		err = quotaErr
+	}
+	if conflict, ok := err.(*operations.StartWorkflowConflict); ok {
+		capacityErr := &CapacityUnavailableError{OrganizationID: swag.Int32Value(workflow.OrganizationID)}
+		if conflict.Payload != nil {
+			capacityErr.Message = swag.StringValue(conflict.Payload.Message)
+		}
+		err = capacityErr
+	}
 	if err != nil {
 		c.logger.Error("Problem starting workflow", "type", workflow.WorkflowType, "entityID", *workflow.EntityID, "error", err)
 		return "", err
@@ -127,13 +472,218 @@ func (c *client) StartWorkflow(workflow *models.PostWorkflow) (workflowID string
 	return response.Payload, nil
 }
 
+func (c *client) StartWorkflowNoQueue(workflow *models.PostWorkflow) (string, error) {
+	noQueue := *workflow
+	noQueue.NoQueue = true
+	return c.StartWorkflow(&noQueue)
+}
+
+func (c *client) StartWorkflowIfNotExists(workflow *models.PostWorkflow) (string, bool, error) {
+	workflow = c.withDefaultOrganization(workflow)
+	// reject what StartWorkflow would before listing the workflows
+	if err := checkPostWorkflow(workflow); err != nil {
+		return "", false, err
+	}
+	entityID := swag.Int32Value(workflow.EntityID)
+	running, err := c.ListWorkflows(WorkflowFilter{
+		EntityID:       entityID,
+		OrganizationID: swag.Int32Value(workflow.OrganizationID),
+		State:          models.WorkflowStateRunning,
+		SortBy:         SortByCreatedAt,
+	})
+	if err != nil {
+		return "", false, err
+	}
+	if len(running) > 0 {
+		c.logger.Info("Not starting workflow, one is already running for the entity", "entityID", entityID, "workflowID", running[0].ID)
+		return running[0].ID, false, nil
+	}
+	workflowID, err := c.StartWorkflow(workflow)
+	if err != nil {
+		return "", false, err
+	}
+	return workflowID, true, nil
+}
+
+func (c *client) CloneWorkflow(sourceWorkflowID string, overrides *models.PostWorkflow) (string, error) {
+	c.logger.Info("Cloning workflow", "sourceWorkflowID", sourceWorkflowID)
+	source, err := c.GetWorkflowParameters(sourceWorkflowID)
+	if err != nil {
+		return "", err
+	}
+	// the scheduled start of the source has most likely passed, the clone starts right away unless overrides set one
+	source.StartAt = nil
+	// an external ID identifies a single workflow, the clone only gets one from overrides
+	source.ExternalID = ""
+	return c.StartWorkflow(mergePostWorkflow(source, overrides))
+}
+
+// mergePostWorkflow returns a copy of workflow with the non-zero fields of overrides set
+func mergePostWorkflow(workflow, overrides *models.PostWorkflow) *models.PostWorkflow {
+	merged := *workflow
+	if overrides == nil {
+		return &merged
+	}
+	if overrides.Description != "" {
+		merged.Description = overrides.Description
+	}
+	if overrides.DynamicWorkflowGraph != nil {
+		merged.DynamicWorkflowGraph = overrides.DynamicWorkflowGraph
+	}
+	if overrides.EntityID != nil {
+		merged.EntityID = overrides.EntityID
+	}
+	if overrides.ExternalID != "" {
+		merged.ExternalID = overrides.ExternalID
+	}
+	if overrides.Metadata != nil {
+		merged.Metadata = overrides.Metadata
+	}
+	if overrides.NoQueue {
+		merged.NoQueue = true
+	}
+	if overrides.OrganizationID != nil {
+		merged.OrganizationID = overrides.OrganizationID
+	}
+	if overrides.Priority != "" {
+		merged.Priority = overrides.Priority
+	}
+	if overrides.RetryPolicy != nil {
+		merged.RetryPolicy = overrides.RetryPolicy
+	}
+	if overrides.RunDistortionCompensation {
+		merged.RunDistortionCompensation = true
+	}
+	if overrides.RunDistortionCompensationAfterCutoff {
+		merged.RunDistortionCompensationAfterCutoff = true
+	}
+	if overrides.RunSupportOptimization {
+		merged.RunSupportOptimization = true
+	}
+	if overrides.StartAt != nil {
+		merged.StartAt = overrides.StartAt
+	}
+	if overrides.TimeoutSeconds != 0 {
+		merged.TimeoutSeconds = overrides.TimeoutSeconds
+	}
+	if overrides.WorkflowType != nil {
+		merged.WorkflowType = overrides.WorkflowType
+	}
+	return &merged
+}
+
+func (c *client) GetWorkflowParameters(workflowID string) (*models.PostWorkflow, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Info("Getting workflow parameters", "workflowID", workflowID)
+	params := operations.NewGetWorkflowParametersParams().WithContext(c.ctx).WithID(workflowID)
+	response, err := c.client.Operations.GetWorkflowParameters(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem getting workflow parameters", "workflowID", workflowID, "error", err)
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) ValidateWorkflow(workflow *models.PostWorkflow) error {
+	if err := checkPostWorkflow(workflow); err != nil {
+		return err
+	}
+	token, err := c.token()
+	if err != nil {
+		return err
+	}
+	c.logger.Info("Validating workflow", "type", swag.StringValue(workflow.WorkflowType), "entityID", swag.Int32Value(workflow.EntityID))
+	params := operations.NewValidateWorkflowParams().WithContext(c.ctx).WithWorkflow(workflow)
+	_, err = c.client.Operations.ValidateWorkflow(params, openapiclient.BearerToken(token))
+	if invalid, ok := err.(*operations.ValidateWorkflowUnprocessableEntity); ok {
+		validationErr := &WorkflowValidationError{}
+		if invalid.Payload != nil {
+			validationErr.Fields = invalid.Payload.Errors
+		}
+		c.logger.Info("Workflow is not valid", "type", swag.StringValue(workflow.WorkflowType), "entityID", swag.Int32Value(workflow.EntityID), "error", validationErr)
+		return validationErr
+	}
+	if err != nil {
+		c.logger.Error("Problem validating workflow", "type", swag.StringValue(workflow.WorkflowType), "entityID", swag.Int32Value(workflow.EntityID), "error", err)
+		return err
+	}
+	return nil
+}
+
+// checkPostWorkflow makes the checks that can be done without the workflow API
+func checkPostWorkflow(workflow *models.PostWorkflow) error {
+	if workflow.WorkflowType != nil {
+		if err := models.WorkflowType(*workflow.WorkflowType).Validate(); err != nil {
+			return err
+		}
+	}
+	if err := checkPriority(workflow.Priority); err != nil {
+		return err
+	}
+	if workflow.TimeoutSeconds < 0 {
+		return &WorkflowTimeoutError{TimeoutSeconds: workflow.TimeoutSeconds}
+	}
+	if workflow.StartAt != nil && !time.Time(*workflow.StartAt).After(time.Now()) {
+		return &StartTimeError{StartAt: time.Time(*workflow.StartAt)}
+	}
+	return checkRetryPolicy(workflow.RetryPolicy)
+}
+
+// checkRetryPolicy rejects the values of the retry policy that are out of range.  Zero values are left to the workflow
+// API defaults.
+func checkRetryPolicy(policy *models.RetryPolicy) error {
+	if policy == nil {
+		return nil
+	}
+	switch {
+	case policy.MaxAttempts < 0:
+		return &RetryPolicyError{Field: "maxAttempts", Value: policy.MaxAttempts, Reason: "positive"}
+	case policy.BackoffCoefficient != 0 && policy.BackoffCoefficient < 1:
+		return &RetryPolicyError{Field: "backoffCoefficient", Value: policy.BackoffCoefficient, Reason: "at least 1"}
+	case policy.InitialIntervalSeconds < 0:
+		return &RetryPolicyError{Field: "initialIntervalSeconds", Value: policy.InitialIntervalSeconds, Reason: "positive"}
+	case policy.MaxIntervalSeconds < 0:
+		return &RetryPolicyError{Field: "maxIntervalSeconds", Value: policy.MaxIntervalSeconds, Reason: "positive"}
+	case policy.MaxIntervalSeconds != 0 && policy.MaxIntervalSeconds < policy.InitialIntervalSeconds:
+		return &RetryPolicyError{Field: "maxIntervalSeconds", Value: policy.MaxIntervalSeconds, Reason: "at least initialIntervalSeconds"}
+	}
+	return nil
+}
+
+// checkPriority rejects priorities the workflow API does not know so they fail before a workflow is started
+func checkPriority(priority string) error {
+	switch priority {
+	case "", models.PostWorkflowPriorityLow, models.PostWorkflowPriorityNormal, models.PostWorkflowPriorityHigh:
+		return nil
+	}
+	return &PriorityError{Priority: priority}
+}
+
+func (c *client) GetWorkflowType(workflowType string) (*models.WorkflowTypeInfo, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Info("Getting workflow type", "type", workflowType)
+	params := operations.NewGetWorkflowTypeParams().WithContext(c.ctx).WithWorkflowType(workflowType)
+	response, err := c.client.Operations.GetWorkflowType(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem getting workflow type", "type", workflowType, "error", err)
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
 func (c *client) CancelWorkflow(workflowID string) error {
-	token, err := c.tokenFetcher.Token(c.audience)
+	token, err := c.token()
 	if err != nil {
 		return err
 	}
 	c.logger.Info("Cancelling workflow", "workflowID", workflowID)
-	params := operations.NewCancelWorkflowParams().WithID(workflowID)
+	params := operations.NewCancelWorkflowParams().WithContext(c.ctx).WithID(workflowID)
 	_, err = c.client.Operations.CancelWorkflow(params, openapiclient.BearerToken(token))
 	if err != nil {
 		c.logger.Error("Problem cancelling workflow", "workflowID", workflowID, "error", err)
@@ -142,14 +692,334 @@ func (c *client) CancelWorkflow(workflowID string) error {
 	return nil
 }
 
+func (c *client) TransferWorkflow(workflowID string, newOrganizationID int32) error {
+	if newOrganizationID <= 0 {
+		return &OrganizationIDError{OrganizationID: newOrganizationID}
+	}
+	workflow, err := c.GetWorkflow(workflowID)
+	if err != nil {
+		return err
+	}
+	if workflow.OrganizationID == newOrganizationID {
+		return &SameOrganizationError{WorkflowID: workflowID, OrganizationID: newOrganizationID}
+	}
+	token, err := c.token()
+	if err != nil {
+		return err
+	}
+	c.logger.Info("Transferring workflow", "workflowID", workflowID, "from", workflow.OrganizationID, "to", newOrganizationID)
+	transfer := &models.WorkflowTransfer{OrganizationID: swag.Int32(newOrganizationID)}
+	params := operations.NewTransferWorkflowParams().WithContext(c.ctx).WithID(workflowID).WithTransfer(transfer)
+	_, err = c.client.Operations.TransferWorkflow(params, openapiclient.BearerToken(token))
+	if _, ok := err.(*operations.TransferWorkflowForbidden); ok {
+		err = &TransferForbiddenError{WorkflowID: workflowID, OrganizationID: newOrganizationID}
+	}
+	if err != nil {
+		c.logger.Error("Problem transferring workflow", "workflowID", workflowID, "organizationID", newOrganizationID, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (c *client) UpdateWorkflowMetadata(workflowID string, description string, metadata map[string]string) error {
+	token, err := c.token()
+	if err != nil {
+		return err
+	}
+	c.logger.Info("Updating workflow metadata", "workflowID", workflowID, "description", description, "metadata", metadata)
+	workflowMetadata := &models.WorkflowMetadata{Description: description, Metadata: metadata}
+	params := operations.NewUpdateWorkflowMetadataParams().WithContext(c.ctx).WithID(workflowID).WithMetadata(workflowMetadata)
+	_, err = c.client.Operations.UpdateWorkflowMetadata(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem updating workflow metadata", "workflowID", workflowID, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (c *client) GetWorkflow(workflowID string) (*models.Workflow, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Info("Getting workflow", "workflowID", workflowID)
+	params := operations.NewGetWorkflowParams().WithContext(c.ctx).WithID(workflowID)
+	response, err := c.client.Operations.GetWorkflow(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem getting workflow", "workflowID", workflowID, "error", err)
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) GetWorkflowByExternalID(externalID string, organizationID int32) (*models.Workflow, error) {
+	if organizationID <= 0 {
+		return nil, &OrganizationIDError{OrganizationID: organizationID}
+	}
+	if err := c.checkOrganization(organizationID); err != nil {
+		return nil, err
+	}
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Info("Getting workflow by external ID", "externalID", externalID, "organizationID", organizationID)
+	params := operations.NewGetWorkflowByExternalIDParams().WithContext(c.ctx).WithExternalID(externalID).WithOrganizationID(organizationID)
+	response, err := c.client.Operations.GetWorkflowByExternalID(params, openapiclient.BearerToken(token))
+	if _, ok := err.(*operations.GetWorkflowByExternalIDNotFound); ok {
+		err = &WorkflowNotFoundError{ExternalID: externalID, OrganizationID: organizationID}
+	}
+	if err != nil {
+		c.logger.Error("Problem getting workflow by external ID", "externalID", externalID, "organizationID", organizationID, "error", err)
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) GetChildWorkflows(parentWorkflowID string) ([]*models.Workflow, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Info("Getting child workflows", "parentWorkflowID", parentWorkflowID)
+	params := operations.NewGetChildWorkflowsParams().WithContext(c.ctx).WithID(parentWorkflowID)
+	response, err := c.client.Operations.GetChildWorkflows(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem getting child workflows", "parentWorkflowID", parentWorkflowID, "error", err)
+		return nil, err
+	}
+	if response.Payload == nil {
+		return []*models.Workflow{}, nil
+	}
+	return response.Payload, nil
+}
+
+func (c *client) GetWorkflowHistory(workflowID string) ([]*models.HistoryEvent, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Info("Getting workflow history", "workflowID", workflowID)
+	params := operations.NewGetWorkflowHistoryParams().WithContext(c.ctx).WithID(workflowID)
+	response, err := c.client.Operations.GetWorkflowHistory(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem getting workflow history", "workflowID", workflowID, "error", err)
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+// ListWorkflowHistoryPage fetches one page of history events.  A limit <= 0 lets the workflow API choose the page size.
+func (c *client) ListWorkflowHistoryPage(workflowID, cursor string, limit int) ([]*models.HistoryEvent, string, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, "", err
+	}
+	c.logger.Debug("Listing workflow history", "workflowID", workflowID, "cursor", cursor, "limit", limit)
+	params := operations.NewListWorkflowHistoryParams().WithContext(c.ctx).WithID(workflowID)
+	if cursor != "" {
+		params.SetCursor(swag.String(cursor))
+	}
+	if limit > 0 {
+		params.SetLimit(swag.Int32(int32(limit)))
+	}
+	response, err := c.client.Operations.ListWorkflowHistory(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem listing workflow history", "workflowID", workflowID, "cursor", cursor, "error", err)
+		return nil, "", err
+	}
+	return response.Payload.Events, response.Payload.NextCursor, nil
+}
+
+func (c *client) ForEachHistoryEvent(workflowID string, filter HistoryFilter, fn func(*models.HistoryEvent) error) error {
+	cursor := ""
+	for {
+		page, nextCursor, err := c.ListWorkflowHistoryPage(workflowID, cursor, 0)
+		if err != nil {
+			return err
+		}
+		for _, event := range page {
+			if filter.past(event) {
+				// events are oldest first, none of the remaining ones can match
+				return nil
+			}
+			if !filter.matches(event) {
+				continue
+			}
+			if err := fn(event); err != nil {
+				return err
+			}
+		}
+		if nextCursor == "" {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
+
+func (c *client) ExportWorkflow(workflowID string) (*models.WorkflowBundle, error) {
+	c.logger.Info("Exporting workflow", "workflowID", workflowID)
+	workflow, err := c.GetWorkflow(workflowID)
+	if err != nil {
+		return nil, err
+	}
+	activities, err := c.ListActivities(workflowID)
+	if err != nil {
+		return nil, err
+	}
+	history, err := c.GetWorkflowHistory(workflowID)
+	if err != nil {
+		return nil, err
+	}
+	return &models.WorkflowBundle{
+		Workflow:   workflow,
+		Activities: activities,
+		History:    history,
+		ExportedAt: strfmt.DateTime(time.Now().UTC()),
+	}, nil
+}
+
+func (c *client) SignalWorkflow(workflowID string, signal *models.Signal) error {
+	token, err := c.token()
+	if err != nil {
+		return err
+	}
+	c.logger.Info("Signalling workflow", "workflowID", workflowID, "signal", *signal.Name)
+	params := operations.NewSignalWorkflowParams().WithContext(c.ctx).WithID(workflowID).WithSignal(signal)
+	_, err = c.client.Operations.SignalWorkflow(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem signalling workflow", "workflowID", workflowID, "signal", *signal.Name, "error", err)
+		return err
+	}
+	return nil
+}
+
+// SignalWorkflowIfRunning checks the state of the workflow before signalling it.  The workflow can still finish between
+// the check and the signal, so this catches mistakes rather than guaranteeing delivery.
+func (c *client) SignalWorkflowIfRunning(workflowID string, signal *models.Signal) error {
+	workflow, err := c.GetWorkflow(workflowID)
+	if err != nil {
+		return err
+	}
+	if workflow.State != models.WorkflowStateRunning {
+		c.logger.Warn("Not signalling workflow because it is not running", "workflowID", workflowID, "signal", *signal.Name, "state", workflow.State)
+		return &WorkflowNotRunningError{WorkflowID: workflowID, State: workflow.State}
+	}
+	return c.SignalWorkflow(workflowID, signal)
+}
+
+func (c *client) GetSignalStatus(workflowID, signalName string) (*models.SignalStatus, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Debug("Getting signal status", "workflowID", workflowID, "signal", signalName)
+	params := operations.NewGetSignalStatusParams().WithContext(c.ctx).WithID(workflowID).WithName(signalName)
+	response, err := c.client.Operations.GetSignalStatus(params, openapiclient.BearerToken(token))
+	if _, ok := err.(*operations.GetSignalStatusNotFound); ok {
+		err = &SignalNotFoundError{WorkflowID: workflowID, SignalName: signalName}
+	}
+	if err != nil {
+		c.logger.Error("Problem getting signal status", "workflowID", workflowID, "signal", signalName, "error", err)
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) RegisterWebhook(workflowID, callbackURL string, events []string) (string, error) {
+	token, err := c.token()
+	if err != nil {
+		return "", err
+	}
+	c.logger.Info("Registering webhook", "workflowID", workflowID, "callbackURL", callbackURL, "events", events)
+	registration := &models.WebhookRegistration{CallbackURL: swag.String(callbackURL), Events: events}
+	params := operations.NewRegisterWebhookParams().WithContext(c.ctx).WithID(workflowID).WithRegistration(registration)
+	response, err := c.client.Operations.RegisterWebhook(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem registering webhook", "workflowID", workflowID, "error", err)
+		return "", err
+	}
+	return swag.StringValue(response.Payload.ID), nil
+}
+
+func (c *client) UnregisterWebhook(webhookID string) error {
+	token, err := c.token()
+	if err != nil {
+		return err
+	}
+	c.logger.Info("Unregistering webhook", "webhookID", webhookID)
+	params := operations.NewUnregisterWebhookParams().WithContext(c.ctx).WithWebhookID(webhookID)
+	_, err = c.client.Operations.UnregisterWebhook(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem unregistering webhook", "webhookID", webhookID, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (c *client) WorkflowRaw(workflowID string) (json.RawMessage, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Info("Getting raw workflow", "workflowID", workflowID)
+	params := operations.NewGetWorkflowParams().WithContext(c.ctx).WithID(workflowID)
+	result, err := c.client.Transport.Submit(&runtime.ClientOperation{
+		ID:                 "getWorkflow",
+		Method:             "GET",
+		PathPattern:        "/workflows/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &rawWorkflowReader{},
+		AuthInfo:           openapiclient.BearerToken(token),
+		Context:            c.ctx,
+	})
+	if err != nil {
+		c.logger.Error("Problem getting raw workflow", "workflowID", workflowID, "error", err)
+		return nil, err
+	}
+	return result.(json.RawMessage), nil
+}
+
+// rawWorkflowReader returns the body of a successful get workflow response untouched.  Other responses are read the
+// same way the generated client reads them.
+type rawWorkflowReader struct {
+	operations.GetWorkflowReader
+}
+
+func (r *rawWorkflowReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	if response.Code() != http.StatusOK {
+		return r.GetWorkflowReader.ReadResponse(response, consumer)
+	}
+	body, err := ioutil.ReadAll(response.Body())
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(body), nil
+}
+
 func (c *client) UpdateActivity(workflowID string, activity *models.Activity) (*models.Activity, error) {
-	token, err := c.tokenFetcher.Token(c.audience)
+	token, err := c.token()
 	if err != nil {
 		return nil, err
 	}
 	c.logger.Info("Updating activity", "workflowID", workflowID, "activityID", *activity.ID)
-	params := operations.NewUpdateActivityParams().WithID(workflowID).WithActivityID(*activity.ID).WithActivity(activity)
+	if attempt := c.attempt(); activity.Attempt == 0 && attempt > 0 {
+		withAttempt := *activity
+		withAttempt.Attempt = attempt
+		activity = &withAttempt
+	}
+	params := operations.NewUpdateActivityParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(*activity.ID).WithActivity(activity)
+	if activity.Version != "" {
+		params.SetIfMatch(swag.String(activity.Version))
+	}
 	response, err := c.client.Operations.UpdateActivity(params, openapiclient.BearerToken(token))
+	if _, ok := err.(*operations.UpdateActivityPreconditionFailed); ok {
+		c.logger.Info("Activity was changed by someone else", "workflowID", workflowID, "activityID", *activity.ID, "version", activity.Version)
+		return nil, &ActivityVersionConflictError{WorkflowID: workflowID, ActivityID: *activity.ID, Version: activity.Version}
+	}
 	if err != nil {
 		c.logger.Error("Problem updating activity", "workflowID", workflowID, "activityID", *activity.ID, "error", err)
 		return nil, err
@@ -157,8 +1027,15 @@ func (c *client) UpdateActivity(workflowID string, activity *models.Activity) (*
 	return response.Payload, nil
 }
 
+// UpdateActivityPercentComplete sends the progress of a running activity.  Values outside of [0,100] are clamped unless
+// the client was created with WithStrictPercentComplete, in which case a *PercentCompleteRangeError is returned.
 func (c *client) UpdateActivityPercentComplete(workflowID, activityID string, percentComplete int) (*models.Activity, error) {
-	token, err := c.tokenFetcher.Token(c.audience)
+	percentComplete, err := c.checkPercentComplete(percentComplete)
+	if err != nil {
+		c.logger.Error("Refusing to send percent complete", "workflowID", workflowID, "activityID", activityID, "error", err)
+		return nil, err
+	}
+	token, err := c.token()
 	if err != nil {
 		return nil, err
 	}
@@ -166,9 +1043,10 @@ func (c *client) UpdateActivityPercentComplete(workflowID, activityID string, pe
 		ID:              swag.String(activityID),
 		Status:          swag.String(models.ActivityStatusRunning),
 		PercentComplete: int32(percentComplete),
+		Attempt:         c.attempt(),
 	}
 	c.logger.Info("Updating activity percent complete", "workflowID", workflowID, "activityID", activityID, "percentComplete", percentComplete)
-	params := operations.NewUpdateActivityParams().WithID(workflowID).WithActivityID(activityID).WithActivity(updatedActivity)
+	params := operations.NewUpdateActivityParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID).WithActivity(updatedActivity)
 	response, err := c.client.Operations.UpdateActivity(params, openapiclient.BearerToken(token))
 	if err != nil {
 		c.logger.Error("Problem updating activity percent complete", "workflowID", workflowID, "activityID", activityID, "error", err)
@@ -177,80 +1055,355 @@ func (c *client) UpdateActivityPercentComplete(workflowID, activityID string, pe
 	return response.Payload, nil
 }
 
+func (c *client) PatchActivity(workflowID, activityID string, fields map[string]interface{}) (*models.Activity, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Info("Patching activity", "workflowID", workflowID, "activityID", activityID, "fields", fields)
+	params := operations.NewPatchActivityParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID).WithPatch(fields)
+	response, err := c.client.Operations.PatchActivity(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem patching activity", "workflowID", workflowID, "activityID", activityID, "error", err)
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+// attempt returns the attempt number put in the context of the client with ContextWithAttempt, 0 if there is none
+func (c *client) attempt() int32 {
+	attempt, _ := AttemptFromContext(c.ctx)
+	return attempt
+}
+
+func (c *client) UpdateActivitiesPercentComplete(workflowID string, progress map[string]int) ([]*models.Activity, []error) {
+	activityIDs := make([]string, 0, len(progress))
+	for activityID := range progress {
+		activityIDs = append(activityIDs, activityID)
+	}
+	sort.Strings(activityIDs)
+	c.logger.Debug("Updating activities percent complete", "workflowID", workflowID, "activities", len(activityIDs))
+	activities := make([]*models.Activity, len(activityIDs))
+	errs := make([]error, len(activityIDs))
+	// bounds the number of updates in flight
+	slots := make(chan struct{}, maxConcurrentUpdates)
+	var wg sync.WaitGroup
+	for i, activityID := range activityIDs {
+		wg.Add(1)
+		go func(i int, activityID string) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			activity, err := c.UpdateActivityPercentComplete(workflowID, activityID, progress[activityID])
+			if err != nil {
+				errs[i] = &ActivityError{ActivityID: activityID, Err: err}
+				return
+			}
+			activities[i] = activity
+		}(i, activityID)
+	}
+	wg.Wait()
+	return activities, errs
+}
+
+// checkPercentComplete clamps percentComplete to [0,100], or rejects it when strict percent complete is enabled
+func (c *client) checkPercentComplete(percentComplete int) (int, error) {
+	if percentComplete >= 0 && percentComplete <= 100 {
+		return percentComplete, nil
+	}
+	if c.options.strictPercentComplete {
+		return 0, &PercentCompleteRangeError{PercentComplete: percentComplete}
+	}
+	clamped := 0
+	if percentComplete > 100 {
+		clamped = 100
+	}
+	c.logger.Warn("Percent complete out of range, clamping it", "percentComplete", percentComplete, "clamped", clamped)
+	return clamped, nil
+}
+
 func (c *client) CompleteSuccessfulActivity(workflowID, activityID string, result interface{}) (*models.Activity, error) {
-	token, err := c.tokenFetcher.Token(c.audience)
+	token, err := c.token()
 	if err != nil {
 		return nil, err
 	}
-	resultBytes, err := json.Marshal(result)
+	resultJSON, err := c.activityResult(result)
 	if err != nil {
 		return nil, err
 	}
 	completedActivity := &models.Activity{
 		ID:              swag.String(activityID),
 		Status:          swag.String(models.ActivityStatusCompleted),
-		Result:          string(resultBytes),
+		Result:          resultJSON,
 		PercentComplete: 100,
+		Attempt:         c.attempt(),
 	}
 	c.logger.Info("Completing successful activity", "workflowID", workflowID, "activityID", activityID, "result", result)
-	params := operations.NewUpdateActivityParams().WithID(workflowID).WithActivityID(activityID).WithActivity(completedActivity)
-	response, err := c.client.Operations.UpdateActivity(params, openapiclient.BearerToken(token))
+	params := operations.NewUpdateActivityParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID).WithActivity(completedActivity)
+	activity, err := c.completeActivity(params, token)
 	if err != nil {
 		c.logger.Error("Problem completing successful activity", "workflowID", workflowID, "activityID", activityID, "error", err)
 		return nil, err
 	}
-	return response.Payload, nil
+	return activity, nil
+}
+
+// CompleteSuccessfulActivityStream completes the activity like CompleteSuccessfulActivity, but the result is read from r
+// as it is sent instead of being marshalled up front.  r must contain the already serialized result.  Note that a client
+// created with NewClientWithRetry has to buffer request bodies so they can be resent, use NewClient to avoid that.
+func (c *client) CompleteSuccessfulActivityStream(workflowID, activityID string, r io.Reader) (*models.Activity, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Info("Completing successful activity from stream", "workflowID", workflowID, "activityID", activityID)
+	body := newCompletedActivityBody(activityID, c.attempt(), r)
+	defer body.Close()
+	result, err := c.client.Transport.Submit(&runtime.ClientOperation{
+		ID:                 "updateActivity",
+		Method:             "PUT",
+		PathPattern:        "/workflows/{id}/activities/{activityId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             &streamedActivityParams{workflowID: workflowID, activityID: activityID, body: body},
+		Reader:             &operations.UpdateActivityReader{},
+		AuthInfo:           openapiclient.BearerToken(token),
+		Context:            c.ctx,
+	})
+	response, _ := result.(*operations.UpdateActivityOK)
+	activity, err := c.resolveCompletion(workflowID, activityID, models.ActivityStatusCompleted, response, err)
+	if err != nil {
+		c.logger.Error("Problem completing successful activity from stream", "workflowID", workflowID, "activityID", activityID, "error", err)
+		return nil, err
+	}
+	return activity, nil
+}
+
+// marshalResult serializes the result of an activity.  Pre-serialized results are used as is so nothing about them
+// (e.g. number formatting) changes.
+func marshalResult(result interface{}) (string, error) {
+	if raw, ok := result.(json.RawMessage); ok {
+		if !json.Valid(raw) {
+			return "", errors.New("Result is not valid JSON")
+		}
+		return string(raw), nil
+	}
+	resultBytes, err := json.Marshal(result)
+	if err != nil {
+		return "", err
+	}
+	return string(resultBytes), nil
+}
+
+// activityResult serializes the result of an activity with marshalResult, except for a nil result (including nil
+// pointers, maps and slices) that is replaced by the result set with WithNilResult instead of being sent as null
+func (c *client) activityResult(result interface{}) (string, error) {
+	resultJSON, err := marshalResult(result)
+	if err != nil || resultJSON != "null" {
+		return resultJSON, err
+	}
+	return c.options.nilResult, nil
 }
 
 // CompleteCancelledActivity will sent an activity with a cancelled status to the workflow API.  workflowID, activityID,
 // and reason are required.
 func (c *client) CompleteCancelledActivity(workflowID, activityID, reason, details string) (*models.Activity, error) {
-	token, err := c.tokenFetcher.Token(c.audience)
+	return c.completeCancelledActivity(workflowID, activityID, reason, details, "")
+}
+
+func (c *client) CompleteCancelledActivityWithResult(workflowID, activityID, reason, details string, partialResult interface{}) (*models.Activity, error) {
+	resultJSON, err := c.activityResult(partialResult)
+	if err != nil {
+		return nil, err
+	}
+	return c.completeCancelledActivity(workflowID, activityID, reason, details, resultJSON)
+}
+
+func (c *client) completeCancelledActivity(workflowID, activityID, reason, details, resultJSON string) (*models.Activity, error) {
+	token, err := c.token()
 	if err != nil {
 		return nil, err
 	}
 	cancelledActivity := &models.Activity{
-		ID:     swag.String(activityID),
-		Status: swag.String(models.ActivityStatusCancelled),
-		Error:  &models.ActivityError{Reason: swag.String(reason), Details: details},
+		ID:      swag.String(activityID),
+		Status:  swag.String(models.ActivityStatusCancelled),
+		Error:   &models.ActivityError{Reason: swag.String(reason), Details: details},
+		Result:  resultJSON,
+		Attempt: c.attempt(),
 	}
 	c.logger.Info("Completing cancelled activity", "workflowID", workflowID, "activityID", activityID)
-	params := operations.NewUpdateActivityParams().WithID(workflowID).WithActivityID(activityID).WithActivity(cancelledActivity)
-	response, err := c.client.Operations.UpdateActivity(params, openapiclient.BearerToken(token))
+	params := operations.NewUpdateActivityParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID).WithActivity(cancelledActivity)
+	activity, err := c.completeActivity(params, token)
 	if err != nil {
 		c.logger.Error("Problem completing cancelled activity", "workflowID", workflowID, "activityID", activityID, "error", err)
 		return nil, err
 	}
-	return response.Payload, nil
+	return activity, nil
 }
 
 func (c *client) CompleteFailedActivity(workflowID, activityID, reason, details string) (*models.Activity, error) {
-	token, err := c.tokenFetcher.Token(c.audience)
+	return c.completeFailedActivity(workflowID, activityID, reason, details, false)
+}
+
+func (c *client) CompleteFailedActivityNonBlocking(workflowID, activityID, reason, details string) (*models.Activity, error) {
+	return c.completeFailedActivity(workflowID, activityID, reason, details, true)
+}
+
+func (c *client) completeFailedActivity(workflowID, activityID, reason, details string, nonBlocking bool) (*models.Activity, error) {
+	token, err := c.token()
 	if err != nil {
 		return nil, err
 	}
 	failedActivity := &models.Activity{
-		ID:     swag.String(activityID),
-		Status: swag.String(models.ActivityStatusFailed),
-		Error:  &models.ActivityError{Reason: swag.String(reason), Details: details},
+		ID:          swag.String(activityID),
+		Status:      swag.String(models.ActivityStatusFailed),
+		Error:       &models.ActivityError{Reason: swag.String(reason), Details: details},
+		NonBlocking: nonBlocking,
+		Attempt:     c.attempt(),
 	}
-	c.logger.Info("Completing failed activity", "workflowID", workflowID, "activityID", activityID)
-	params := operations.NewUpdateActivityParams().WithID(workflowID).WithActivityID(activityID).WithActivity(failedActivity)
-	response, err := c.client.Operations.UpdateActivity(params, openapiclient.BearerToken(token))
+	c.logger.Info("Completing failed activity", "workflowID", workflowID, "activityID", activityID, "nonBlocking", nonBlocking)
+	params := operations.NewUpdateActivityParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID).WithActivity(failedActivity)
+	activity, err := c.completeActivity(params, token)
 	if err != nil {
 		c.logger.Error("Problem completing failed activity", "workflowID", workflowID, "activityID", activityID, "error", err)
 		return nil, err
 	}
+	return activity, nil
+}
+
+func (c *client) CompleteActivity(workflowID, activityID string, outcome ActivityOutcome) (*models.Activity, error) {
+	if err := outcome.Validate(); err != nil {
+		c.logger.Error("Activity outcome is not valid", "workflowID", workflowID, "activityID", activityID, "error", err)
+		return nil, err
+	}
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	var resultJSON string
+	if outcome.Result != nil {
+		if resultJSON, err = c.activityResult(outcome.Result); err != nil {
+			return nil, err
+		}
+	}
+	percentComplete := outcome.PercentComplete
+	if outcome.Status == models.ActivityStatusCompleted {
+		percentComplete = 100
+	}
+	activity := &models.Activity{
+		ID:              swag.String(activityID),
+		Status:          swag.String(outcome.Status),
+		Result:          resultJSON,
+		Error:           outcome.Error,
+		PercentComplete: int32(percentComplete),
+		Attempt:         c.attempt(),
+	}
+	c.logger.Info("Completing activity", "workflowID", workflowID, "activityID", activityID, "status", outcome.Status, "warning", outcome.Warning)
+	params := operations.NewUpdateActivityParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID).WithActivity(activity)
+	completed, err := c.completeActivity(params, token)
+	if err != nil {
+		c.logger.Error("Problem completing activity", "workflowID", workflowID, "activityID", activityID, "status", outcome.Status, "error", err)
+		return nil, err
+	}
+	return completed, nil
+}
+
+// completeActivity sends an activity update that moves the activity to a terminal status.  If the workflow API
+// responds that the activity was already completed, the update is considered successful when the stored status is the
+// one being sent (e.g. a retried request), otherwise an *ActivityConflictError is returned.
+func (c *client) completeActivity(params *operations.UpdateActivityParams, token string) (*models.Activity, error) {
+	response, err := c.client.Operations.UpdateActivity(params, openapiclient.BearerToken(token))
+	return c.resolveCompletion(params.ID, params.ActivityID, *params.Activity.Status, response, err)
+}
+
+// resolveCompletion turns the outcome of an update that completes an activity into the activity to return, handling the
+// conflict response as described on completeActivity.
+func (c *client) resolveCompletion(workflowID, activityID, status string, response *operations.UpdateActivityOK, err error) (*models.Activity, error) {
+	if conflict, ok := err.(*operations.UpdateActivityConflict); ok {
+		existing := conflict.Payload
+		if existing != nil && existing.Status != nil && *existing.Status == status {
+			c.logger.Info("Activity was already completed with the same status", "workflowID", workflowID, "activityID", activityID, "status", *existing.Status)
+			return existing, nil
+		}
+		conflictErr := &ActivityConflictError{WorkflowID: workflowID, ActivityID: activityID, Status: status}
+		if existing != nil && existing.Status != nil {
+			conflictErr.ExistingStatus = *existing.Status
+		}
+		return nil, conflictErr
+	}
+	if err != nil {
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) RetryActivity(workflowID, activityID string) (*models.Activity, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Info("Retrying activity", "workflowID", workflowID, "activityID", activityID)
+	params := operations.NewRetryActivityParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID)
+	response, err := c.client.Operations.RetryActivity(params, openapiclient.BearerToken(token))
+	if conflict, ok := err.(*operations.RetryActivityConflict); ok {
+		notFailedErr := &ActivityNotFailedError{WorkflowID: workflowID, ActivityID: activityID}
+		if conflict.Payload != nil && conflict.Payload.Status != nil {
+			notFailedErr.Status = *conflict.Payload.Status
+		}
+		err = notFailedErr
+	}
+	if err != nil {
+		c.logger.Error("Problem retrying activity", "workflowID", workflowID, "activityID", activityID, "error", err)
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) AppendActivityLogs(workflowID, activityID string, lines []*models.LogLine) error {
+	token, err := c.token()
+	if err != nil {
+		return err
+	}
+	c.logger.Debug("Appending activity logs", "workflowID", workflowID, "activityID", activityID, "lines", len(lines))
+	params := operations.NewAppendActivityLogsParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID).WithLines(lines)
+	_, err = c.client.Operations.AppendActivityLogs(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem appending activity logs", "workflowID", workflowID, "activityID", activityID, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (c *client) GetActivityLogs(workflowID, activityID string, since time.Time) ([]*models.LogLine, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Debug("Getting activity logs", "workflowID", workflowID, "activityID", activityID, "since", since)
+	params := operations.NewGetActivityLogsParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID)
+	if !since.IsZero() {
+		sinceDateTime := strfmt.DateTime(since)
+		params.SetSince(&sinceDateTime)
+	}
+	response, err := c.client.Operations.GetActivityLogs(params, openapiclient.BearerToken(token))
+	if _, ok := err.(*operations.GetActivityLogsNotFound); ok {
+		err = &ActivityNotFoundError{WorkflowID: workflowID, ActivityID: activityID}
+	}
+	if err != nil {
+		c.logger.Error("Problem getting activity logs", "workflowID", workflowID, "activityID", activityID, "error", err)
+		return nil, err
+	}
 	return response.Payload, nil
 }
 
 func (c *client) HeartbeatActivity(workflowID string, activityID string) (*models.Heartbeat, error) {
-	token, err := c.tokenFetcher.Token(c.audience)
+	token, err := c.token()
 	if err != nil {
 		return nil, err
 	}
 	c.logger.Debug("Heartbeating activity", "workflowID", workflowID, "activityID", activityID)
-	params := operations.NewHeartbeatActivityParams().WithID(workflowID).WithActivityID(activityID)
+	params := operations.NewHeartbeatActivityParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID)
 	response, err := c.client.Operations.HeartbeatActivity(params, openapiclient.BearerToken(token))
 	if err != nil {
 		c.logger.Error("Problem heartbeating activity", "workflowID", workflowID, "activityID", activityID, "error", err)
@@ -259,8 +1412,80 @@ func (c *client) HeartbeatActivity(workflowID string, activityID string) (*model
 	return response.Payload, nil
 }
 
+func (c *client) GetActivityTaskToken(workflowID, activityID string) (string, error) {
+	token, err := c.token()
+	if err != nil {
+		return "", err
+	}
+	c.logger.Info("Getting activity task token", "workflowID", workflowID, "activityID", activityID)
+	params := operations.NewGetActivityTaskTokenParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID)
+	response, err := c.client.Operations.GetActivityTaskToken(params, openapiclient.BearerToken(token))
+	if _, ok := err.(*operations.GetActivityTaskTokenConflict); ok {
+		err = &NoActiveTaskTokenError{WorkflowID: workflowID, ActivityID: activityID}
+	}
+	if err != nil {
+		c.logger.Error("Problem getting activity task token", "workflowID", workflowID, "activityID", activityID, "error", err)
+		return "", err
+	}
+	return swag.StringValue(response.Payload.TaskToken), nil
+}
+
+func (c *client) LookupByTaskToken(taskToken string) (string, string, error) {
+	token, err := c.token()
+	if err != nil {
+		return "", "", err
+	}
+	c.logger.Info("Looking up task token", "taskToken", taskToken)
+	params := operations.NewLookupTaskTokenParams().WithContext(c.ctx).WithTaskToken(&models.TaskToken{TaskToken: swag.String(taskToken)})
+	response, err := c.client.Operations.LookupTaskToken(params, openapiclient.BearerToken(token))
+	if _, ok := err.(*operations.LookupTaskTokenNotFound); ok {
+		err = &UnknownTaskTokenError{TaskToken: taskToken}
+	}
+	if err != nil {
+		c.logger.Error("Problem looking up task token", "taskToken", taskToken, "error", err)
+		return "", "", err
+	}
+	return swag.StringValue(response.Payload.WorkflowID), swag.StringValue(response.Payload.ActivityID), nil
+}
+
+func (c *client) GetActivity(workflowID, activityID string) (*models.Activity, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Debug("Getting activity", "workflowID", workflowID, "activityID", activityID)
+	params := operations.NewGetActivityParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID)
+	response, err := c.client.Operations.GetActivity(params, openapiclient.BearerToken(token))
+	if _, ok := err.(*operations.GetActivityNotFound); ok {
+		err = &ActivityNotFoundError{WorkflowID: workflowID, ActivityID: activityID}
+	}
+	if err != nil {
+		c.logger.Error("Problem getting activity", "workflowID", workflowID, "activityID", activityID, "error", err)
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) IsCancellationRequested(workflowID, activityID string) (bool, error) {
+	token, err := c.token()
+	if err != nil {
+		return false, err
+	}
+	c.logger.Debug("Checking activity cancellation", "workflowID", workflowID, "activityID", activityID)
+	params := operations.NewGetActivityCancellationParams().WithContext(c.ctx).WithID(workflowID).WithActivityID(activityID)
+	response, err := c.client.Operations.GetActivityCancellation(params, openapiclient.BearerToken(token))
+	if _, ok := err.(*operations.GetActivityCancellationNotFound); ok {
+		err = &ActivityNotFoundError{WorkflowID: workflowID, ActivityID: activityID}
+	}
+	if err != nil {
+		c.logger.Error("Problem checking activity cancellation", "workflowID", workflowID, "activityID", activityID, "error", err)
+		return false, err
+	}
+	return swag.BoolValue(response.Payload.CancelRequested), nil
+}
+
 func (c *client) HeartbeatActivityWithToken(taskToken, activityID, details string) (*models.Heartbeat, error) {
-	token, err := c.tokenFetcher.Token(c.audience)
+	token, err := c.token()
 	if err != nil {
 		return nil, err
 	}
@@ -271,7 +1496,7 @@ func (c *client) HeartbeatActivityWithToken(taskToken, activityID, details strin
 		Cancelled:  false,
 	}
 	c.logger.Debug("Heartbeating activity", "token", taskToken)
-	params := operations.NewHeartbeatParams().WithHeartbeat(heartbeat)
+	params := operations.NewHeartbeatParams().WithContext(c.ctx).WithHeartbeat(heartbeat)
 	response, err := c.client.Operations.Heartbeat(params, openapiclient.BearerToken(token))
 	if err != nil {
 		c.logger.Error("Problem heartbeating activity", "token", taskToken, "error", err)
@@ -279,3 +1504,252 @@ func (c *client) HeartbeatActivityWithToken(taskToken, activityID, details strin
 	}
 	return response.Payload, nil
 }
+
+func (c *client) AbandonActivity(taskToken, activityID, details string) error {
+	token, err := c.token()
+	if err != nil {
+		return err
+	}
+	heartbeat := &models.Heartbeat{
+		TaskToken:  swag.String(taskToken),
+		ActivityID: swag.String(activityID),
+		Details:    details,
+		Abandoned:  true,
+	}
+	c.logger.Info("Abandoning activity", "activityID", activityID, "token", taskToken)
+	params := operations.NewHeartbeatParams().WithContext(c.ctx).WithHeartbeat(heartbeat)
+	_, err = c.client.Operations.Heartbeat(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem abandoning activity", "activityID", activityID, "token", taskToken, "error", err)
+		return err
+	}
+	return nil
+}
+
+func (c *client) ExtendHeartbeatTimeout(taskToken, activityID string, extension time.Duration) (*models.Heartbeat, error) {
+	if extension <= 0 {
+		return nil, &HeartbeatExtensionError{Extension: extension}
+	}
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	heartbeat := &models.Heartbeat{
+		TaskToken:            swag.String(taskToken),
+		ActivityID:           swag.String(activityID),
+		ExtendTimeoutSeconds: int64((extension + time.Second - 1) / time.Second),
+	}
+	c.logger.Info("Extending heartbeat timeout", "activityID", activityID, "extension", extension, "token", taskToken)
+	params := operations.NewHeartbeatParams().WithContext(c.ctx).WithHeartbeat(heartbeat)
+	response, err := c.client.Operations.Heartbeat(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem extending heartbeat timeout", "activityID", activityID, "token", taskToken, "error", err)
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) HeartbeatActivities(taskTokens map[string]string) (map[string]*models.Heartbeat, error) {
+	c.logger.Debug("Heartbeating activities", "activities", len(taskTokens))
+	type heartbeatResult struct {
+		activityID string
+		heartbeat  *models.Heartbeat
+		err        error
+	}
+	results := make(chan heartbeatResult, len(taskTokens))
+	// bounds the number of heartbeats in flight
+	slots := make(chan struct{}, maxConcurrentHeartbeats)
+	for activityID, taskToken := range taskTokens {
+		go func(activityID, taskToken string) {
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			heartbeat, err := c.HeartbeatActivityWithToken(taskToken, activityID, "")
+			results <- heartbeatResult{activityID: activityID, heartbeat: heartbeat, err: err}
+		}(activityID, taskToken)
+	}
+	heartbeats := make(map[string]*models.Heartbeat, len(taskTokens))
+	var errs []error
+	for range taskTokens {
+		result := <-results
+		if result.err != nil {
+			errs = append(errs, &ActivityError{ActivityID: result.activityID, Err: result.err})
+			continue
+		}
+		heartbeats[result.activityID] = result.heartbeat
+	}
+	if len(errs) > 0 {
+		return heartbeats, &MultiError{Errors: errs}
+	}
+	return heartbeats, nil
+}
+
+func (c *client) ListActivities(workflowID string) ([]*models.Activity, error) {
+	var activities []*models.Activity
+	cursor := ""
+	for {
+		page, nextCursor, err := c.ListActivitiesPage(workflowID, cursor, 0)
+		if err != nil {
+			return nil, err
+		}
+		activities = append(activities, page...)
+		if nextCursor == "" {
+			return activities, nil
+		}
+		cursor = nextCursor
+	}
+}
+
+func (c *client) WorkflowProgress(workflowID string) (int, error) {
+	activities, err := c.ListActivities(workflowID)
+	if err != nil {
+		return 0, err
+	}
+	return activitiesProgress(activities), nil
+}
+
+func (c *client) CurrentActivity(workflowID string) (*models.Activity, error) {
+	workflow, err := c.GetWorkflow(workflowID)
+	if err != nil {
+		return nil, err
+	}
+	for _, activity := range workflow.Activities {
+		if swag.StringValue(activity.Status) == models.ActivityStatusRunning {
+			return activity, nil
+		}
+	}
+	return nil, &NoCurrentActivityError{WorkflowID: workflowID, State: workflow.State, WaitingOnCapacity: workflow.WaitingOnCapacity}
+}
+
+// ListActivitiesPage fetches one page of activities.  A limit <= 0 lets the workflow API choose the page size.
+func (c *client) ListActivitiesPage(workflowID, cursor string, limit int) ([]*models.Activity, string, error) {
+	token, err := c.token()
+	if err != nil {
+		return nil, "", err
+	}
+	c.logger.Debug("Listing activities", "workflowID", workflowID, "cursor", cursor, "limit", limit)
+	params := operations.NewListActivitiesParams().WithContext(c.ctx).WithID(workflowID)
+	if cursor != "" {
+		params.SetCursor(swag.String(cursor))
+	}
+	if limit > 0 {
+		params.SetLimit(swag.Int32(int32(limit)))
+	}
+	response, err := c.client.Operations.ListActivities(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem listing activities", "workflowID", workflowID, "cursor", cursor, "error", err)
+		return nil, "", err
+	}
+	return response.Payload.Activities, response.Payload.NextCursor, nil
+}
+
+func (c *client) ListWorkflows(filter WorkflowFilter) ([]*models.Workflow, error) {
+	var workflows []*models.Workflow
+	err := c.ForEachWorkflow(filter, func(workflow *models.Workflow) error {
+		workflows = append(workflows, workflow)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	filter.sort(workflows)
+	return workflows, nil
+}
+
+func (c *client) ForEachWorkflow(filter WorkflowFilter, fn func(*models.Workflow) error) error {
+	cursor := ""
+	for {
+		page, nextCursor, err := c.ListWorkflowsPage(filter, cursor, 0)
+		if err != nil {
+			return err
+		}
+		for _, workflow := range page {
+			if err := fn(workflow); err != nil {
+				return err
+			}
+		}
+		if nextCursor == "" {
+			return nil
+		}
+		cursor = nextCursor
+	}
+}
+
+// ListWorkflowsPage fetches one page of workflows.  A limit <= 0 lets the workflow API choose the page size.
+func (c *client) ListWorkflowsPage(filter WorkflowFilter, cursor string, limit int) ([]*models.Workflow, string, error) {
+	if err := c.checkOrganization(filter.OrganizationID); err != nil {
+		return nil, "", err
+	}
+	token, err := c.token()
+	if err != nil {
+		return nil, "", err
+	}
+	c.logger.Debug("Listing workflows", "filter", filter, "cursor", cursor, "limit", limit)
+	params := filter.params(c.ctx)
+	if cursor != "" {
+		params.SetCursor(swag.String(cursor))
+	}
+	if limit > 0 {
+		params.SetLimit(swag.Int32(int32(limit)))
+	}
+	response, err := c.client.Operations.ListWorkflows(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem listing workflows", "filter", filter, "cursor", cursor, "error", err)
+		return nil, "", err
+	}
+	return response.Payload.Workflows, response.Payload.NextCursor, nil
+}
+
+func (c *client) SearchWorkflows(query string, organizationID int32) ([]*models.Workflow, error) {
+	if strings.TrimSpace(query) == "" {
+		return nil, ErrEmptySearchQuery
+	}
+	if err := c.checkOrganization(organizationID); err != nil {
+		return nil, err
+	}
+	token, err := c.token()
+	if err != nil {
+		return nil, err
+	}
+	c.logger.Info("Searching workflows", "query", query, "organizationID", organizationID)
+	params := operations.NewSearchWorkflowsParams().WithContext(c.ctx).WithQ(query).WithOrganizationID(organizationID)
+	response, err := c.client.Operations.SearchWorkflows(params, openapiclient.BearerToken(token))
+	if err != nil {
+		c.logger.Error("Problem searching workflows", "query", query, "organizationID", organizationID, "error", err)
+		return nil, err
+	}
+	return response.Payload, nil
+}
+
+func (c *client) CancelWorkflowsForEntity(entityID, organizationID int32) ([]string, error) {
+	c.logger.Info("Cancelling workflows for entity", "entityID", entityID, "organizationID", organizationID)
+	workflows, err := c.ListWorkflows(WorkflowFilter{EntityID: entityID, OrganizationID: organizationID, State: models.WorkflowStateRunning})
+	if err != nil {
+		return nil, err
+	}
+	var cancelled []string
+	var errs []error
+	for _, workflow := range workflows {
+		err := c.CancelWorkflow(workflow.ID)
+		if _, ok := err.(*operations.CancelWorkflowConflict); ok {
+			// finished after it was listed, nothing left to cancel
+			c.logger.Info("Workflow already finished, not cancelling it", "workflowID", workflow.ID)
+			continue
+		}
+		if err != nil {
+			errs = append(errs, &WorkflowError{WorkflowID: workflow.ID, Err: err})
+			continue
+		}
+		cancelled = append(cancelled, workflow.ID)
+	}
+	if len(errs) > 0 {
+		return cancelled, &MultiError{Errors: errs}
+	}
+	return cancelled, nil
+}
+
+func (c *client) RecentRequests() []RecordedRequest {
+	if c.options.recorder == nil {
+		return nil
+	}
+	return c.options.recorder.recent()
+}
@@ -1,15 +1,26 @@
 package workflow
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/ioutil"
+	"net"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/workflow-goclient/genclient/operations"
 	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/gorilla/mux"
 	log "github.com/inconshreveable/log15"
@@ -38,6 +49,21 @@ func TestNewClientExpectsClientReturned(t *testing.T) {
 	assert.NotNil(t, client, "Expected new client to not be nil")
 }
 
+func TestNewClientExpectsConfigurationReadBack(t *testing.T) {
+	// arrange
+	client := NewClientWithRetry(nil, "https://3dsim-qa.cloud.tyk.io", workflowAPIBasePath, audience, time.Second, logger)
+
+	// act
+	copied := client.WithContext(context.Background())
+
+	// assert
+	for _, c := range []Client{client, copied} {
+		assert.Equal(t, "https://3dsim-qa.cloud.tyk.io", c.APIGatewayURL(), "Expected the API gateway URL passed to the constructor")
+		assert.Equal(t, workflowAPIBasePath, c.APIBasePath(), "Expected the API base path passed to the constructor")
+		assert.Equal(t, audience, c.Audience(), "Expected the audience passed to the constructor")
+	}
+}
+
 func TestCancelWorkflow(t *testing.T) {
 	// arrange
 	workflowID := "my-workflow"
@@ -108,41 +134,21 @@ func TestCancelWorkflow(t *testing.T) {
 		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
 	})
 }
-func TestUpdateActivity(t *testing.T) {
+func TestGetWorkflowType(t *testing.T) {
 	// arrange
-	workflowID := "my-workflow"
-	activityID := "my-activity"
-	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
-	activityToReturn := &models.Activity{
-		ID:              swag.String(activityID),
-		Status:          swag.String("Completed"),
-		PercentComplete: 100,
-		Result:          "json-string",
-		Error: &models.ActivityError{
-			Reason:  swag.String("reason"),
-			Details: "details",
-		},
-	}
+	workflowType := models.PostWorkflowWorkflowTypePart
+	endpoint := "/" + workflowAPIBasePath + "/workflow-types/{workflowType}"
 
-	t.Run("WhenSuccessfulExpectsActivityReturned", func(t *testing.T) {
+	t.Run("WhenSuccessfulExpectsTypeInfoReturned", func(t *testing.T) {
 		// arrange
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
 		fakeTokenFetcher.TokenReturns("token", nil)
 		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "application/json")
 			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
-			receivedWorkflowID := mux.Vars(r)["workflowID"]
-			receivedActivityID := mux.Vars(r)["activityID"]
-			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
-			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
-			bytes, err := json.Marshal(activityToReturn)
-			if err != nil {
-				t.Error("Failed to marshal activity")
-			}
-			w.Write(bytes)
+			assert.Equal(t, workflowType, mux.Vars(r)["workflowType"], "Expected workflow type received to match what was passed in")
+			w.Write([]byte(`{"name":"Part","description":"Simulates a part","requiredInputs":["entityId","organizationId"],"supportedFlags":["runSupportOptimization","runDistortionCompensation"]}`))
 		})
-
-		// Setup routes
 		r := mux.NewRouter()
 		r.HandleFunc(endpoint, handler)
 		testServer := httptest.NewServer(r)
@@ -150,18 +156,16 @@ func TestUpdateActivity(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.UpdateActivity(workflowID, activityToReturn)
+		info, err := client.GetWorkflowType(workflowType)
 
 		// assert
-		assert.Nil(t, err, "Expected error to be nil when getting workflow")
-		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
-		assert.Equal(t, *activityToReturn.ID, *activity.ID, "Expected activity IDs to match")
-		assert.Equal(t, *activityToReturn.Status, *activity.Status, "Expected activity status to match")
-		assert.Equal(t, activityToReturn.PercentComplete, activity.PercentComplete, "Expected activity percent complete to match")
-		assert.Equal(t, activityToReturn.Result, activity.Result, "Expected activity result to match")
-		assert.NotNil(t, activity.Error, "Expected activity error to not be nil")
-		assert.Equal(t, *activityToReturn.Error.Reason, *activity.Error.Reason, "Expected activity error reason to not be nil")
-		assert.Equal(t, activityToReturn.Error.Details, activity.Error.Details, "Expected activity error details to not be nil")
+		assert.Nil(t, err, "Expected no error")
+		assert.Equal(t, &models.WorkflowTypeInfo{
+			Name:           "Part",
+			Description:    "Simulates a part",
+			RequiredInputs: []string{"entityId", "organizationId"},
+			SupportedFlags: []string{"runSupportOptimization", "runDistortionCompensation"},
+		}, info, "Expected the type descriptor to be returned")
 	})
 
 	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
@@ -172,25 +176,22 @@ func TestUpdateActivity(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.UpdateActivity(workflowID, activityToReturn)
+		info, err := client.GetWorkflowType(workflowType)
 
 		// assert
-		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
+		assert.Nil(t, info, "Expected no type info returned")
 		assert.Equal(t, expectedError, err, "Expected an error returned")
-
 	})
 
-	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+	t.Run("WhenUnknownTypeExpectsNotFoundError", func(t *testing.T) {
 		// arrange
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
-		fakeTokenFetcher.TokenReturns("Token", nil)
-
-		// return server error from http handler
+		fakeTokenFetcher.TokenReturns("token", nil)
 		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(500)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"unknown workflow type"}`))
 		})
-
-		// set up routes
 		r := mux.NewRouter()
 		r.HandleFunc(endpoint, handler)
 		testServer := httptest.NewServer(r)
@@ -198,54 +199,29 @@ func TestUpdateActivity(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.UpdateActivity(workflowID, activityToReturn)
+		info, err := client.GetWorkflowType("Unknown")
 
 		// assert
-		assert.Nil(t, activity, "Expected no activity to be returned due to API error")
-		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+		assert.Nil(t, info, "Expected no type info returned")
+		assert.IsType(t, &operations.GetWorkflowTypeNotFound{}, err, "Expected the not found error from the workflow API")
 	})
 }
 
-func TestUpdateActivityPercentComplete(t *testing.T) {
+func TestGetWorkflow(t *testing.T) {
 	// arrange
 	workflowID := "my-workflow"
-	activityID := "my-activity"
-	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
 
-	t.Run("WhenSuccessfulExpectsUpdatedActivityInRequest", func(t *testing.T) {
+	t.Run("WhenSuccessfulExpectsWorkflowReturned", func(t *testing.T) {
 		// arrange
-		expectedActivity := &models.Activity{
-			ID:              swag.String(activityID),
-			Status:          swag.String(models.ActivityStatusRunning),
-			PercentComplete: 32,
-		}
-		var actualActivity models.Activity
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
 		fakeTokenFetcher.TokenReturns("token", nil)
-
 		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "application/json")
 			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
-			receivedWorkflowID := mux.Vars(r)["workflowID"]
-			receivedActivityID := mux.Vars(r)["activityID"]
-			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
-			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
-			bodyBytes, err := ioutil.ReadAll(r.Body)
-			if err != nil {
-				t.Fatal(err)
-			}
-			err = json.Unmarshal(bodyBytes, &actualActivity)
-			if err != nil {
-				t.Fatal(err)
-			}
-			bytes, err := json.Marshal(&models.Activity{})
-			if err != nil {
-				t.Fatal("Failed to marshal activity " + err.Error())
-			}
-			w.Write(bytes)
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			w.Write([]byte(`{"id":"my-workflow","state":"Running"}`))
 		})
-
-		// Setup routes
 		r := mux.NewRouter()
 		r.HandleFunc(endpoint, handler)
 		testServer := httptest.NewServer(r)
@@ -253,15 +229,11 @@ func TestUpdateActivityPercentComplete(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.UpdateActivityPercentComplete(workflowID, activityID, int(expectedActivity.PercentComplete))
+		workflow, err := client.GetWorkflow(workflowID)
 
 		// assert
-		assert.Equal(t, *expectedActivity.ID, *actualActivity.ID, "Expected activity IDs to match")
-		assert.Equal(t, models.ActivityStatusRunning, *actualActivity.Status, "Expected activity status to be: "+models.ActivityStatusRunning)
-		assert.EqualValues(t, expectedActivity.PercentComplete, actualActivity.PercentComplete, "Expected percent complete to match what was passed in")
 		assert.Nil(t, err, "Expected no error")
-		assert.Nil(t, actualActivity.Error, "Expected no activity error")
-		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
+		assert.Equal(t, &models.Workflow{ID: workflowID, State: models.WorkflowStateRunning}, workflow, "Expected the workflow to be returned")
 	})
 
 	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
@@ -272,106 +244,81 @@ func TestUpdateActivityPercentComplete(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.UpdateActivityPercentComplete(workflowID, activityID, 0)
+		workflow, err := client.GetWorkflow(workflowID)
 
 		// assert
-		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
+		assert.Nil(t, workflow, "Expected no workflow returned")
 		assert.Equal(t, expectedError, err, "Expected an error returned")
-
 	})
+}
 
-	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+func TestGetChildWorkflows(t *testing.T) {
+	// arrange
+	parentWorkflowID := "parent-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/children"
+	children := map[string]string{
+		parentWorkflowID: `[{"id":"child-1","parentId":"parent-workflow","state":"Completed"},{"id":"child-2","parentId":"parent-workflow","state":"Running"}]`,
+		"child-1":        `[]`,
+		"child-2":        `[]`,
+	}
+
+	t.Run("WhenWorkflowHasChildrenExpectsHierarchyReconstructed", func(t *testing.T) {
 		// arrange
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
-		fakeTokenFetcher.TokenReturns("Token", nil)
-
-		// return server error from http handler
-		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(500)
-		})
-
-		// set up routes
+		fakeTokenFetcher.TokenReturns("token", nil)
 		r := mux.NewRouter()
-		r.HandleFunc(endpoint, handler)
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(children[mux.Vars(r)["workflowID"]]))
+		}).Methods("GET")
 		testServer := httptest.NewServer(r)
 		defer testServer.Close()
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.UpdateActivityPercentComplete(workflowID, activityID, 0)
+		tree := map[string][]string{}
+		pending := []string{parentWorkflowID}
+		var err error
+		for len(pending) > 0 && err == nil {
+			workflowID := pending[0]
+			pending = pending[1:]
+			var workflows []*models.Workflow
+			workflows, err = client.GetChildWorkflows(workflowID)
+			for _, child := range workflows {
+				assert.Equal(t, workflowID, child.ParentID, "Expected the parent ID of the child")
+				tree[workflowID] = append(tree[workflowID], child.ID)
+				pending = append(pending, child.ID)
+			}
+		}
 
 		// assert
-		assert.Nil(t, activity, "Expected no activity to be returned due to API error")
-		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+		assert.Nil(t, err, "Expected no error getting the child workflows")
+		assert.Equal(t, map[string][]string{parentWorkflowID: {"child-1", "child-2"}}, tree, "Expected the parent with its two children")
 	})
-}
-
-func TestCompleteSuccessfulActivity(t *testing.T) {
-	// arrange
-	workflowID := "my-workflow"
-	activityID := "my-activity"
-	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
 
-	t.Run("WhenSuccessfulExpectsCompletedActivityInRequest", func(t *testing.T) {
+	t.Run("WhenResponseEmptyExpectsEmptySlice", func(t *testing.T) {
 		// arrange
-		result := struct{ Foo string }{Foo: "Bar"}
-		resultBytes, err := json.Marshal(result)
-		if err != nil {
-			t.Fatal(err)
-		}
-		expectedActivity := &models.Activity{
-			ID:              swag.String(activityID),
-			Status:          swag.String(models.ActivityStatusCompleted),
-			PercentComplete: 100,
-			Result:          string(resultBytes),
-		}
-		var actualActivity models.Activity
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
 		fakeTokenFetcher.TokenReturns("token", nil)
-
-		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Content-Type", "application/json")
-			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
-			receivedWorkflowID := mux.Vars(r)["workflowID"]
-			receivedActivityID := mux.Vars(r)["activityID"]
-			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
-			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
-			bodyBytes, err := ioutil.ReadAll(r.Body)
-			if err != nil {
-				t.Fatal(err)
-			}
-			err = json.Unmarshal(bodyBytes, &actualActivity)
-			if err != nil {
-				t.Fatal(err)
-			}
-			bytes, err := json.Marshal(&models.Activity{})
-			if err != nil {
-				t.Fatal("Failed to marshal activity " + err.Error())
-			}
-			w.Write(bytes)
-		})
-
-		// Setup routes
 		r := mux.NewRouter()
-		r.HandleFunc(endpoint, handler)
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+		}).Methods("GET")
 		testServer := httptest.NewServer(r)
 		defer testServer.Close()
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.CompleteSuccessfulActivity(workflowID, activityID, result)
+		workflows, err := client.GetChildWorkflows(parentWorkflowID)
 
 		// assert
-		assert.Equal(t, *expectedActivity.ID, *actualActivity.ID, "Expected activity IDs to match")
-		assert.Equal(t, models.ActivityStatusCompleted, *actualActivity.Status, "Expected activity status to be: "+models.ActivityStatusCompleted)
-		assert.Equal(t, expectedActivity.Result, actualActivity.Result, "Expected activity result to match")
-		assert.EqualValues(t, 100, actualActivity.PercentComplete, "Expected percent complete to be 100")
-		assert.Nil(t, err, "Expected no error")
-		assert.Nil(t, actualActivity.Error, "Expected no activity error")
-		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
+		assert.Nil(t, err, "Expected no error getting the child workflows")
+		assert.NotNil(t, workflows, "Expected an empty slice rather than nil")
+		assert.Empty(t, workflows, "Expected no child workflows")
 	})
 
-	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+	t.Run("WhenAuthTokenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
 		// arrange
 		expectedError := errors.New("Some auth0 error")
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
@@ -379,198 +326,189 @@ func TestCompleteSuccessfulActivity(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.CompleteSuccessfulActivity(workflowID, activityID, nil)
+		workflows, err := client.GetChildWorkflows(parentWorkflowID)
 
 		// assert
-		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
+		assert.Nil(t, workflows, "Expected no workflows to be returned due to token error")
 		assert.Equal(t, expectedError, err, "Expected an error returned")
-
 	})
+}
 
-	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+func TestGetWorkflowByExternalID(t *testing.T) {
+	// arrange
+	externalID := "job-42"
+	orgID := int32(10)
+	endpoint := "/" + workflowAPIBasePath + "/workflows/external/{externalID}"
+
+	t.Run("WhenSuccessfulExpectsWorkflowReturned", func(t *testing.T) {
 		// arrange
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
-		fakeTokenFetcher.TokenReturns("Token", nil)
-
-		// return server error from http handler
-		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(500)
-		})
-
-		// set up routes
+		fakeTokenFetcher.TokenReturns("token", nil)
 		r := mux.NewRouter()
-		r.HandleFunc(endpoint, handler)
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			assert.Equal(t, externalID, mux.Vars(r)["externalID"], "Expected the external ID in the path")
+			assert.Equal(t, "10", r.URL.Query().Get("organizationId"), "Expected the organization ID in the query")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"sim-200","externalId":"job-42","organizationId":10,"state":"Running"}`))
+		}).Methods("GET")
 		testServer := httptest.NewServer(r)
 		defer testServer.Close()
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.CompleteSuccessfulActivity(workflowID, activityID, nil)
+		workflow, err := client.GetWorkflowByExternalID(externalID, orgID)
 
 		// assert
-		assert.Nil(t, activity, "Expected no activity to be returned due to API error")
-		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+		assert.Nil(t, err, "Expected no error getting the workflow")
+		if assert.NotNil(t, workflow, "Expected the workflow to be returned") {
+			assert.Equal(t, "sim-200", workflow.ID, "Expected the workflow of the response")
+			assert.Equal(t, externalID, workflow.ExternalID, "Expected the external ID of the workflow")
+		}
 	})
-}
-
-func TestCompleteCancelledActivity(t *testing.T) {
-	// arrange
-	workflowID := "my-workflow"
-	activityID := "my-activity"
-	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
 
-	t.Run("WhenSuccessfulExpectsCancelledActivityInRequest", func(t *testing.T) {
+	t.Run("WhenUnknownExpectsWorkflowNotFoundError", func(t *testing.T) {
 		// arrange
-		expectedActivity := &models.Activity{
-			ID:     swag.String(activityID),
-			Status: swag.String(models.ActivityStatusCancelled),
-			Error:  &models.ActivityError{Reason: swag.String("some reason"), Details: "some cancel details"},
-		}
-		var actualActivity models.Activity
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
 		fakeTokenFetcher.TokenReturns("token", nil)
-
-		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "application/json")
-			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
-			receivedWorkflowID := mux.Vars(r)["workflowID"]
-			receivedActivityID := mux.Vars(r)["activityID"]
-			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
-			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
-			bodyBytes, err := ioutil.ReadAll(r.Body)
-			if err != nil {
-				t.Fatal(err)
-			}
-			err = json.Unmarshal(bodyBytes, &actualActivity)
-			if err != nil {
-				t.Fatal(err)
-			}
-			bytes, err := json.Marshal(&models.Activity{})
-			if err != nil {
-				t.Fatal("Failed to marshal activity " + err.Error())
-			}
-			w.Write(bytes)
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"Workflow not found"}`))
 		})
-
-		// Setup routes
-		r := mux.NewRouter()
-		r.HandleFunc(endpoint, handler)
 		testServer := httptest.NewServer(r)
 		defer testServer.Close()
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.CompleteCancelledActivity(workflowID, activityID, *expectedActivity.Error.Reason, expectedActivity.Error.Details)
+		workflow, err := client.GetWorkflowByExternalID(externalID, orgID)
 
 		// assert
-		assert.Equal(t, *expectedActivity.ID, *actualActivity.ID, "Expected activity IDs to match")
-		assert.Equal(t, models.ActivityStatusCancelled, *actualActivity.Status, "Expected activity status to be: "+models.ActivityStatusCancelled)
-		assert.NotNil(t, actualActivity.Error, "Expected an activity error")
-		assert.Equal(t, expectedActivity.Error.Details, actualActivity.Error.Details, "Expected error details to be passed in")
-		assert.Equal(t, *expectedActivity.Error.Reason, *actualActivity.Error.Reason, "Expected error reason to be passed in")
-		assert.Nil(t, err, "Expected no error")
-		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
+		assert.Nil(t, workflow, "Expected no workflow")
+		assert.Equal(t, &WorkflowNotFoundError{ExternalID: externalID, OrganizationID: orgID}, err, "Expected a *WorkflowNotFoundError")
 	})
 
-	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+	t.Run("WhenOrganizationIDNotPositiveExpectsOrganizationIDError", func(t *testing.T) {
 		// arrange
-		expectedError := errors.New("Some auth0 error")
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
-		fakeTokenFetcher.TokenReturns("", expectedError)
 		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.CompleteCancelledActivity(workflowID, activityID, "", "")
+		_, err := client.GetWorkflowByExternalID(externalID, 0)
 
 		// assert
-		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
-		assert.Equal(t, expectedError, err, "Expected an error returned")
-
+		assert.Equal(t, &OrganizationIDError{OrganizationID: 0}, err, "Expected an *OrganizationIDError")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no request to be made")
 	})
+}
 
-	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+func TestExportWorkflow(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	workflowEndpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	activitiesEndpoint := workflowEndpoint + "/activities"
+	historyEndpoint := workflowEndpoint + "/history"
+	workflowHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"` + workflowID + `","state":"Failed"}`))
+	})
+	activitiesHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"activities":[{"id":"activity-1","status":"Completed","result":"\"mesh.stl\""},` +
+			`{"id":"activity-2","status":"Failed","error":{"reason":"Out of memory","details":"needed 64GB"}}]}`))
+	})
+	historyHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[{"eventId":1,"eventType":"WorkflowExecutionStarted","time":"2017-06-01T14:00:00.000Z"},` +
+			`{"eventId":2,"eventType":"ActivityTaskFailed","activityId":"activity-2"}]`))
+	})
+	errorHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(500)
+	})
+
+	t.Run("WhenSuccessfulExpectsBundleAssembledFromEveryResponse", func(t *testing.T) {
 		// arrange
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
-		fakeTokenFetcher.TokenReturns("Token", nil)
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(workflowEndpoint, workflowHandler)
+		r.HandleFunc(activitiesEndpoint, activitiesHandler)
+		r.HandleFunc(historyEndpoint, historyHandler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
-		// return server error from http handler
-		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(500)
-		})
+		// act
+		bundle, err := client.ExportWorkflow(workflowID)
 
-		// set up routes
+		// assert
+		assert.Nil(t, err, "Expected no error exporting workflow")
+		assert.Equal(t, workflowID, bundle.Workflow.ID, "Expected the workflow in the bundle")
+		assert.Equal(t, models.WorkflowStateFailed, bundle.Workflow.State, "Expected the workflow state in the bundle")
+		if assert.Len(t, bundle.Activities, 2, "Expected every activity in the bundle") {
+			assert.Equal(t, "Out of memory", *bundle.Activities[1].Error.Reason, "Expected the activity errors in the bundle")
+		}
+		if assert.Len(t, bundle.History, 2, "Expected every history event in the bundle") {
+			assert.Equal(t, "ActivityTaskFailed", *bundle.History[1].EventType, "Expected the history in order")
+		}
+		assert.False(t, time.Time(bundle.ExportedAt).IsZero(), "Expected the export time to be set")
+		_, err = json.Marshal(bundle)
+		assert.Nil(t, err, "Expected the bundle to be serializable")
+	})
+
+	t.Run("WhenHistoryErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
 		r := mux.NewRouter()
-		r.HandleFunc(endpoint, handler)
+		r.HandleFunc(workflowEndpoint, workflowHandler)
+		r.HandleFunc(activitiesEndpoint, activitiesHandler)
+		r.HandleFunc(historyEndpoint, errorHandler)
 		testServer := httptest.NewServer(r)
 		defer testServer.Close()
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.CompleteCancelledActivity(workflowID, activityID, "", "")
+		bundle, err := client.ExportWorkflow(workflowID)
 
 		// assert
-		assert.Nil(t, activity, "Expected no activity to be returned due to API error")
+		assert.Nil(t, bundle, "Expected no bundle to be returned")
 		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
 	})
 }
 
-func TestCompleteFailedActivity(t *testing.T) {
+func TestSignalWorkflow(t *testing.T) {
 	// arrange
 	workflowID := "my-workflow"
-	activityID := "my-activity"
-	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/signals"
 
-	t.Run("WhenSuccessfulExpectsFailedActivityInRequest", func(t *testing.T) {
+	t.Run("WhenSuccessfulExpectsSignalInRequest", func(t *testing.T) {
 		// arrange
-		expectedActivity := &models.Activity{
-			ID:     swag.String(activityID),
-			Status: swag.String(models.ActivityStatusFailed),
-			Error:  &models.ActivityError{Reason: swag.String("some reason"), Details: "some failure details"},
-		}
-		var actualActivity models.Activity
+		var actualSignal models.Signal
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
 		fakeTokenFetcher.TokenReturns("token", nil)
-
 		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "application/json")
 			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
-			receivedWorkflowID := mux.Vars(r)["workflowID"]
-			receivedActivityID := mux.Vars(r)["activityID"]
-			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
-			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
-			bodyBytes, err := ioutil.ReadAll(r.Body)
-			if err != nil {
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			if err := json.NewDecoder(r.Body).Decode(&actualSignal); err != nil {
 				t.Fatal(err)
 			}
-			err = json.Unmarshal(bodyBytes, &actualActivity)
-			if err != nil {
-				t.Fatal(err)
-			}
-			bytes, err := json.Marshal(&models.Activity{})
-			if err != nil {
-				t.Fatal("Failed to marshal activity " + err.Error())
-			}
-			w.Write(bytes)
 		})
-
-		// Setup routes
 		r := mux.NewRouter()
-		r.HandleFunc(endpoint, handler)
+		r.HandleFunc(endpoint, handler).Methods(http.MethodPost)
 		testServer := httptest.NewServer(r)
 		defer testServer.Close()
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.CompleteFailedActivity(workflowID, activityID, *expectedActivity.Error.Reason, expectedActivity.Error.Details)
+		err := client.SignalWorkflow(workflowID, models.NewSignal("pause", "input"))
 
 		// assert
-		assert.Equal(t, *expectedActivity.ID, *actualActivity.ID, "Expected activity IDs to match")
-		assert.Equal(t, models.ActivityStatusFailed, *actualActivity.Status, "Expected activity status to be: "+models.ActivityStatusCancelled)
-		assert.NotNil(t, actualActivity.Error, "Expected an activity error")
-		assert.Equal(t, *expectedActivity.Error.Reason, *actualActivity.Error.Reason, "Expected error reason to be passed in")
-		assert.Equal(t, expectedActivity.Error.Details, actualActivity.Error.Details, "Expected error details to be passed in")
 		assert.Nil(t, err, "Expected no error")
-		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
+		assert.Equal(t, "pause", *actualSignal.Name, "Expected the signal name to be sent")
+		assert.Equal(t, "input", actualSignal.Input, "Expected the signal input to be sent")
 	})
 
 	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
@@ -581,162 +519,239 @@ func TestCompleteFailedActivity(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.CompleteFailedActivity(workflowID, activityID, "", "")
+		err := client.SignalWorkflow(workflowID, models.NewSignal("pause", ""))
 
 		// assert
-		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
 		assert.Equal(t, expectedError, err, "Expected an error returned")
-
 	})
+}
 
-	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+func TestSignalWorkflowIfRunning(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	workflowEndpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	signalEndpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/signals"
+
+	newServer := func(state string, signals *int) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(workflowEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"my-workflow","state":"` + state + `"}`))
+		})
+		r.HandleFunc(signalEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			*signals++
+		})
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenCompletedExpectsSignalRefused", func(t *testing.T) {
 		// arrange
+		signals := 0
+		testServer := newServer(models.WorkflowStateCompleted, &signals)
+		defer testServer.Close()
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
-		fakeTokenFetcher.TokenReturns("Token", nil)
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
-		// return server error from http handler
-		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(500)
-		})
+		// act
+		err := client.SignalWorkflowIfRunning(workflowID, models.NewSignal("pause", ""))
 
-		// set up routes
-		r := mux.NewRouter()
-		r.HandleFunc(endpoint, handler)
-		testServer := httptest.NewServer(r)
+		// assert
+		assert.Equal(t, &WorkflowNotRunningError{WorkflowID: workflowID, State: models.WorkflowStateCompleted}, err, "Expected the signal to be refused")
+		assert.Equal(t, 0, signals, "Expected no signal to be sent")
+	})
+
+	t.Run("WhenRunningExpectsSignalSent", func(t *testing.T) {
+		// arrange
+		signals := 0
+		testServer := newServer(models.WorkflowStateRunning, &signals)
 		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		activity, err := client.CompleteFailedActivity(workflowID, activityID, "", "")
+		err := client.SignalWorkflowIfRunning(workflowID, models.NewSignal("pause", ""))
 
 		// assert
-		assert.Nil(t, activity, "Expected no activity to be returned due to API error")
-		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+		assert.Nil(t, err, "Expected no error")
+		assert.Equal(t, 1, signals, "Expected the signal to be sent")
 	})
 }
 
-func TestHeartbeatActivity(t *testing.T) {
+func TestGetSignalStatus(t *testing.T) {
 	// arrange
 	workflowID := "my-workflow"
-	activityID := "my-activity"
-	taskToken := "token"
-	heartbeatDetails := "details"
-	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}/heartbeat"
-	heartbeatToReturn := &models.Heartbeat{
-		ActivityID: swag.String(activityID),
-		TaskToken:  swag.String(taskToken),
-		Cancelled:  false,
-		Details:    heartbeatDetails,
-	}
+	signalName := "pause"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/signals/{signalName}"
 
-	t.Run("WhenSuccessfulExpectsHeartbeatReturned", func(t *testing.T) {
+	t.Run("WhenSignalProcessedExpectsProcessedStatusReturned", func(t *testing.T) {
 		// arrange
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
 		fakeTokenFetcher.TokenReturns("token", nil)
-		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.Header().Set("Content-Type", "application/json")
-			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
-			receivedWorkflowID := mux.Vars(r)["workflowID"]
-			receivedActivityID := mux.Vars(r)["activityID"]
-			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
-			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
-			bytes, err := json.Marshal(heartbeatToReturn)
-			if err != nil {
-				t.Error("Failed to marshal heartbeat")
-			}
-			w.Write(bytes)
-		})
-
-		// Setup routes
 		r := mux.NewRouter()
-		r.HandleFunc(endpoint, handler)
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, signalName, mux.Vars(r)["signalName"], "Expected signal name received to match what was passed in")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"name":"pause","delivered":true,"deliveredAt":"2017-06-01T10:00:00.000Z","processed":true,"processedAt":"2017-06-01T10:00:05.000Z"}`))
+		}).Methods("GET")
 		testServer := httptest.NewServer(r)
 		defer testServer.Close()
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		heartbeat, err := client.HeartbeatActivity(workflowID, activityID)
+		status, err := client.GetSignalStatus(workflowID, signalName)
 
 		// assert
-		assert.Nil(t, err, "Expected error to be nil when getting workflow")
-		assert.NotNil(t, heartbeat, "Expected retrieved heartbeat to not be nil")
-		assert.Equal(t, *heartbeatToReturn.ActivityID, *heartbeat.ActivityID, "Expected heartbeat activity IDs to match")
-		assert.Equal(t, *heartbeatToReturn.TaskToken, *heartbeat.TaskToken, "Expected heartbeat tokens to match")
-		assert.Equal(t, heartbeatToReturn.Cancelled, heartbeat.Cancelled, "Expected heartbeat cancelled field to match")
-		assert.Equal(t, heartbeatToReturn.Details, heartbeat.Details, "Expected heartbeat details to match")
+		assert.Nil(t, err, "Expected no error getting the signal status")
+		if assert.NotNil(t, status, "Expected the signal status to be returned") {
+			assert.Equal(t, signalName, swag.StringValue(status.Name), "Expected the name of the signal")
+			assert.True(t, status.Delivered, "Expected the signal to be delivered")
+			assert.True(t, status.Processed, "Expected the signal to be processed")
+			assert.Equal(t, time.Date(2017, 6, 1, 10, 0, 5, 0, time.UTC), time.Time(status.ProcessedAt).UTC(), "Expected the time the signal was processed")
+		}
 	})
 
-	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+	t.Run("WhenSignalNotSentExpectsSignalNotFoundError", func(t *testing.T) {
 		// arrange
-		expectedError := errors.New("Some auth0 error")
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
-		fakeTokenFetcher.TokenReturns("", expectedError)
-		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"Signal not found"}`))
+		})
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		heartbeat, err := client.HeartbeatActivity(workflowID, activityID)
+		status, err := client.GetSignalStatus(workflowID, signalName)
 
 		// assert
-		assert.Nil(t, heartbeat, "Expected no heartbeat to be returned due to token error")
-		assert.Equal(t, expectedError, err, "Expected an error returned")
+		assert.Nil(t, status, "Expected no signal status")
+		assert.Equal(t, &SignalNotFoundError{WorkflowID: workflowID, SignalName: signalName}, err, "Expected a *SignalNotFoundError")
 	})
+}
 
-	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+func TestWebhooks(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	callbackURL := "https://example.com/callback"
+	events := []string{models.WebhookRegistrationEventsStateChanged, models.WebhookRegistrationEventsActivityFailed}
+
+	t.Run("WhenRegisteredThenUnregisteredExpectsWebhookRoundTrip", func(t *testing.T) {
 		// arrange
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
-		fakeTokenFetcher.TokenReturns("Token", nil)
-
-		// return server error from http handler
-		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(500)
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var mu sync.Mutex
+		webhooks := map[string]*models.WebhookRegistration{}
+		r := mux.NewRouter()
+		r.HandleFunc("/"+workflowAPIBasePath+"/workflows/{workflowID}/webhooks", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method, "Expected the webhook to be posted")
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			registration := &models.WebhookRegistration{}
+			if err := json.NewDecoder(r.Body).Decode(registration); err != nil {
+				t.Error("Failed to decode webhook registration")
+			}
+			mu.Lock()
+			webhookID := "webhook-" + strconv.Itoa(len(webhooks)+1)
+			webhooks[webhookID] = registration
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(&models.Webhook{ID: swag.String(webhookID), WorkflowID: workflowID, CallbackURL: *registration.CallbackURL, Events: registration.Events})
+		})
+		r.HandleFunc("/"+workflowAPIBasePath+"/webhooks/{webhookID}", func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodDelete, r.Method, "Expected the webhook to be deleted")
+			mu.Lock()
+			defer mu.Unlock()
+			if _, ok := webhooks[mux.Vars(r)["webhookID"]]; !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			delete(webhooks, mux.Vars(r)["webhookID"])
+			w.WriteHeader(http.StatusNoContent)
 		})
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
-		// set up routes
+		// act
+		webhookID, registerErr := client.RegisterWebhook(workflowID, callbackURL, events)
+		registered := webhooks[webhookID]
+		unregisterErr := client.UnregisterWebhook(webhookID)
+
+		// assert
+		assert.Nil(t, registerErr, "Expected no error registering the webhook")
+		assert.Equal(t, "webhook-1", webhookID, "Expected the webhook ID from the workflow API")
+		if assert.NotNil(t, registered, "Expected the webhook to be registered") {
+			assert.Equal(t, callbackURL, *registered.CallbackURL, "Expected the callback URL to be sent")
+			assert.Equal(t, events, registered.Events, "Expected the events to be sent")
+		}
+		assert.Nil(t, unregisterErr, "Expected no error unregistering the webhook")
+		assert.Empty(t, webhooks, "Expected the webhook to be unregistered")
+	})
+
+	t.Run("WhenUnregisteringUnknownWebhookExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
 		r := mux.NewRouter()
-		r.HandleFunc(endpoint, handler)
+		r.HandleFunc("/"+workflowAPIBasePath+"/webhooks/{webhookID}", func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
 		testServer := httptest.NewServer(r)
 		defer testServer.Close()
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		heartbeat, err := client.HeartbeatActivity(workflowID, activityID)
+		err := client.UnregisterWebhook("unknown")
 
 		// assert
-		assert.Nil(t, heartbeat, "Expected no heartbeat to be returned due to API error")
-		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+		_, ok := err.(*operations.UnregisterWebhookNotFound)
+		assert.True(t, ok, "Expected a not found error, got %v", err)
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		webhookID, registerErr := client.RegisterWebhook(workflowID, callbackURL, events)
+		unregisterErr := client.UnregisterWebhook("webhook-1")
+
+		// assert
+		assert.Empty(t, webhookID, "Expected no webhook ID to be returned due to token error")
+		assert.Equal(t, expectedError, registerErr, "Expected an error returned")
+		assert.Equal(t, expectedError, unregisterErr, "Expected an error returned")
 	})
 }
 
-func TestHeartbeatActivityWithToken(t *testing.T) {
+func TestWorkflowRaw(t *testing.T) {
 	// arrange
-	activityID := "my-activity"
-	taskToken := "token"
-	heartbeatDetails := "details"
-	endpoint := "/" + workflowAPIBasePath + "/heartbeats"
-	heartbeatToReturn := &models.Heartbeat{
-		ActivityID: swag.String(activityID),
-		TaskToken:  swag.String(taskToken),
-		Cancelled:  false,
-		Details:    heartbeatDetails,
-	}
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
 
-	t.Run("WhenSuccessfulExpectsHeartbeatReturned", func(t *testing.T) {
+	t.Run("WhenSuccessfulExpectsUnknownFieldsKept", func(t *testing.T) {
 		// arrange
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
 		fakeTokenFetcher.TokenReturns("token", nil)
+		body := `{"id":"my-workflow","state":"Running","someNewField":{"nested":[1,2,3]}}`
+
 		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "application/json")
 			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
-			receivedBytes, err := ioutil.ReadAll(r.Body)
-			if err != nil {
-				assert.Fail(t, "Message did not include heartbeat")
-			}
-			receivedHeartbeat := &models.Heartbeat{}
-			if err := json.Unmarshal(receivedBytes, receivedHeartbeat); err != nil {
-				assert.Fail(t, "Unable to unmarshal heartbeat")
-			}
-			assert.EqualValues(t, heartbeatToReturn, receivedHeartbeat, "Expected received heartbeat to match what was passed in")
-			w.Write(receivedBytes)
+			receivedWorkflowID := mux.Vars(r)["workflowID"]
+			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
+			w.Write([]byte(body))
 		})
 
 		// Setup routes
@@ -747,18 +762,14 @@ func TestHeartbeatActivityWithToken(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		heartbeat, err := client.HeartbeatActivityWithToken(taskToken, activityID, heartbeatDetails)
+		raw, err := client.WorkflowRaw(workflowID)
 
 		// assert
-		assert.Nil(t, err, "Expected error to be nil when getting workflow")
-		assert.NotNil(t, heartbeat, "Expected retrieved heartbeat to not be nil")
-		assert.Equal(t, *heartbeatToReturn.ActivityID, *heartbeat.ActivityID, "Expected heartbeat activity IDs to match")
-		assert.Equal(t, *heartbeatToReturn.TaskToken, *heartbeat.TaskToken, "Expected heartbeat tokens to match")
-		assert.Equal(t, heartbeatToReturn.Cancelled, heartbeat.Cancelled, "Expected heartbeat cancelled field to match")
-		assert.Equal(t, heartbeatToReturn.Details, heartbeat.Details, "Expected heartbeat details to match")
+		assert.Nil(t, err, "Expected no error")
+		assert.Equal(t, body, string(raw), "Expected the body to be returned untouched")
 	})
 
-	t.Run("WhenAuthTokenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
 		// arrange
 		expectedError := errors.New("Some auth0 error")
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
@@ -766,11 +777,11 @@ func TestHeartbeatActivityWithToken(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
 
 		// act
-		heartbeat, err := client.HeartbeatActivityWithToken(taskToken, activityID, heartbeatDetails)
+		raw, err := client.WorkflowRaw(workflowID)
 
 		// assert
-		assert.Nil(t, heartbeat, "Expected no heartbeat to be returned due to token error")
 		assert.Equal(t, expectedError, err, "Expected an error returned")
+		assert.Nil(t, raw, "Expected no workflow returned")
 	})
 
 	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
@@ -778,9 +789,11 @@ func TestHeartbeatActivityWithToken(t *testing.T) {
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
 		fakeTokenFetcher.TokenReturns("Token", nil)
 
-		// return server error from http handler
+		// return not found from http handler
 		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			w.WriteHeader(500)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"not found"}`))
 		})
 
 		// set up routes
@@ -791,50 +804,249 @@ func TestHeartbeatActivityWithToken(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		heartbeat, err := client.HeartbeatActivityWithToken(taskToken, activityID, heartbeatDetails)
+		raw, err := client.WorkflowRaw(workflowID)
 
 		// assert
-		assert.Nil(t, heartbeat, "Expected no heartbeat to be returned due to API error")
-		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+		assert.IsType(t, &operations.GetWorkflowNotFound{}, err, "Expected the not found error from the workflow API")
+		assert.Nil(t, raw, "Expected no workflow returned")
 	})
 }
 
-func TestStartWorkflow(t *testing.T) {
+func TestUpdateActivity(t *testing.T) {
 	// arrange
-	entityID := int32(200)
-	orgID := int32(10)
-	workflowType := models.PostWorkflowWorkflowTypeAssumedStrain
-	workflowID := "sim-200"
-	endpoint := "/" + workflowAPIBasePath + "/workflows"
-	post := &models.PostWorkflow{
-		EntityID:                  swag.Int32(entityID),
-		OrganizationID:            swag.Int32(orgID),
-		WorkflowType:              swag.String(workflowType),
-		RunDistortionCompensation: false,
-		RunSupportOptimization:    false,
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+	activityToReturn := &models.Activity{
+		ID:              swag.String(activityID),
+		Status:          swag.String("Completed"),
+		PercentComplete: 100,
+		Result:          "json-string",
+		Error: &models.ActivityError{
+			Reason:  swag.String("reason"),
+			Details: "details",
+		},
 	}
 
-	t.Run("WhenSuccessfulExpectsWorkflowIDReturned", func(t *testing.T) {
+	t.Run("WhenSuccessfulExpectsActivityReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			receivedWorkflowID := mux.Vars(r)["workflowID"]
+			receivedActivityID := mux.Vars(r)["activityID"]
+			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
+			bytes, err := json.Marshal(activityToReturn)
+			if err != nil {
+				t.Error("Failed to marshal activity")
+			}
+			w.Write(bytes)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.UpdateActivity(workflowID, activityToReturn)
+
+		// assert
+		assert.Nil(t, err, "Expected error to be nil when getting workflow")
+		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
+		assert.Equal(t, *activityToReturn.ID, *activity.ID, "Expected activity IDs to match")
+		assert.Equal(t, *activityToReturn.Status, *activity.Status, "Expected activity status to match")
+		assert.Equal(t, activityToReturn.PercentComplete, activity.PercentComplete, "Expected activity percent complete to match")
+		assert.Equal(t, activityToReturn.Result, activity.Result, "Expected activity result to match")
+		assert.NotNil(t, activity.Error, "Expected activity error to not be nil")
+		assert.Equal(t, *activityToReturn.Error.Reason, *activity.Error.Reason, "Expected activity error reason to not be nil")
+		assert.Equal(t, activityToReturn.Error.Details, activity.Error.Details, "Expected activity error details to not be nil")
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.UpdateActivity(workflowID, activityToReturn)
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("Token", nil)
+
+		// return server error from http handler
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.UpdateActivity(workflowID, activityToReturn)
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to API error")
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+	})
+
+	t.Run("WhenVersionSetExpectsIfMatchSent", func(t *testing.T) {
+		// arrange
+		var ifMatch string
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			ifMatch = r.Header.Get("If-Match")
+			w.Write([]byte(`{"id":"my-activity","status":"Running","version":"4"}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		activityToSend := &models.Activity{ID: swag.String(activityID), Status: swag.String(models.ActivityStatusRunning), Version: "3"}
+
+		// act
+		activity, err := client.UpdateActivity(workflowID, activityToSend)
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.Equal(t, "3", ifMatch, "Expected the version to be sent as If-Match")
+		assert.Equal(t, "4", activity.Version, "Expected the new version to be returned")
+	})
+
+	t.Run("WhenVersionStaleExpectsVersionConflictError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusPreconditionFailed)
+			w.Write([]byte(`{"code":412,"message":"version mismatch"}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		activityToSend := &models.Activity{ID: swag.String(activityID), Status: swag.String(models.ActivityStatusRunning), Version: "3"}
+
+		// act
+		activity, err := client.UpdateActivity(workflowID, activityToSend)
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to the conflict")
+		assert.Equal(t, &ActivityVersionConflictError{WorkflowID: workflowID, ActivityID: activityID, Version: "3"}, err, "Expected a version conflict error")
+	})
+
+	t.Run("WhenOnlyStatusSetExpectsZeroFieldsNotSent", func(t *testing.T) {
+		// arrange
+		var sentFields map[string]interface{}
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&sentFields)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"my-activity","status":"Cancelled","percentComplete":40}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.UpdateActivity(workflowID, &models.Activity{ID: swag.String(activityID), Status: swag.String(models.ActivityStatusCancelled)})
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.Equal(t, map[string]interface{}{"id": activityID, "status": models.ActivityStatusCancelled}, sentFields, "Expected only the ID and the status to be sent")
+		assert.NotContains(t, sentFields, "percentComplete", "Expected percent complete not to be sent as 0")
+		assert.EqualValues(t, 40, activity.PercentComplete, "Expected the percent complete kept by the workflow API to be returned")
+	})
+
+	t.Run("WhenNoVersionExpectsNoIfMatchSent", func(t *testing.T) {
+		// arrange
+		sentIfMatch := true
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			_, sentIfMatch = r.Header["If-Match"]
+			w.Write([]byte(`{}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.UpdateActivity(workflowID, &models.Activity{ID: swag.String(activityID), Status: swag.String(models.ActivityStatusRunning)})
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.False(t, sentIfMatch, "Expected no If-Match header without a version")
+	})
+}
+
+func TestUpdateActivityPercentComplete(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+
+	t.Run("WhenSuccessfulExpectsUpdatedActivityInRequest", func(t *testing.T) {
 		// arrange
+		expectedActivity := &models.Activity{
+			ID:              swag.String(activityID),
+			Status:          swag.String(models.ActivityStatusRunning),
+			PercentComplete: 32,
+		}
+		var actualActivity models.Activity
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
 		fakeTokenFetcher.TokenReturns("token", nil)
+
 		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.Header().Set("Content-Type", "application/json")
 			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			receivedWorkflowID := mux.Vars(r)["workflowID"]
+			receivedActivityID := mux.Vars(r)["activityID"]
+			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
 			bodyBytes, err := ioutil.ReadAll(r.Body)
 			if err != nil {
-				assert.Fail(t, "Message body did not include workflow")
+				t.Fatal(err)
 			}
-			receivedWorkflow := &models.PostWorkflow{}
-			if err := json.Unmarshal(bodyBytes, receivedWorkflow); err != nil {
-				assert.Fail(t, "Unable to unmarshal workflow")
+			err = json.Unmarshal(bodyBytes, &actualActivity)
+			if err != nil {
+				t.Fatal(err)
 			}
-			assert.EqualValues(t, post, receivedWorkflow, "Expected recieved workflow to match what was passed in")
-			workflowIDBytes, err := json.Marshal(workflowID)
+			bytes, err := json.Marshal(&models.Activity{})
 			if err != nil {
-				assert.Fail(t, "Failed to marshal workflow ID")
+				t.Fatal("Failed to marshal activity " + err.Error())
 			}
-			w.Write(workflowIDBytes)
+			w.Write(bytes)
 		})
 
 		// Setup routes
@@ -845,14 +1057,18 @@ func TestStartWorkflow(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		returnedWorkflowID, err := client.StartWorkflow(post)
+		activity, err := client.UpdateActivityPercentComplete(workflowID, activityID, int(expectedActivity.PercentComplete))
 
 		// assert
-		assert.Nil(t, err, "Expected error to be nil when getting workflow")
-		assert.Equal(t, workflowID, returnedWorkflowID, "Expected returned workflow ID to match response value")
+		assert.Equal(t, *expectedActivity.ID, *actualActivity.ID, "Expected activity IDs to match")
+		assert.Equal(t, models.ActivityStatusRunning, *actualActivity.Status, "Expected activity status to be: "+models.ActivityStatusRunning)
+		assert.EqualValues(t, expectedActivity.PercentComplete, actualActivity.PercentComplete, "Expected percent complete to match what was passed in")
+		assert.Nil(t, err, "Expected no error")
+		assert.Nil(t, actualActivity.Error, "Expected no activity error")
+		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
 	})
 
-	t.Run("WhenAuthTokenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
 		// arrange
 		expectedError := errors.New("Some auth0 error")
 		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
@@ -860,11 +1076,12 @@ func TestStartWorkflow(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
 
 		// act
-		workflowID, err := client.StartWorkflow(post)
+		activity, err := client.UpdateActivityPercentComplete(workflowID, activityID, 0)
 
 		// assert
-		assert.Empty(t, workflowID, "Expected no workflow ID to be returned due to token error")
+		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
 		assert.Equal(t, expectedError, err, "Expected an error returned")
+
 	})
 
 	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
@@ -885,10 +1102,3691 @@ func TestStartWorkflow(t *testing.T) {
 		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
 
 		// act
-		workflowID, err := client.StartWorkflow(post)
+		activity, err := client.UpdateActivityPercentComplete(workflowID, activityID, 0)
 
 		// assert
-		assert.Empty(t, workflowID, "Expected no workflow ID to be returned due to api error")
+		assert.Nil(t, activity, "Expected no activity to be returned due to API error")
 		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
 	})
+
+	t.Run("WhenOutOfRangeExpectsClamped", func(t *testing.T) {
+		// arrange
+		var sent []int32
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			var actualActivity models.Activity
+			if err := json.NewDecoder(r.Body).Decode(&actualActivity); err != nil {
+				t.Fatal(err)
+			}
+			sent = append(sent, actualActivity.PercentComplete)
+			w.Write([]byte(`{}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, errAbove := client.UpdateActivityPercentComplete(workflowID, activityID, 150)
+		_, errBelow := client.UpdateActivityPercentComplete(workflowID, activityID, -5)
+
+		// assert
+		assert.Nil(t, errAbove, "Expected no error when clamping")
+		assert.Nil(t, errBelow, "Expected no error when clamping")
+		assert.Equal(t, []int32{100, 0}, sent, "Expected 150 to be clamped to 100 and -5 to 0")
+	})
+
+	t.Run("WhenStrictAndOutOfRangeExpectsRangeError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger, WithStrictPercentComplete())
+
+		// act
+		activity, err := client.UpdateActivityPercentComplete(workflowID, activityID, 150)
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to the range error")
+		assert.Equal(t, &PercentCompleteRangeError{PercentComplete: 150}, err, "Expected a range error")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected nothing to be sent")
+	})
+}
+
+func TestUpdateActivitiesPercentComplete(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+	progress := map[string]int{"activity-a": 10, "activity-b": 150, "activity-c": 30}
+	// newServer returns the activity sent and keeps the percent complete received for each activity
+	newServer := func(received map[string]int32, mu *sync.Mutex) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			var activity models.Activity
+			if err := json.NewDecoder(r.Body).Decode(&activity); err != nil {
+				t.Fatal(err)
+			}
+			mu.Lock()
+			received[mux.Vars(r)["activityID"]] = activity.PercentComplete
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&activity)
+		}).Methods("PUT")
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenValueOutOfRangeExpectsValueClampedAndEveryActivityUpdated", func(t *testing.T) {
+		// arrange
+		var mu sync.Mutex
+		received := make(map[string]int32)
+		testServer := newServer(received, &mu)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activities, errs := client.UpdateActivitiesPercentComplete(workflowID, progress)
+
+		// assert
+		assert.Equal(t, []error{nil, nil, nil}, errs, "Expected no error")
+		if assert.Len(t, activities, 3, "Expected an activity for each activity ID") {
+			assert.Equal(t, "activity-a", swag.StringValue(activities[0].ID), "Expected the activities in the order of the activity IDs")
+			assert.Equal(t, "activity-c", swag.StringValue(activities[2].ID), "Expected the activities in the order of the activity IDs")
+		}
+		assert.Equal(t, map[string]int32{"activity-a": 10, "activity-b": 100, "activity-c": 30}, received, "Expected the out of range value to be clamped")
+	})
+
+	t.Run("WhenStrictAndValueOutOfRangeExpectsErrorAlignedWithActivity", func(t *testing.T) {
+		// arrange
+		var mu sync.Mutex
+		received := make(map[string]int32)
+		testServer := newServer(received, &mu)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithStrictPercentComplete())
+
+		// act
+		activities, errs := client.UpdateActivitiesPercentComplete(workflowID, progress)
+
+		// assert
+		if assert.Len(t, errs, 3, "Expected an error slot for each activity ID") && assert.Len(t, activities, 3, "Expected an activity slot for each activity ID") {
+			assert.Nil(t, errs[0], "Expected the first activity to be updated")
+			assert.Equal(t, &ActivityError{ActivityID: "activity-b", Err: &PercentCompleteRangeError{PercentComplete: 150}}, errs[1], "Expected the error of the invalid value")
+			assert.Nil(t, activities[1], "Expected no activity for the invalid value")
+			assert.Nil(t, errs[2], "Expected the last activity to be updated")
+		}
+		assert.Equal(t, map[string]int32{"activity-a": 10, "activity-c": 30}, received, "Expected the invalid value not to be sent")
+	})
+}
+
+func TestPatchActivity(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+
+	t.Run("WhenSuccessfulExpectsOnlyPatchedFieldsSent", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedBody map[string]interface{}
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPatch, r.Method, "Expected a PATCH request")
+			assert.Equal(t, "application/merge-patch+json", r.Header.Get("Content-Type"), "Expected a JSON merge patch")
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, mux.Vars(r)["activityID"], "Expected activity id received to match what was passed in")
+			if err := json.NewDecoder(r.Body).Decode(&receivedBody); err != nil {
+				t.Error("Failed to decode patch")
+			}
+			activity := models.NewActivity(activityID, models.ActivityStatusRunning)
+			activity.PercentComplete = 50
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(activity)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.PatchActivity(workflowID, activityID, map[string]interface{}{"percentComplete": 50})
+
+		// assert
+		assert.Nil(t, err, "Expected no error patching activity")
+		assert.Equal(t, map[string]interface{}{"percentComplete": float64(50)}, receivedBody, "Expected only the patched fields to be sent")
+		if assert.NotNil(t, activity, "Expected the patched activity to be returned") {
+			assert.Equal(t, int32(50), activity.PercentComplete, "Expected the patched activity")
+		}
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.PatchActivity(workflowID, activityID, map[string]interface{}{"percentComplete": 50})
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.PatchActivity(workflowID, activityID, map[string]interface{}{"percentComplete": 50})
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to API error")
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+	})
+}
+
+func TestCompleteSuccessfulActivity(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+
+	t.Run("WhenSuccessfulExpectsCompletedActivityInRequest", func(t *testing.T) {
+		// arrange
+		result := struct{ Foo string }{Foo: "Bar"}
+		resultBytes, err := json.Marshal(result)
+		if err != nil {
+			t.Fatal(err)
+		}
+		expectedActivity := &models.Activity{
+			ID:              swag.String(activityID),
+			Status:          swag.String(models.ActivityStatusCompleted),
+			PercentComplete: 100,
+			Result:          string(resultBytes),
+		}
+		var actualActivity models.Activity
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			receivedWorkflowID := mux.Vars(r)["workflowID"]
+			receivedActivityID := mux.Vars(r)["activityID"]
+			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
+			bodyBytes, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = json.Unmarshal(bodyBytes, &actualActivity)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bytes, err := json.Marshal(&models.Activity{})
+			if err != nil {
+				t.Fatal("Failed to marshal activity " + err.Error())
+			}
+			w.Write(bytes)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteSuccessfulActivity(workflowID, activityID, result)
+
+		// assert
+		assert.Equal(t, *expectedActivity.ID, *actualActivity.ID, "Expected activity IDs to match")
+		assert.Equal(t, models.ActivityStatusCompleted, *actualActivity.Status, "Expected activity status to be: "+models.ActivityStatusCompleted)
+		assert.Equal(t, expectedActivity.Result, actualActivity.Result, "Expected activity result to match")
+		assert.EqualValues(t, 100, actualActivity.PercentComplete, "Expected percent complete to be 100")
+		assert.Nil(t, err, "Expected no error")
+		assert.Nil(t, actualActivity.Error, "Expected no activity error")
+		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
+	})
+
+	t.Run("WhenContextHasAttemptExpectsAttemptInRequest", func(t *testing.T) {
+		// arrange
+		var actualActivity models.Activity
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewDecoder(r.Body).Decode(&actualActivity); err != nil {
+				t.Fatal(err)
+			}
+			w.Write([]byte("{}"))
+		})
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger).WithContext(ContextWithAttempt(context.Background(), 2))
+
+		// act
+		_, err := client.CompleteSuccessfulActivity(workflowID, activityID, "result")
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.EqualValues(t, 2, actualActivity.Attempt, "Expected the attempt number sent with the completion")
+		assert.Equal(t, models.ActivityStatusCompleted, swag.StringValue(actualActivity.Status), "Expected activity status to be: "+models.ActivityStatusCompleted)
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteSuccessfulActivity(workflowID, activityID, nil)
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("Token", nil)
+
+		// return server error from http handler
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteSuccessfulActivity(workflowID, activityID, nil)
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to API error")
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+	})
+
+	t.Run("WhenAlreadyCompletedWithSameStatusExpectsExistingActivityReturned", func(t *testing.T) {
+		// arrange
+		existingActivity := &models.Activity{
+			ID:              swag.String(activityID),
+			Status:          swag.String(models.ActivityStatusCompleted),
+			PercentComplete: 100,
+			Result:          "existing result",
+		}
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+
+		// return conflict because the activity was completed by an earlier request
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			bytes, err := json.Marshal(existingActivity)
+			if err != nil {
+				t.Fatal("Failed to marshal activity " + err.Error())
+			}
+			w.Write(bytes)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteSuccessfulActivity(workflowID, activityID, "result")
+
+		// assert
+		assert.Nil(t, err, "Expected no error because the activity was already completed successfully")
+		assert.Equal(t, existingActivity, activity, "Expected the existing activity to be returned")
+	})
+
+	t.Run("WhenAlreadyCompletedWithDifferentStatusExpectsConflictError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+
+		// return conflict because the activity was already cancelled
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			bytes, err := json.Marshal(&models.Activity{
+				ID:     swag.String(activityID),
+				Status: swag.String(models.ActivityStatusCancelled),
+			})
+			if err != nil {
+				t.Fatal("Failed to marshal activity " + err.Error())
+			}
+			w.Write(bytes)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteSuccessfulActivity(workflowID, activityID, "result")
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to conflict")
+		if assert.IsType(t, &ActivityConflictError{}, err, "Expected a conflict error") {
+			conflictErr := err.(*ActivityConflictError)
+			assert.Equal(t, workflowID, conflictErr.WorkflowID, "Expected workflow ID in conflict error")
+			assert.Equal(t, activityID, conflictErr.ActivityID, "Expected activity ID in conflict error")
+			assert.Equal(t, models.ActivityStatusCompleted, conflictErr.Status, "Expected the status being set in conflict error")
+			assert.Equal(t, models.ActivityStatusCancelled, conflictErr.ExistingStatus, "Expected the existing status in conflict error")
+		}
+	})
+
+	t.Run("WhenResultHasLargeNumbersExpectsThemTransmittedExactly", func(t *testing.T) {
+		// arrange
+		// 2^53 + 1 can't be represented by a float64
+		var largeID int64 = 9007199254740993
+		var actualActivity models.Activity
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if err := json.NewDecoder(r.Body).Decode(&actualActivity); err != nil {
+				t.Fatal(err)
+			}
+			w.Write([]byte(`{}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		results := map[string]interface{}{
+			"int64":       struct{ ID int64 }{ID: largeID},
+			"json.Number": map[string]json.Number{"ID": "9007199254740993"},
+			"RawMessage":  json.RawMessage(`{"ID":9007199254740993}`),
+		}
+
+		for name, result := range results {
+			// act
+			_, err := client.CompleteSuccessfulActivity(workflowID, activityID, result)
+
+			// assert
+			assert.Nil(t, err, "Expected no error for "+name)
+			var transmitted struct{ ID json.Number }
+			decoder := json.NewDecoder(strings.NewReader(actualActivity.Result))
+			decoder.UseNumber()
+			if err := decoder.Decode(&transmitted); err != nil {
+				t.Fatal(err)
+			}
+			assert.Equal(t, "9007199254740993", transmitted.ID.String(), "Expected the number to be transmitted exactly for "+name)
+		}
+	})
+
+	t.Run("WhenRawResultIsInvalidExpectsError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteSuccessfulActivity(workflowID, activityID, json.RawMessage(`{"ID":`))
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity returned")
+		assert.NotNil(t, err, "Expected an error for invalid JSON")
+	})
+
+	t.Run("WhenResultNilExpectsEmptyResultOrNilResultOption", func(t *testing.T) {
+		// arrange
+		var sentFields map[string]interface{}
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sentFields = nil
+			json.NewDecoder(r.Body).Decode(&sentFields)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		clientWithNilResult := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithNilResult("{}"))
+		var nilOutput *simulationOutput
+
+		// act
+		_, err := client.CompleteSuccessfulActivity(workflowID, activityID, nil)
+		nilSent := sentFields
+		client.CompleteSuccessfulActivity(workflowID, activityID, nilOutput)
+		nilPointerSent := sentFields
+		clientWithNilResult.CompleteSuccessfulActivity(workflowID, activityID, nil)
+		optionSent := sentFields
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.NotContains(t, nilSent, "result", "Expected no result sent for nil")
+		assert.NotContains(t, nilPointerSent, "result", "Expected no result sent for a nil pointer")
+		assert.Equal(t, "{}", optionSent["result"], "Expected the result set with WithNilResult")
+	})
+}
+
+func TestCompleteSuccessfulActivityStream(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+
+	t.Run("WhenSuccessfulExpectsResultTransmittedIntact", func(t *testing.T) {
+		// arrange
+		// large enough to span several chunks and with characters that must be escaped or are multi-byte
+		result := `{"text":"quote \" backslash \\ newline \n tab \t"},` + strings.Repeat("résultat ✓ <&>", 10000)
+		var actualActivity models.Activity
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, mux.Vars(r)["activityID"], "Expected activity id received to match what was passed in")
+			bodyBytes, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = json.Unmarshal(bodyBytes, &actualActivity)
+			if err != nil {
+				t.Fatal(err)
+			}
+			w.Write(bodyBytes)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteSuccessfulActivityStream(workflowID, activityID, bytes.NewReader([]byte(result)))
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.Equal(t, activityID, *actualActivity.ID, "Expected activity IDs to match")
+		assert.Equal(t, models.ActivityStatusCompleted, *actualActivity.Status, "Expected activity status to be: "+models.ActivityStatusCompleted)
+		assert.EqualValues(t, 100, actualActivity.PercentComplete, "Expected percent complete to be 100")
+		assert.True(t, result == actualActivity.Result, "Expected activity result to be transmitted intact")
+		if assert.NotNil(t, activity, "Expected activity to be returned") {
+			assert.True(t, result == activity.Result, "Expected returned activity to have the result")
+		}
+	})
+
+	t.Run("WhenTokenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteSuccessfulActivityStream(workflowID, activityID, bytes.NewReader([]byte("result")))
+
+		// assert
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+		assert.Nil(t, activity, "Expected no activity returned")
+	})
+}
+
+func TestCompleteCancelledActivity(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+
+	t.Run("WhenSuccessfulExpectsCancelledActivityInRequest", func(t *testing.T) {
+		// arrange
+		expectedActivity := &models.Activity{
+			ID:     swag.String(activityID),
+			Status: swag.String(models.ActivityStatusCancelled),
+			Error:  &models.ActivityError{Reason: swag.String("some reason"), Details: "some cancel details"},
+		}
+		var actualActivity models.Activity
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			receivedWorkflowID := mux.Vars(r)["workflowID"]
+			receivedActivityID := mux.Vars(r)["activityID"]
+			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
+			bodyBytes, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = json.Unmarshal(bodyBytes, &actualActivity)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bytes, err := json.Marshal(&models.Activity{})
+			if err != nil {
+				t.Fatal("Failed to marshal activity " + err.Error())
+			}
+			w.Write(bytes)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteCancelledActivity(workflowID, activityID, *expectedActivity.Error.Reason, expectedActivity.Error.Details)
+
+		// assert
+		assert.Equal(t, *expectedActivity.ID, *actualActivity.ID, "Expected activity IDs to match")
+		assert.Equal(t, models.ActivityStatusCancelled, *actualActivity.Status, "Expected activity status to be: "+models.ActivityStatusCancelled)
+		assert.NotNil(t, actualActivity.Error, "Expected an activity error")
+		assert.Equal(t, expectedActivity.Error.Details, actualActivity.Error.Details, "Expected error details to be passed in")
+		assert.Equal(t, *expectedActivity.Error.Reason, *actualActivity.Error.Reason, "Expected error reason to be passed in")
+		assert.Nil(t, err, "Expected no error")
+		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteCancelledActivity(workflowID, activityID, "", "")
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("Token", nil)
+
+		// return server error from http handler
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteCancelledActivity(workflowID, activityID, "", "")
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to API error")
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+	})
+}
+
+func TestCompleteCancelledActivityWithResult(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+
+	t.Run("WhenSuccessfulExpectsCancelledActivityWithPartialResultInRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var actualActivity models.Activity
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			json.NewDecoder(r.Body).Decode(&actualActivity)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&actualActivity)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteCancelledActivityWithResult(workflowID, activityID, "some reason", "some cancel details", map[string]int{"processedLayers": 42})
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
+		assert.Equal(t, models.ActivityStatusCancelled, swag.StringValue(actualActivity.Status), "Expected activity status to be: "+models.ActivityStatusCancelled)
+		assert.JSONEq(t, `{"processedLayers":42}`, actualActivity.Result, "Expected the partial result to be sent")
+		if assert.NotNil(t, actualActivity.Error, "Expected an activity error") {
+			assert.Equal(t, "some reason", swag.StringValue(actualActivity.Error.Reason), "Expected error reason to be passed in")
+		}
+	})
+
+	t.Run("WhenResultCannotBeMarshalledExpectsErrorWithoutRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteCancelledActivityWithResult(workflowID, activityID, "some reason", "", make(chan int))
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned")
+		assert.NotNil(t, err, "Expected the marshalling error")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no request to be made")
+	})
+}
+
+func TestCompleteFailedActivity(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+
+	t.Run("WhenSuccessfulExpectsFailedActivityInRequest", func(t *testing.T) {
+		// arrange
+		expectedActivity := &models.Activity{
+			ID:     swag.String(activityID),
+			Status: swag.String(models.ActivityStatusFailed),
+			Error:  &models.ActivityError{Reason: swag.String("some reason"), Details: "some failure details"},
+		}
+		var actualActivity models.Activity
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			receivedWorkflowID := mux.Vars(r)["workflowID"]
+			receivedActivityID := mux.Vars(r)["activityID"]
+			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
+			bodyBytes, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = json.Unmarshal(bodyBytes, &actualActivity)
+			if err != nil {
+				t.Fatal(err)
+			}
+			bytes, err := json.Marshal(&models.Activity{})
+			if err != nil {
+				t.Fatal("Failed to marshal activity " + err.Error())
+			}
+			w.Write(bytes)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteFailedActivity(workflowID, activityID, *expectedActivity.Error.Reason, expectedActivity.Error.Details)
+
+		// assert
+		assert.Equal(t, *expectedActivity.ID, *actualActivity.ID, "Expected activity IDs to match")
+		assert.Equal(t, models.ActivityStatusFailed, *actualActivity.Status, "Expected activity status to be: "+models.ActivityStatusCancelled)
+		assert.NotNil(t, actualActivity.Error, "Expected an activity error")
+		assert.Equal(t, *expectedActivity.Error.Reason, *actualActivity.Error.Reason, "Expected error reason to be passed in")
+		assert.Equal(t, expectedActivity.Error.Details, actualActivity.Error.Details, "Expected error details to be passed in")
+		assert.Nil(t, err, "Expected no error")
+		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteFailedActivity(workflowID, activityID, "", "")
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("Token", nil)
+
+		// return server error from http handler
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteFailedActivity(workflowID, activityID, "", "")
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to API error")
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+	})
+}
+
+func TestCompleteFailedActivityNonBlocking(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+	// newServer keeps the activity sent in the body of the last request
+	newServer := func(received *models.Activity) *httptest.Server {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			var activity models.Activity
+			json.NewDecoder(r.Body).Decode(&activity)
+			*received = activity
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&activity)
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenSuccessfulExpectsNonBlockingFailedActivityInRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var actualActivity models.Activity
+		testServer := newServer(&actualActivity)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteFailedActivityNonBlocking(workflowID, activityID, "some reason", "some failure details")
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.NotNil(t, activity, "Expected retrieved activity to not be nil")
+		assert.Equal(t, models.ActivityStatusFailed, swag.StringValue(actualActivity.Status), "Expected activity status to be: "+models.ActivityStatusFailed)
+		assert.True(t, actualActivity.NonBlocking, "Expected the activity to be sent as non-blocking")
+		if assert.NotNil(t, actualActivity.Error, "Expected an activity error") {
+			assert.Equal(t, "some reason", swag.StringValue(actualActivity.Error.Reason), "Expected error reason to be passed in")
+		}
+	})
+
+	t.Run("WhenCompletedWithCompleteFailedActivityExpectsBlocking", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		actualActivity := models.Activity{NonBlocking: true}
+		testServer := newServer(&actualActivity)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.CompleteFailedActivity(workflowID, activityID, "some reason", "")
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.False(t, actualActivity.NonBlocking, "Expected the activity to not be sent as non-blocking")
+	})
+}
+
+func TestCompleteActivity(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+	// newServer keeps the activity sent in the body of the last request and counts the requests
+	newServer := func(received *models.Activity, requests *int) *httptest.Server {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*requests++
+			var activity models.Activity
+			json.NewDecoder(r.Body).Decode(&activity)
+			*received = activity
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&activity)
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler).Methods("PUT")
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenCompletedWithWarningExpectsResultAndWarningSent", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var actualActivity models.Activity
+		var requests int
+		testServer := newServer(&actualActivity, &requests)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteActivity(workflowID, activityID, ActivityOutcome{
+			Status:  models.ActivityStatusCompleted,
+			Result:  map[string]int{"layers": 10},
+			Error:   models.NewActivityError("Mesh coarsened", "2 layers were merged"),
+			Warning: true,
+		})
+
+		// assert
+		assert.Nil(t, err, "Expected no error completing with a warning")
+		assert.NotNil(t, activity, "Expected the activity to be returned")
+		assert.Equal(t, models.ActivityStatusCompleted, swag.StringValue(actualActivity.Status), "Expected the status of the outcome")
+		assert.Equal(t, `{"layers":10}`, actualActivity.Result, "Expected the result serialized to JSON")
+		assert.EqualValues(t, 100, actualActivity.PercentComplete, "Expected a completed activity to be 100 percent complete")
+		if assert.NotNil(t, actualActivity.Error, "Expected the warning to be sent") {
+			assert.Equal(t, "Mesh coarsened", swag.StringValue(actualActivity.Error.Reason), "Expected the reason of the warning")
+		}
+	})
+
+	t.Run("WhenCancelledWithPartialResultExpectsAllFieldsSent", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var actualActivity models.Activity
+		var requests int
+		testServer := newServer(&actualActivity, &requests)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.CompleteActivity(workflowID, activityID, ActivityOutcome{
+			Status:          models.ActivityStatusCancelled,
+			Result:          "partial",
+			Error:           models.NewActivityError("Cancelled by user", ""),
+			PercentComplete: 40,
+		})
+
+		// assert
+		assert.Nil(t, err, "Expected no error cancelling with a partial result")
+		assert.Equal(t, models.ActivityStatusCancelled, swag.StringValue(actualActivity.Status), "Expected the status of the outcome")
+		assert.Equal(t, `"partial"`, actualActivity.Result, "Expected the partial result serialized to JSON")
+		assert.EqualValues(t, 40, actualActivity.PercentComplete, "Expected the percent complete of the outcome")
+	})
+
+	t.Run("WhenOutcomeNotValidExpectsErrorWithoutRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var actualActivity models.Activity
+		var requests int
+		testServer := newServer(&actualActivity, &requests)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CompleteActivity(workflowID, activityID, ActivityOutcome{Status: models.ActivityStatusFailed})
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity")
+		assert.Equal(t, &ActivityOutcomeError{Status: models.ActivityStatusFailed, Reason: "the error is required"}, err, "Expected the outcome to be rejected")
+		assert.Equal(t, 0, requests, "Expected no request to be sent")
+	})
+}
+
+func TestRetryActivity(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}/retry"
+
+	t.Run("WhenSuccessfulExpectsRescheduledActivityReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method, "Expected the retry to be posted")
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, mux.Vars(r)["activityID"], "Expected activity id received to match what was passed in")
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(models.NewActivity(activityID, models.ActivityStatusRunning))
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.RetryActivity(workflowID, activityID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error retrying activity")
+		if assert.NotNil(t, activity, "Expected the activity to be returned") {
+			assert.Equal(t, models.ActivityStatusRunning, *activity.Status, "Expected the activity to be running again")
+		}
+	})
+
+	t.Run("WhenActivityNotFailedExpectsActivityNotFailedError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			json.NewEncoder(w).Encode(models.NewActivity(activityID, models.ActivityStatusCompleted))
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.RetryActivity(workflowID, activityID)
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned")
+		expectedErr := &ActivityNotFailedError{WorkflowID: workflowID, ActivityID: activityID, Status: models.ActivityStatusCompleted}
+		assert.Equal(t, expectedErr, err, "Expected an *ActivityNotFailedError")
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.RetryActivity(workflowID, activityID)
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity to be returned due to token error")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+}
+
+func TestAppendActivityLogs(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}/logs"
+	lines := []*models.LogLine{
+		{Line: "first line", Time: strfmt.DateTime(time.Date(2017, 1, 2, 3, 4, 5, 0, time.UTC))},
+		{Line: "second line", Time: strfmt.DateTime(time.Date(2017, 1, 2, 3, 4, 6, 0, time.UTC))},
+	}
+
+	t.Run("WhenSuccessfulExpectsLinesSent", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedLines []*models.LogLine
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method, "Expected logs to be posted")
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, mux.Vars(r)["activityID"], "Expected activity id received to match what was passed in")
+			if err := json.NewDecoder(r.Body).Decode(&receivedLines); err != nil {
+				t.Error("Failed to decode log lines")
+			}
+			w.WriteHeader(http.StatusOK)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.AppendActivityLogs(workflowID, activityID, lines)
+
+		// assert
+		assert.Nil(t, err, "Expected no error appending logs")
+		if assert.Len(t, receivedLines, 2, "Expected both lines to be received") {
+			assert.Equal(t, "first line", receivedLines[0].Line, "Expected lines in order")
+			assert.Equal(t, lines[1].Time.String(), receivedLines[1].Time.String(), "Expected line time to be sent")
+		}
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.AppendActivityLogs(workflowID, activityID, lines)
+
+		// assert
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("Token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(404)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.AppendActivityLogs(workflowID, activityID, lines)
+
+		// assert
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 404 error")
+	})
+}
+
+func TestHeartbeatActivity(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	taskToken := "token"
+	heartbeatDetails := "details"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}/heartbeat"
+	heartbeatToReturn := &models.Heartbeat{
+		ActivityID: swag.String(activityID),
+		TaskToken:  swag.String(taskToken),
+		Cancelled:  false,
+		Details:    heartbeatDetails,
+	}
+
+	t.Run("WhenSuccessfulExpectsHeartbeatReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			receivedWorkflowID := mux.Vars(r)["workflowID"]
+			receivedActivityID := mux.Vars(r)["activityID"]
+			assert.EqualValues(t, workflowID, receivedWorkflowID, "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, receivedActivityID, "Expected activity id received to match what was passed in")
+			bytes, err := json.Marshal(heartbeatToReturn)
+			if err != nil {
+				t.Error("Failed to marshal heartbeat")
+			}
+			w.Write(bytes)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		heartbeat, err := client.HeartbeatActivity(workflowID, activityID)
+
+		// assert
+		assert.Nil(t, err, "Expected error to be nil when getting workflow")
+		assert.NotNil(t, heartbeat, "Expected retrieved heartbeat to not be nil")
+		assert.Equal(t, *heartbeatToReturn.ActivityID, *heartbeat.ActivityID, "Expected heartbeat activity IDs to match")
+		assert.Equal(t, *heartbeatToReturn.TaskToken, *heartbeat.TaskToken, "Expected heartbeat tokens to match")
+		assert.Equal(t, heartbeatToReturn.Cancelled, heartbeat.Cancelled, "Expected heartbeat cancelled field to match")
+		assert.Equal(t, heartbeatToReturn.Details, heartbeat.Details, "Expected heartbeat details to match")
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		heartbeat, err := client.HeartbeatActivity(workflowID, activityID)
+
+		// assert
+		assert.Nil(t, heartbeat, "Expected no heartbeat to be returned due to token error")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("Token", nil)
+
+		// return server error from http handler
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		heartbeat, err := client.HeartbeatActivity(workflowID, activityID)
+
+		// assert
+		assert.Nil(t, heartbeat, "Expected no heartbeat to be returned due to API error")
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+	})
+}
+
+func TestTransferWorkflow(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	var currentOrgID int32 = 5
+	var newOrgID int32 = 7
+	workflowEndpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	transferEndpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/transfer"
+	workflowHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(fmt.Sprintf(`{"id":"%v","organizationId":%d}`, workflowID, currentOrgID)))
+	})
+
+	t.Run("WhenTransferAllowedExpectsNewOrganizationIDSent", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedTransfer models.WorkflowTransfer
+		transferHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			json.NewDecoder(r.Body).Decode(&receivedTransfer)
+			w.WriteHeader(http.StatusOK)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(workflowEndpoint, workflowHandler).Methods("GET")
+		r.HandleFunc(transferEndpoint, transferHandler).Methods("POST")
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.TransferWorkflow(workflowID, newOrgID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error transferring the workflow")
+		if assert.NotNil(t, receivedTransfer.OrganizationID, "Expected the organization ID to be sent") {
+			assert.Equal(t, newOrgID, *receivedTransfer.OrganizationID, "Expected the new organization ID to be sent")
+		}
+	})
+
+	t.Run("WhenCallerNotAllowedExpectsTransferForbiddenError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		transferHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte(`{"code":403,"message":"Forbidden"}`))
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(workflowEndpoint, workflowHandler).Methods("GET")
+		r.HandleFunc(transferEndpoint, transferHandler).Methods("POST")
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.TransferWorkflow(workflowID, newOrgID)
+
+		// assert
+		assert.Equal(t, &TransferForbiddenError{WorkflowID: workflowID, OrganizationID: newOrgID}, err, "Expected a *TransferForbiddenError")
+	})
+
+	t.Run("WhenWorkflowAlreadyInOrganizationExpectsSameOrganizationErrorWithoutTransfer", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		transferred := false
+		transferHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			transferred = true
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(workflowEndpoint, workflowHandler).Methods("GET")
+		r.HandleFunc(transferEndpoint, transferHandler).Methods("POST")
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.TransferWorkflow(workflowID, currentOrgID)
+
+		// assert
+		assert.Equal(t, &SameOrganizationError{WorkflowID: workflowID, OrganizationID: currentOrgID}, err, "Expected a *SameOrganizationError")
+		assert.False(t, transferred, "Expected no transfer request")
+	})
+
+	t.Run("WhenOrganizationIDNotPositiveExpectsOrganizationIDErrorWithoutRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.TransferWorkflow(workflowID, 0)
+
+		// assert
+		assert.Equal(t, &OrganizationIDError{OrganizationID: 0}, err, "Expected an *OrganizationIDError")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no request to be made")
+	})
+}
+
+func TestUpdateWorkflowMetadata(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	startEndpoint := "/" + workflowAPIBasePath + "/workflows"
+	workflowEndpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	metadataEndpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/metadata"
+	// newServer keeps the description and metadata of the workflow it starts and returns them with the workflow
+	newServer := func() *httptest.Server {
+		var mu sync.Mutex
+		stored := models.WorkflowMetadata{}
+		r := mux.NewRouter()
+		r.HandleFunc(startEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			var post models.PostWorkflow
+			json.NewDecoder(r.Body).Decode(&post)
+			mu.Lock()
+			stored = models.WorkflowMetadata{Description: post.Description, Metadata: post.Metadata}
+			mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(workflowID)
+		}).Methods("POST")
+		r.HandleFunc(metadataEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			mu.Lock()
+			stored = models.WorkflowMetadata{}
+			json.NewDecoder(r.Body).Decode(&stored)
+			mu.Unlock()
+		}).Methods("PUT")
+		r.HandleFunc(workflowEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&models.Workflow{ID: workflowID, Description: stored.Description, Metadata: stored.Metadata})
+		}).Methods("GET")
+		return httptest.NewServer(r)
+	}
+	post := models.NewPostWorkflow(models.PostWorkflowWorkflowTypeAssumedStrain, 7, 10,
+		models.WithDescription("Bracket, first iteration"), models.WithMetadata(map[string]string{"project": "bracket"}))
+
+	t.Run("WhenStartedWithMetadataExpectsMetadataReturnedWithWorkflow", func(t *testing.T) {
+		// arrange
+		testServer := newServer()
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.StartWorkflow(post)
+		workflow, getErr := client.GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error starting the workflow")
+		if assert.Nil(t, getErr, "Expected no error getting the workflow") {
+			assert.Equal(t, "Bracket, first iteration", workflow.Description, "Expected the description given at start")
+			assert.Equal(t, map[string]string{"project": "bracket"}, workflow.Metadata, "Expected the metadata given at start")
+		}
+	})
+
+	t.Run("WhenUpdatedExpectsNewMetadataReturnedWithWorkflow", func(t *testing.T) {
+		// arrange
+		testServer := newServer()
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		client.StartWorkflow(post)
+
+		// act
+		err := client.UpdateWorkflowMetadata(workflowID, "Bracket, final", map[string]string{"project": "bracket", "stage": "final"})
+		workflow, getErr := client.GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error updating the metadata")
+		if assert.Nil(t, getErr, "Expected no error getting the workflow") {
+			assert.Equal(t, "Bracket, final", workflow.Description, "Expected the updated description")
+			assert.Equal(t, map[string]string{"project": "bracket", "stage": "final"}, workflow.Metadata, "Expected the updated metadata")
+		}
+	})
+
+	t.Run("WhenAuthTokenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.UpdateWorkflowMetadata(workflowID, "Bracket", nil)
+
+		// assert
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+}
+
+func TestGetActivityLogs(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}/logs"
+	start := time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	t.Run("WhenTailingExpectsOnlyNewLinesOnSecondFetch", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var mu sync.Mutex
+		lines := []*models.LogLine{
+			{Line: "meshing", Time: strfmt.DateTime(start)},
+			{Line: "solving", Time: strfmt.DateTime(start.Add(time.Second))},
+		}
+		var receivedSince []string
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, mux.Vars(r)["activityID"], "Expected activity id received to match what was passed in")
+			mu.Lock()
+			defer mu.Unlock()
+			since := r.URL.Query().Get("since")
+			receivedSince = append(receivedSince, since)
+			var sinceTime strfmt.DateTime
+			if since != "" {
+				var err error
+				sinceTime, err = strfmt.ParseDateTime(since)
+				assert.Nil(t, err, "Expected since to be a date-time")
+			}
+			var newLines []*models.LogLine
+			for _, line := range lines {
+				if time.Time(line.Time).After(time.Time(sinceTime)) {
+					newLines = append(newLines, line)
+				}
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(newLines)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler).Methods("GET")
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		firstLines, firstErr := client.GetActivityLogs(workflowID, activityID, time.Time{})
+		mu.Lock()
+		lines = append(lines, &models.LogLine{Line: "writing results", Time: strfmt.DateTime(start.Add(2 * time.Second))})
+		mu.Unlock()
+		last := time.Time(firstLines[len(firstLines)-1].Time)
+		secondLines, secondErr := client.GetActivityLogs(workflowID, activityID, last)
+
+		// assert
+		assert.Nil(t, firstErr, "Expected no error on the first fetch")
+		assert.Nil(t, secondErr, "Expected no error on the second fetch")
+		if assert.Len(t, firstLines, 2, "Expected every line on the first fetch") {
+			assert.Equal(t, "meshing", firstLines[0].Line, "Expected the lines oldest first")
+		}
+		if assert.Len(t, secondLines, 1, "Expected only the new line on the second fetch") {
+			assert.Equal(t, "writing results", secondLines[0].Line, "Expected the line written after the first fetch")
+		}
+		assert.Equal(t, []string{"", strfmt.DateTime(last).String()}, receivedSince, "Expected since to be sent only when set")
+	})
+
+	t.Run("WhenActivityHasNoLogsExpectsNoLines", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`[]`))
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		lines, err := client.GetActivityLogs(workflowID, activityID, time.Time{})
+
+		// assert
+		assert.Nil(t, err, "Expected no error for an activity without output")
+		assert.Empty(t, lines, "Expected no lines")
+	})
+
+	t.Run("WhenActivityUnknownExpectsActivityNotFoundError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"Activity not found"}`))
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		lines, err := client.GetActivityLogs(workflowID, activityID, time.Time{})
+
+		// assert
+		assert.Nil(t, lines, "Expected no lines to be returned")
+		assert.Equal(t, &ActivityNotFoundError{WorkflowID: workflowID, ActivityID: activityID}, err, "Expected an *ActivityNotFoundError")
+	})
+}
+
+func TestLookupByTaskToken(t *testing.T) {
+	// arrange
+	taskToken := "AAAAKgAAAAIAAAAAAAAAAg"
+	endpoint := "/" + workflowAPIBasePath + "/taskTokens/lookup"
+
+	t.Run("WhenTaskTokenKnownExpectsWorkflowAndActivityIDs", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var received models.TaskToken
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&received)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"workflowId":"my-workflow","activityId":"my-activity"}`))
+		}).Methods("POST")
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflowID, activityID, err := client.LookupByTaskToken(taskToken)
+
+		// assert
+		assert.Nil(t, err, "Expected no error looking up the task token")
+		assert.Equal(t, taskToken, swag.StringValue(received.TaskToken), "Expected the task token to be sent")
+		assert.Equal(t, "my-workflow", workflowID, "Expected the workflow ID sent by the server")
+		assert.Equal(t, "my-activity", activityID, "Expected the activity ID sent by the server")
+	})
+
+	t.Run("WhenTaskTokenUnknownExpectsUnknownTaskTokenError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"Task token expired"}`))
+		})
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflowID, activityID, err := client.LookupByTaskToken(taskToken)
+
+		// assert
+		assert.Empty(t, workflowID, "Expected no workflow ID")
+		assert.Empty(t, activityID, "Expected no activity ID")
+		assert.Equal(t, &UnknownTaskTokenError{TaskToken: taskToken}, err, "Expected an *UnknownTaskTokenError")
+	})
+}
+
+func TestGetActivity(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+
+	t.Run("WhenSuccessfulExpectsActivityReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, mux.Vars(r)["activityID"], "Expected activity id received to match what was passed in")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"my-activity","status":"Running","percentComplete":40}`))
+		}).Methods("GET")
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.GetActivity(workflowID, activityID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error getting the activity")
+		if assert.NotNil(t, activity, "Expected the activity to be returned") {
+			assert.Equal(t, activityID, swag.StringValue(activity.ID), "Expected the activity of the response")
+			assert.EqualValues(t, 40, activity.PercentComplete, "Expected the percent complete of the response")
+		}
+	})
+
+	t.Run("WhenActivityUnknownExpectsActivityNotFoundError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"Activity not found"}`))
+		})
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.GetActivity(workflowID, activityID)
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity")
+		assert.Equal(t, &ActivityNotFoundError{WorkflowID: workflowID, ActivityID: activityID}, err, "Expected an *ActivityNotFoundError")
+	})
+}
+
+func TestIsCancellationRequested(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}/cancellation"
+
+	t.Run("WhenCancelRequestedExpectsTrueWithoutHeartbeat", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		heartbeats := 0
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, mux.Vars(r)["activityID"], "Expected activity id received to match what was passed in")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"cancelRequested":true}`))
+		}).Methods("GET")
+		r.PathPrefix("/").HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			heartbeats++
+		})
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		cancelRequested, err := client.IsCancellationRequested(workflowID, activityID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error checking the cancellation")
+		assert.True(t, cancelRequested, "Expected the cancellation to be requested")
+		assert.Equal(t, 0, heartbeats, "Expected no other request, e.g. a heartbeat")
+	})
+
+	t.Run("WhenActivityUnknownExpectsActivityNotFoundError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code":404,"message":"Activity not found"}`))
+		})
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		cancelRequested, err := client.IsCancellationRequested(workflowID, activityID)
+
+		// assert
+		assert.False(t, cancelRequested, "Expected no cancellation")
+		assert.Equal(t, &ActivityNotFoundError{WorkflowID: workflowID, ActivityID: activityID}, err, "Expected an *ActivityNotFoundError")
+	})
+}
+
+func TestGetActivityTaskToken(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}/taskToken"
+
+	t.Run("WhenActivityRunningExpectsTaskTokenReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			assert.EqualValues(t, activityID, mux.Vars(r)["activityID"], "Expected activity id received to match what was passed in")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"taskToken":"AAAAKgAAAAIAAAAAAAAAAg"}`))
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler).Methods("GET")
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		taskToken, err := client.GetActivityTaskToken(workflowID, activityID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error getting the task token")
+		assert.Equal(t, "AAAAKgAAAAIAAAAAAAAAAg", taskToken, "Expected the task token sent by the server")
+	})
+
+	t.Run("WhenActivityNotRunningExpectsNoActiveTaskTokenError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"code":409,"message":"Activity is completed"}`))
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		taskToken, err := client.GetActivityTaskToken(workflowID, activityID)
+
+		// assert
+		assert.Empty(t, taskToken, "Expected no task token to be returned")
+		assert.Equal(t, &NoActiveTaskTokenError{WorkflowID: workflowID, ActivityID: activityID}, err, "Expected a *NoActiveTaskTokenError")
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		taskToken, err := client.GetActivityTaskToken(workflowID, activityID)
+
+		// assert
+		assert.Empty(t, taskToken, "Expected no task token to be returned")
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+	})
+}
+
+func TestAbandonActivity(t *testing.T) {
+	// arrange
+	activityID := "my-activity"
+	taskToken := "token"
+	endpoint := "/" + workflowAPIBasePath + "/heartbeats"
+
+	t.Run("WhenSuccessfulExpectsAbandonedHeartbeatSent", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedHeartbeat models.Heartbeat
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&receivedHeartbeat); err != nil {
+				t.Fatal(err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&receivedHeartbeat)
+		}).Methods("PUT")
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.AbandonActivity(taskToken, activityID, "shutting down")
+
+		// assert
+		assert.Nil(t, err, "Expected no error abandoning the activity")
+		assert.True(t, receivedHeartbeat.Abandoned, "Expected the heartbeat to abandon the activity")
+		assert.Equal(t, taskToken, swag.StringValue(receivedHeartbeat.TaskToken), "Expected the task token of the activity")
+		assert.Equal(t, "shutting down", receivedHeartbeat.Details, "Expected the details to be sent")
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.AbandonActivity(taskToken, activityID, "")
+
+		// assert
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+}
+
+func TestExtendHeartbeatTimeout(t *testing.T) {
+	// arrange
+	activityID := "my-activity"
+	taskToken := "token"
+	endpoint := "/" + workflowAPIBasePath + "/heartbeats"
+
+	t.Run("WhenSuccessfulExpectsExtensionSentInSeconds", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedHeartbeat models.Heartbeat
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&receivedHeartbeat); err != nil {
+				t.Fatal(err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&receivedHeartbeat)
+		}).Methods("PUT")
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		heartbeat, err := client.ExtendHeartbeatTimeout(taskToken, activityID, 90*time.Second+time.Millisecond)
+
+		// assert
+		assert.Nil(t, err, "Expected no error extending the heartbeat timeout")
+		assert.EqualValues(t, 91, receivedHeartbeat.ExtendTimeoutSeconds, "Expected the extension rounded up to whole seconds")
+		assert.Equal(t, taskToken, swag.StringValue(receivedHeartbeat.TaskToken), "Expected the task token of the activity")
+		assert.Equal(t, activityID, swag.StringValue(receivedHeartbeat.ActivityID), "Expected the ID of the activity")
+		assert.NotNil(t, heartbeat, "Expected the heartbeat to be returned")
+	})
+
+	t.Run("WhenExtensionNotPositiveExpectsErrorWithoutRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		heartbeat, err := client.ExtendHeartbeatTimeout(taskToken, activityID, 0)
+
+		// assert
+		assert.Nil(t, heartbeat, "Expected no heartbeat")
+		assert.Equal(t, &HeartbeatExtensionError{Extension: 0}, err, "Expected the extension to be rejected")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no token to be fetched")
+	})
+}
+
+func TestHeartbeatActivityWithToken(t *testing.T) {
+	// arrange
+	activityID := "my-activity"
+	taskToken := "token"
+	heartbeatDetails := "details"
+	endpoint := "/" + workflowAPIBasePath + "/heartbeats"
+	heartbeatToReturn := &models.Heartbeat{
+		ActivityID: swag.String(activityID),
+		TaskToken:  swag.String(taskToken),
+		Cancelled:  false,
+		Details:    heartbeatDetails,
+	}
+
+	t.Run("WhenSuccessfulExpectsHeartbeatReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			receivedBytes, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				assert.Fail(t, "Message did not include heartbeat")
+			}
+			receivedHeartbeat := &models.Heartbeat{}
+			if err := json.Unmarshal(receivedBytes, receivedHeartbeat); err != nil {
+				assert.Fail(t, "Unable to unmarshal heartbeat")
+			}
+			assert.EqualValues(t, heartbeatToReturn, receivedHeartbeat, "Expected received heartbeat to match what was passed in")
+			w.Write(receivedBytes)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		heartbeat, err := client.HeartbeatActivityWithToken(taskToken, activityID, heartbeatDetails)
+
+		// assert
+		assert.Nil(t, err, "Expected error to be nil when getting workflow")
+		assert.NotNil(t, heartbeat, "Expected retrieved heartbeat to not be nil")
+		assert.Equal(t, *heartbeatToReturn.ActivityID, *heartbeat.ActivityID, "Expected heartbeat activity IDs to match")
+		assert.Equal(t, *heartbeatToReturn.TaskToken, *heartbeat.TaskToken, "Expected heartbeat tokens to match")
+		assert.Equal(t, heartbeatToReturn.Cancelled, heartbeat.Cancelled, "Expected heartbeat cancelled field to match")
+		assert.Equal(t, heartbeatToReturn.Details, heartbeat.Details, "Expected heartbeat details to match")
+	})
+
+	t.Run("WhenAuthTokenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		heartbeat, err := client.HeartbeatActivityWithToken(taskToken, activityID, heartbeatDetails)
+
+		// assert
+		assert.Nil(t, heartbeat, "Expected no heartbeat to be returned due to token error")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("Token", nil)
+
+		// return server error from http handler
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		heartbeat, err := client.HeartbeatActivityWithToken(taskToken, activityID, heartbeatDetails)
+
+		// assert
+		assert.Nil(t, heartbeat, "Expected no heartbeat to be returned due to API error")
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+	})
+}
+
+func TestHeartbeatActivities(t *testing.T) {
+	// arrange
+	endpoint := "/" + workflowAPIBasePath + "/heartbeats"
+	taskTokens := map[string]string{
+		"activity-1": "token-1",
+		"activity-2": "token-2",
+		"activity-3": "token-3",
+	}
+
+	t.Run("WhenOneActivityCancelledExpectsHeartbeatOfEveryActivityReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedHeartbeat := &models.Heartbeat{}
+			if err := json.NewDecoder(r.Body).Decode(receivedHeartbeat); err != nil {
+				assert.Fail(t, "Unable to unmarshal heartbeat")
+			}
+			assert.Equal(t, taskTokens[*receivedHeartbeat.ActivityID], *receivedHeartbeat.TaskToken, "Expected the task token of the activity")
+			receivedHeartbeat.Cancelled = *receivedHeartbeat.ActivityID == "activity-2"
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(receivedHeartbeat)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		heartbeats, err := client.HeartbeatActivities(taskTokens)
+
+		// assert
+		assert.Nil(t, err, "Expected no error heartbeating activities")
+		if assert.Len(t, heartbeats, 3, "Expected a heartbeat for every activity") {
+			assert.False(t, heartbeats["activity-1"].Cancelled, "Expected activity-1 to not be cancelled")
+			assert.True(t, heartbeats["activity-2"].Cancelled, "Expected activity-2 to be cancelled")
+			assert.False(t, heartbeats["activity-3"].Cancelled, "Expected activity-3 to not be cancelled")
+		}
+	})
+
+	t.Run("WhenSomeHeartbeatsFailExpectsOthersReturnedWithMultiError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedHeartbeat := &models.Heartbeat{}
+			if err := json.NewDecoder(r.Body).Decode(receivedHeartbeat); err != nil {
+				assert.Fail(t, "Unable to unmarshal heartbeat")
+			}
+			if *receivedHeartbeat.ActivityID == "activity-3" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(receivedHeartbeat)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		heartbeats, err := client.HeartbeatActivities(taskTokens)
+
+		// assert
+		assert.Len(t, heartbeats, 2, "Expected the heartbeats that succeeded to be returned")
+		multiError, ok := err.(*MultiError)
+		if assert.True(t, ok, "Expected a *MultiError") && assert.Len(t, multiError.Errors, 1, "Expected one error") {
+			activityError, ok := multiError.Errors[0].(*ActivityError)
+			if assert.True(t, ok, "Expected an *ActivityError") {
+				assert.Equal(t, "activity-3", activityError.ActivityID, "Expected the error of activity-3")
+			}
+		}
+	})
+
+	t.Run("WhenNoActivitiesExpectsNoRequests", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		heartbeats, err := client.HeartbeatActivities(nil)
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.Empty(t, heartbeats, "Expected no heartbeats")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no token to be fetched")
+	})
+}
+
+func TestStartWorkflowIfNotExists(t *testing.T) {
+	// arrange
+	endpoint := "/" + workflowAPIBasePath + "/workflows"
+	workflow := models.NewPostWorkflow(models.PostWorkflowWorkflowTypeAssumedStrain, 5, 7)
+	// newServer answers the listing of the workflows with list and starts workflow-new, keeping the query of the listing
+	// and counting the workflows started
+	newServer := func(list string, query *url.Values, starts *int) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			*query = r.URL.Query()
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(list))
+		}).Methods("GET")
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			*starts++
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`"workflow-new"`))
+		}).Methods("POST")
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenRunningWorkflowExistsExpectsOldestReturnedWithoutStarting", func(t *testing.T) {
+		// arrange
+		var query url.Values
+		var starts int
+		testServer := newServer(`{"workflows":[{"id":"workflow-2","state":"Running","createdAt":"2018-03-02T00:00:00.000Z"},{"id":"workflow-1","state":"Running","createdAt":"2018-03-01T00:00:00.000Z"}]}`, &query, &starts)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflowID, created, err := client.StartWorkflowIfNotExists(workflow)
+
+		// assert
+		assert.Nil(t, err, "Expected no error when a workflow is running")
+		assert.Equal(t, "workflow-1", workflowID, "Expected the oldest running workflow to be returned")
+		assert.False(t, created, "Expected no workflow to be created")
+		assert.Equal(t, 0, starts, "Expected no workflow to be started")
+		assert.Equal(t, "5", query.Get("entityId"), "Expected the entity of the workflow to be looked for")
+		assert.Equal(t, "7", query.Get("organizationId"), "Expected the organization of the workflow to be looked for")
+		assert.Equal(t, models.WorkflowStateRunning, query.Get("state"), "Expected running workflows to be looked for")
+	})
+
+	t.Run("WhenNoRunningWorkflowExpectsWorkflowStarted", func(t *testing.T) {
+		// arrange
+		var query url.Values
+		var starts int
+		testServer := newServer(`{"workflows":[]}`, &query, &starts)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflowID, created, err := client.StartWorkflowIfNotExists(workflow)
+
+		// assert
+		assert.Nil(t, err, "Expected no error starting the workflow")
+		assert.Equal(t, "workflow-new", workflowID, "Expected the ID of the started workflow")
+		assert.True(t, created, "Expected the workflow to be created")
+		assert.Equal(t, 1, starts, "Expected the workflow to be started once")
+	})
+
+	t.Run("WhenListingFailsExpectsErrorWithoutStarting", func(t *testing.T) {
+		// arrange
+		var starts int
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == "POST" {
+				starts++
+			}
+			w.WriteHeader(http.StatusInternalServerError)
+		})
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflowID, created, err := client.StartWorkflowIfNotExists(workflow)
+
+		// assert
+		assert.NotNil(t, err, "Expected the error listing the workflows")
+		assert.Empty(t, workflowID, "Expected no workflow ID")
+		assert.False(t, created, "Expected no workflow to be created")
+		assert.Equal(t, 0, starts, "Expected no workflow to be started")
+	})
+}
+
+func TestCloneWorkflow(t *testing.T) {
+	// arrange
+	sourceWorkflowID := "source-workflow"
+	newWorkflowID := "new-workflow"
+	parametersEndpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/parameters"
+	startEndpoint := "/" + workflowAPIBasePath + "/workflows"
+	source := models.NewPostWorkflow(models.PostWorkflowWorkflowTypePart, 5, 7, models.WithDistortionCompensation(), models.WithPriority(models.PostWorkflowPriorityLow))
+	newServer := func(started *models.PostWorkflow) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(parametersEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			assert.EqualValues(t, sourceWorkflowID, mux.Vars(r)["workflowID"], "Expected the parameters of the source workflow to be fetched")
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(source)
+		}).Methods("GET")
+		r.HandleFunc(startEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(started)
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(newWorkflowID)
+		}).Methods("POST")
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenOverridesGivenExpectsMergedWorkflowStarted", func(t *testing.T) {
+		// arrange
+		var started models.PostWorkflow
+		testServer := newServer(&started)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		overrides := &models.PostWorkflow{RunSupportOptimization: true, Priority: models.PostWorkflowPriorityHigh}
+
+		// act
+		workflowID, err := client.CloneWorkflow(sourceWorkflowID, overrides)
+
+		// assert
+		assert.Nil(t, err, "Expected no error cloning the workflow")
+		assert.Equal(t, newWorkflowID, workflowID, "Expected the ID of the new workflow")
+		assert.Equal(t, models.PostWorkflowWorkflowTypePart, swag.StringValue(started.WorkflowType), "Expected the workflow type of the source")
+		assert.Equal(t, int32(5), swag.Int32Value(started.EntityID), "Expected the entity of the source")
+		assert.Equal(t, int32(7), swag.Int32Value(started.OrganizationID), "Expected the organization of the source")
+		assert.True(t, started.RunDistortionCompensation, "Expected the flags of the source to be kept")
+		assert.True(t, started.RunSupportOptimization, "Expected the overridden flag to be set")
+		assert.Equal(t, models.PostWorkflowPriorityHigh, started.Priority, "Expected the overridden priority")
+	})
+
+	t.Run("WhenNoOverridesExpectsSourceParametersStarted", func(t *testing.T) {
+		// arrange
+		var started models.PostWorkflow
+		testServer := newServer(&started)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflowID, err := client.CloneWorkflow(sourceWorkflowID, nil)
+
+		// assert
+		assert.Nil(t, err, "Expected no error cloning the workflow")
+		assert.Equal(t, newWorkflowID, workflowID, "Expected the ID of the new workflow")
+		assert.EqualValues(t, source, &started, "Expected the parameters of the source workflow")
+	})
+
+	t.Run("WhenSourceNotFoundExpectsErrorWithoutStart", func(t *testing.T) {
+		// arrange
+		started := false
+		r := mux.NewRouter()
+		r.HandleFunc(parametersEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		})
+		r.HandleFunc(startEndpoint, func(w http.ResponseWriter, r *http.Request) {
+			started = true
+		})
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflowID, err := client.CloneWorkflow(sourceWorkflowID, nil)
+
+		// assert
+		assert.Empty(t, workflowID, "Expected no workflow ID to be returned")
+		assert.NotNil(t, err, "Expected the error getting the source workflow")
+		assert.False(t, started, "Expected no workflow to be started")
+	})
+}
+
+func TestStartWorkflow(t *testing.T) {
+	// arrange
+	entityID := int32(200)
+	orgID := int32(10)
+	workflowType := models.PostWorkflowWorkflowTypeAssumedStrain
+	workflowID := "sim-200"
+	endpoint := "/" + workflowAPIBasePath + "/workflows"
+	post := &models.PostWorkflow{
+		EntityID:                  swag.Int32(entityID),
+		OrganizationID:            swag.Int32(orgID),
+		WorkflowType:              swag.String(workflowType),
+		RunDistortionCompensation: false,
+		RunSupportOptimization:    false,
+	}
+
+	t.Run("WhenSuccessfulExpectsWorkflowIDReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+			bodyBytes, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				assert.Fail(t, "Message body did not include workflow")
+			}
+			receivedWorkflow := &models.PostWorkflow{}
+			if err := json.Unmarshal(bodyBytes, receivedWorkflow); err != nil {
+				assert.Fail(t, "Unable to unmarshal workflow")
+			}
+			assert.EqualValues(t, post, receivedWorkflow, "Expected recieved workflow to match what was passed in")
+			workflowIDBytes, err := json.Marshal(workflowID)
+			if err != nil {
+				assert.Fail(t, "Failed to marshal workflow ID")
+			}
+			w.Write(workflowIDBytes)
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		returnedWorkflowID, err := client.StartWorkflow(post)
+
+		// assert
+		assert.Nil(t, err, "Expected error to be nil when getting workflow")
+		assert.Equal(t, workflowID, returnedWorkflowID, "Expected returned workflow ID to match response value")
+	})
+
+	t.Run("WhenExternalIDSetExpectsExternalIDSent", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedWorkflow models.PostWorkflow
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(&receivedWorkflow); err != nil {
+				t.Fatal(err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(workflowID)
+		})
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.StartWorkflow(models.NewPostWorkflow(workflowType, entityID, orgID, models.WithExternalID("job-42")))
+
+		// assert
+		assert.Nil(t, err, "Expected no error starting the workflow")
+		assert.Equal(t, "job-42", receivedWorkflow.ExternalID, "Expected the external ID to be sent")
+	})
+
+	t.Run("WhenAuthTokenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflowID, err := client.StartWorkflow(post)
+
+		// assert
+		assert.Empty(t, workflowID, "Expected no workflow ID to be returned due to token error")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("Token", nil)
+
+		// return server error from http handler
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflowID, err := client.StartWorkflow(post)
+
+		// assert
+		assert.Empty(t, workflowID, "Expected no workflow ID to be returned due to api error")
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+	})
+
+	t.Run("WhenPrioritySetExpectsPrioritySentInBody", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedPriority string
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedWorkflow := &models.PostWorkflow{}
+			if err := json.NewDecoder(r.Body).Decode(receivedWorkflow); err != nil {
+				assert.Fail(t, "Unable to unmarshal workflow")
+			}
+			receivedPriority = receivedWorkflow.Priority
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`"` + workflowID + `"`))
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		prioritized := models.NewPostWorkflow(workflowType, entityID, orgID)
+		prioritized.Priority = models.PostWorkflowPriorityHigh
+
+		// act
+		_, err := client.StartWorkflow(prioritized)
+
+		// assert
+		assert.Nil(t, err, "Expected no error starting workflow")
+		assert.Equal(t, models.PostWorkflowPriorityHigh, receivedPriority, "Expected the priority to reach the server")
+	})
+
+	t.Run("WhenPriorityInvalidExpectsPriorityErrorWithoutRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+		prioritized := models.NewPostWorkflow(workflowType, entityID, orgID)
+		prioritized.Priority = "Urgent"
+
+		// act
+		returnedWorkflowID, err := client.StartWorkflow(prioritized)
+
+		// assert
+		assert.Empty(t, returnedWorkflowID, "Expected no workflow ID to be returned")
+		assert.Equal(t, &PriorityError{Priority: "Urgent"}, err, "Expected a *PriorityError")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no request to be made")
+	})
+
+	t.Run("WhenWorkflowTypeMisspelledExpectsWorkflowTypeErrorWithoutRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+		misspelled := models.NewPostWorkflow("AssumedStrian", entityID, orgID)
+
+		// act
+		returnedWorkflowID, err := client.StartWorkflow(misspelled)
+
+		// assert
+		assert.Empty(t, returnedWorkflowID, "Expected no workflow ID to be returned")
+		assert.Equal(t, &models.WorkflowTypeError{WorkflowType: "AssumedStrian", Suggestion: models.WorkflowTypeAssumedStrain}, err, "Expected a *models.WorkflowTypeError")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no request to be made")
+	})
+
+	t.Run("WhenTimeoutSetExpectsTimeoutSecondsSentInBody", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedTimeoutSeconds int64
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedWorkflow := &models.PostWorkflow{}
+			if err := json.NewDecoder(r.Body).Decode(receivedWorkflow); err != nil {
+				assert.Fail(t, "Unable to unmarshal workflow")
+			}
+			receivedTimeoutSeconds = receivedWorkflow.TimeoutSeconds
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`"` + workflowID + `"`))
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.StartWorkflow(models.NewPostWorkflow(workflowType, entityID, orgID, models.WithTimeout(2*time.Hour)))
+
+		// assert
+		assert.Nil(t, err, "Expected no error starting workflow")
+		assert.EqualValues(t, 7200, receivedTimeoutSeconds, "Expected the timeout to reach the server")
+	})
+
+	t.Run("WhenTimeoutNegativeExpectsWorkflowTimeoutErrorWithoutRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+		timedOut := models.NewPostWorkflow(workflowType, entityID, orgID)
+		timedOut.TimeoutSeconds = -5
+
+		// act
+		returnedWorkflowID, err := client.StartWorkflow(timedOut)
+
+		// assert
+		assert.Empty(t, returnedWorkflowID, "Expected no workflow ID to be returned")
+		assert.Equal(t, &WorkflowTimeoutError{TimeoutSeconds: -5}, err, "Expected a *WorkflowTimeoutError")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no request to be made")
+	})
+
+	t.Run("WhenRetryPolicySetExpectsPolicyInRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedWorkflow models.PostWorkflow
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&receivedWorkflow)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`"` + workflowID + `"`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		policy := &models.RetryPolicy{MaxAttempts: 3, InitialIntervalSeconds: 10, BackoffCoefficient: 2, MaxIntervalSeconds: 60}
+
+		// act
+		_, err := client.StartWorkflow(models.NewPostWorkflow(workflowType, entityID, orgID, models.WithRetryPolicy(policy)))
+
+		// assert
+		assert.Nil(t, err, "Expected no error starting workflow")
+		assert.Equal(t, policy, receivedWorkflow.RetryPolicy, "Expected the retry policy to reach the server")
+	})
+
+	t.Run("WhenStartAtSetExpectsScheduledStartInRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedWorkflow models.PostWorkflow
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&receivedWorkflow)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`"` + workflowID + `"`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		startAt := time.Now().Add(8 * time.Hour).UTC().Truncate(time.Millisecond)
+
+		// act
+		_, err := client.StartWorkflow(models.NewPostWorkflow(workflowType, entityID, orgID, models.WithStartAt(startAt)))
+
+		// assert
+		assert.Nil(t, err, "Expected no error starting workflow")
+		if assert.NotNil(t, receivedWorkflow.StartAt, "Expected the scheduled start to reach the server") {
+			assert.True(t, startAt.Equal(time.Time(*receivedWorkflow.StartAt)), "Expected %v but got %v", startAt, receivedWorkflow.StartAt)
+		}
+	})
+
+	t.Run("WhenStartAtInPastExpectsStartTimeErrorWithoutRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+		startAt := time.Now().Add(-time.Minute)
+
+		// act
+		_, err := client.StartWorkflow(models.NewPostWorkflow(workflowType, entityID, orgID, models.WithStartAt(startAt)))
+
+		// assert
+		assert.Equal(t, &StartTimeError{StartAt: startAt}, err, "Expected a *StartTimeError")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no request to be made")
+	})
+
+	t.Run("WhenRetryPolicyOutOfRangeExpectsRetryPolicyErrorWithoutRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+		policies := map[string]*models.RetryPolicy{
+			"maxAttempts":            {MaxAttempts: -1},
+			"backoffCoefficient":     {MaxAttempts: 3, BackoffCoefficient: 0.5},
+			"initialIntervalSeconds": {InitialIntervalSeconds: -10},
+			"maxIntervalSeconds":     {InitialIntervalSeconds: 10, MaxIntervalSeconds: 5},
+		}
+
+		for field, policy := range policies {
+			// act
+			_, err := client.StartWorkflow(models.NewPostWorkflow(workflowType, entityID, orgID, models.WithRetryPolicy(policy)))
+
+			// assert
+			if assert.IsType(t, &RetryPolicyError{}, err, "Expected a *RetryPolicyError for "+field) {
+				assert.Equal(t, field, err.(*RetryPolicyError).Field, "Expected the field out of range")
+			}
+		}
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no request to be made")
+	})
+
+	t.Run("WhenOverQuotaExpectsQuotaExceededErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"limit":10,"usage":10,"resetTime":"2017-06-01T14:00:00.000Z","message":"Concurrent workflow limit reached"}`))
+		})
+
+		// Setup routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		returnedWorkflowID, err := client.StartWorkflow(post)
+
+		// assert
+		assert.Empty(t, returnedWorkflowID, "Expected no workflow ID to be returned")
+		quotaErr, ok := err.(*QuotaExceededError)
+		if assert.True(t, ok, "Expected a *QuotaExceededError but got %v", err) {
+			assert.Equal(t, int32(10), quotaErr.Limit, "Expected the limit from the response")
+			assert.Equal(t, int32(10), quotaErr.Usage, "Expected the usage from the response")
+			assert.True(t, time.Date(2017, 6, 1, 14, 0, 0, 0, time.UTC).Equal(quotaErr.ResetTime), "Expected the reset time from the response")
+			assert.Equal(t, "Concurrent workflow limit reached", quotaErr.Message, "Expected the message from the response")
+			assert.Contains(t, quotaErr.Error(), "10/10", "Expected the error message to show the usage")
+		}
+	})
+
+	t.Run("WhenNoQueueAndOrganizationAtCapacityExpectsCapacityUnavailableError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedWorkflow models.PostWorkflow
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&receivedWorkflow)
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusConflict)
+			w.Write([]byte(`{"message":"No capacity available"}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		returnedWorkflowID, err := client.StartWorkflowNoQueue(post)
+
+		// assert
+		assert.Empty(t, returnedWorkflowID, "Expected no workflow ID to be returned")
+		assert.True(t, receivedWorkflow.NoQueue, "Expected the workflow API to be asked not to queue the workflow")
+		assert.False(t, post.NoQueue, "Expected the workflow passed in to not be changed")
+		assert.Equal(t, &CapacityUnavailableError{OrganizationID: orgID, Message: "No capacity available"}, err, "Expected a capacity unavailable error")
+	})
+}
+
+func TestValidateWorkflow(t *testing.T) {
+	// arrange
+	endpoint := "/" + workflowAPIBasePath + "/workflows/validate"
+	post := models.NewPostWorkflow(models.PostWorkflowWorkflowTypePart, 200, 10)
+
+	t.Run("WhenValidExpectsNilAndWorkflowSent", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedWorkflow *models.PostWorkflow
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedWorkflow = &models.PostWorkflow{}
+			if err := json.NewDecoder(r.Body).Decode(receivedWorkflow); err != nil {
+				assert.Fail(t, "Unable to unmarshal workflow")
+			}
+			w.WriteHeader(http.StatusNoContent)
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler).Methods("POST")
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.ValidateWorkflow(post)
+
+		// assert
+		assert.Nil(t, err, "Expected no error for a valid workflow")
+		assert.Equal(t, post, receivedWorkflow, "Expected the workflow to be sent")
+	})
+
+	t.Run("WhenNotValidExpectsWorkflowValidationErrorListingFields", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"errors":[{"field":"entityId","message":"part 200 does not exist"},` +
+				`{"field":"runSupportOptimization","message":"not available for parts"}]}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.ValidateWorkflow(post)
+
+		// assert
+		validationErr, ok := err.(*WorkflowValidationError)
+		if assert.True(t, ok, "Expected a *WorkflowValidationError but got %v", err) && assert.Len(t, validationErr.Fields, 2, "Expected every offending field") {
+			assert.Equal(t, "entityId", *validationErr.Fields[0].Field, "Expected the offending field")
+			assert.Equal(t, "part 200 does not exist", validationErr.Fields[0].Message, "Expected the problem with the field")
+			assert.Equal(t, "runSupportOptimization", *validationErr.Fields[1].Field, "Expected the offending field")
+			assert.Contains(t, validationErr.Error(), "entityId: part 200 does not exist", "Expected the fields in the message")
+		}
+	})
+
+	t.Run("WhenPriorityInvalidExpectsPriorityErrorWithoutRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.ValidateWorkflow(models.NewPostWorkflow(models.PostWorkflowWorkflowTypePart, 200, 10, models.WithPriority("Urgent")))
+
+		// assert
+		assert.Equal(t, &PriorityError{Priority: "Urgent"}, err, "Expected a *PriorityError")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no request to be made")
+	})
+}
+
+func TestListActivitiesPage(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities"
+	allActivities := []*models.Activity{
+		{ID: swag.String("activity-1"), Status: swag.String(models.ActivityStatusCompleted)},
+		{ID: swag.String("activity-2"), Status: swag.String(models.ActivityStatusCompleted)},
+		{ID: swag.String("activity-3"), Status: swag.String(models.ActivityStatusCompleted)},
+		{ID: swag.String("activity-4"), Status: swag.String(models.ActivityStatusRunning)},
+		{ID: swag.String("activity-5"), Status: swag.String(models.ActivityStatusRunning)},
+	}
+
+	// pagedHandler serves allActivities using the index of the next activity as the cursor
+	pagedHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.NotEmpty(t, r.Header.Get("Authorization"), "Authorization header should not be empty")
+		assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+		start := 0
+		if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+			start, _ = strconv.Atoi(cursor)
+		}
+		limit := len(allActivities)
+		if l := r.URL.Query().Get("limit"); l != "" {
+			limit, _ = strconv.Atoi(l)
+		}
+		end := start + limit
+		page := &models.ActivityPage{}
+		if end < len(allActivities) {
+			page.NextCursor = strconv.Itoa(end)
+		} else {
+			end = len(allActivities)
+		}
+		page.Activities = allActivities[start:end]
+		bytes, err := json.Marshal(page)
+		if err != nil {
+			t.Fatal("Failed to marshal activity page " + err.Error())
+		}
+		w.Write(bytes)
+	})
+
+	t.Run("WhenPagingExpectsFullListReconstructed", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, pagedHandler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		var activities []*models.Activity
+		pages := 0
+		cursor := ""
+		for {
+			page, nextCursor, err := client.ListActivitiesPage(workflowID, cursor, 2)
+			if err != nil {
+				t.Fatal(err)
+			}
+			pages++
+			activities = append(activities, page...)
+			if nextCursor == "" {
+				break
+			}
+			cursor = nextCursor
+		}
+
+		// assert
+		assert.Equal(t, 3, pages, "Expected 5 activities to be split into 3 pages")
+		assert.Equal(t, allActivities, activities, "Expected pages to reconstruct the full list of activities")
+	})
+
+	t.Run("WhenListingAllExpectsEveryPageFetched", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, pagedHandler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activities, err := client.ListActivities(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected error to be nil when listing activities")
+		assert.Equal(t, allActivities, activities, "Expected the full list of activities")
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activities, nextCursor, err := client.ListActivitiesPage(workflowID, "", 2)
+
+		// assert
+		assert.Nil(t, activities, "Expected no activities to be returned due to token error")
+		assert.Empty(t, nextCursor, "Expected no cursor to be returned due to token error")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("Token", nil)
+
+		// return server error from http handler
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		})
+
+		// set up routes
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activities, nextCursor, err := client.ListActivitiesPage(workflowID, "", 2)
+
+		// assert
+		assert.Nil(t, activities, "Expected no activities to be returned due to API error")
+		assert.Empty(t, nextCursor, "Expected no cursor to be returned due to API error")
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+	})
+}
+
+func TestWorkflowProgress(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities"
+	newServer := func(activities []*models.Activity) *httptest.Server {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(&models.ActivityPage{Activities: activities})
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenActivitiesHaveMixedProgressExpectsAverageWithEndedActivitiesAt100", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		testServer := newServer([]*models.Activity{
+			{ID: swag.String("activity-1"), Status: swag.String(models.ActivityStatusCompleted)},
+			{ID: swag.String("activity-2"), Status: swag.String(models.ActivityStatusFailed), PercentComplete: 10},
+			{ID: swag.String("activity-3"), Status: swag.String(models.ActivityStatusRunning), PercentComplete: 50},
+			{ID: swag.String("activity-4"), Status: swag.String(models.ActivityStatusRunning), PercentComplete: 20},
+		})
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		progress, err := client.WorkflowProgress(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error getting workflow progress")
+		assert.Equal(t, 67, progress, "Expected (100 + 100 + 50 + 20) / 4 rounded down")
+	})
+
+	t.Run("WhenNoActivitiesExpectsZero", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		testServer := newServer(nil)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		progress, err := client.WorkflowProgress(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error getting workflow progress")
+		assert.Equal(t, 0, progress, "Expected no progress without activities")
+	})
+
+	t.Run("WhenAllButOneActivityEndedExpectsLessThan100", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		activities := []*models.Activity{{ID: swag.String("running"), Status: swag.String(models.ActivityStatusRunning), PercentComplete: 99}}
+		for i := 0; i < 10; i++ {
+			activities = append(activities, &models.Activity{ID: swag.String("completed-" + strconv.Itoa(i)), Status: swag.String(models.ActivityStatusCompleted)})
+		}
+		testServer := newServer(activities)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		progress, err := client.WorkflowProgress(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error getting workflow progress")
+		assert.Equal(t, 99, progress, "Expected the workflow to not be done while an activity is running")
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.WorkflowProgress(workflowID)
+
+		// assert
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+}
+
+func TestCurrentActivity(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	newServer := func(workflow *models.Workflow) *httptest.Server {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(workflow)
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenOneActivityRunningExpectsRunningActivity", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		testServer := newServer(&models.Workflow{ID: workflowID, State: models.WorkflowStateRunning, Activities: []*models.Activity{
+			{ID: swag.String("activity-1"), Status: swag.String(models.ActivityStatusCompleted)},
+			{ID: swag.String("activity-2"), Status: swag.String(models.ActivityStatusCompleted)},
+			{ID: swag.String("activity-3"), Status: swag.String(models.ActivityStatusRunning), PercentComplete: 40},
+			{ID: swag.String("activity-4"), Status: swag.String(models.ActivityStatusCompleted)},
+		}})
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CurrentActivity(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error getting the current activity")
+		if assert.NotNil(t, activity, "Expected the running activity") {
+			assert.Equal(t, "activity-3", *activity.ID, "Expected the running activity")
+			assert.EqualValues(t, 40, activity.PercentComplete, "Expected the progress of the running activity")
+		}
+	})
+
+	t.Run("WhenWorkflowWaitingOnCapacityExpectsNoCurrentActivityError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		testServer := newServer(&models.Workflow{ID: workflowID, State: models.WorkflowStateRunning, WaitingOnCapacity: true, Activities: []*models.Activity{
+			{ID: swag.String("activity-1"), Status: swag.String(models.ActivityStatusCompleted)},
+		}})
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		activity, err := client.CurrentActivity(workflowID)
+
+		// assert
+		assert.Nil(t, activity, "Expected no activity")
+		assert.Equal(t, &NoCurrentActivityError{WorkflowID: workflowID, State: models.WorkflowStateRunning, WaitingOnCapacity: true}, err, "Expected a no current activity error")
+	})
+
+	t.Run("WhenWorkflowEndedExpectsNoCurrentActivityErrorWithState", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		testServer := newServer(&models.Workflow{ID: workflowID, State: models.WorkflowStateCompleted, Activities: []*models.Activity{
+			{ID: swag.String("activity-1"), Status: swag.String(models.ActivityStatusCompleted)},
+		}})
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.CurrentActivity(workflowID)
+
+		// assert
+		assert.Equal(t, &NoCurrentActivityError{WorkflowID: workflowID, State: models.WorkflowStateCompleted}, err, "Expected a no current activity error")
+	})
+}
+
+func TestListWorkflows(t *testing.T) {
+	// arrange
+	endpoint := "/" + workflowAPIBasePath + "/workflows"
+
+	t.Run("WhenFilterGivenExpectsFilterSentAsQuery", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var query url.Values
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			query = r.URL.Query()
+			w.Write([]byte(`{"workflows":[{"id":"workflow-1"}]}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflows, err := client.ListWorkflows(WorkflowFilter{EntityID: 5, OrganizationID: 7, State: models.WorkflowStateRunning})
+
+		// assert
+		assert.Nil(t, err, "Expected error to be nil when listing workflows")
+		if assert.Len(t, workflows, 1, "Expected the workflow to be returned") {
+			assert.Equal(t, "workflow-1", workflows[0].ID, "Expected the workflow ID to match")
+		}
+		assert.Equal(t, "5", query.Get("entityId"), "Expected entity ID to be sent")
+		assert.Equal(t, "7", query.Get("organizationId"), "Expected organization ID to be sent")
+		assert.Equal(t, models.WorkflowStateRunning, query.Get("state"), "Expected state to be sent")
+	})
+
+	t.Run("WhenSortByGivenExpectsWorkflowsOfAllPagesInRequestedOrder", func(t *testing.T) {
+		// arrange
+		pages := map[string]string{
+			"":       `{"workflows":[{"id":"wf-c","state":"Running","createdAt":"2018-03-01T00:00:00.000Z"},{"id":"wf-a","state":"Running","createdAt":"2018-03-03T00:00:00.000Z"}],"nextCursor":"page-2"}`,
+			"page-2": `{"workflows":[{"id":"wf-b","state":"Completed","createdAt":"2018-03-02T00:00:00.000Z"}]}`,
+		}
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(pages[r.URL.Query().Get("cursor")]))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		ids := func(workflows []*models.Workflow) []string {
+			var ids []string
+			for _, workflow := range workflows {
+				ids = append(ids, workflow.ID)
+			}
+			return ids
+		}
+
+		// act
+		byCreatedAt, createdAtErr := client.ListWorkflows(WorkflowFilter{SortBy: SortByCreatedAt})
+		byState, stateErr := client.ListWorkflows(WorkflowFilter{SortBy: SortByState})
+		byID, idErr := client.ListWorkflows(WorkflowFilter{SortBy: SortByID})
+		unsorted, err := client.ListWorkflows(WorkflowFilter{})
+
+		// assert
+		assert.Nil(t, createdAtErr, "Expected no error listing workflows by created time")
+		assert.Nil(t, stateErr, "Expected no error listing workflows by state")
+		assert.Nil(t, idErr, "Expected no error listing workflows by ID")
+		assert.Nil(t, err, "Expected no error listing workflows")
+		assert.Equal(t, []string{"wf-c", "wf-b", "wf-a"}, ids(byCreatedAt), "Expected the oldest workflow first")
+		assert.Equal(t, []string{"wf-b", "wf-a", "wf-c"}, ids(byState), "Expected the workflows ordered by state, then by ID")
+		assert.Equal(t, []string{"wf-a", "wf-b", "wf-c"}, ids(byID), "Expected the workflows ordered by ID")
+		assert.Equal(t, []string{"wf-c", "wf-a", "wf-b"}, ids(unsorted), "Expected the order of the workflow API by default")
+	})
+
+	t.Run("WhenFilterEmptyExpectsNoQuerySent", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var rawQuery string
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			rawQuery = r.URL.RawQuery
+			w.Write([]byte(`{"workflows":[]}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.ListWorkflows(WorkflowFilter{})
+
+		// assert
+		assert.Nil(t, err, "Expected error to be nil when listing workflows")
+		assert.Empty(t, rawQuery, "Expected no filter to be sent")
+	})
+
+	t.Run("WhenListingAllExpectsEveryPageFetched", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Query().Get("cursor") == "" {
+				w.Write([]byte(`{"workflows":[{"id":"workflow-1"}],"nextCursor":"next"}`))
+				return
+			}
+			w.Write([]byte(`{"workflows":[{"id":"workflow-2"}]}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflows, err := client.ListWorkflows(WorkflowFilter{})
+
+		// assert
+		assert.Nil(t, err, "Expected error to be nil when listing workflows")
+		assert.Equal(t, []*models.Workflow{{ID: "workflow-1"}, {ID: "workflow-2"}}, workflows, "Expected workflows from both pages")
+	})
+
+	t.Run("WhenFetcherErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflows, err := client.ListWorkflows(WorkflowFilter{})
+
+		// assert
+		assert.Nil(t, workflows, "Expected no workflows to be returned due to token error")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+}
+
+func TestSearchWorkflows(t *testing.T) {
+	// arrange
+	orgID := int32(10)
+	endpoint := "/" + workflowAPIBasePath + "/workflows/search"
+
+	t.Run("WhenSuccessfulExpectsQueryAndOrgSentAndMatchesReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var receivedQuery, receivedOrgID string
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedQuery = r.URL.Query().Get("q")
+			receivedOrgID = r.URL.Query().Get("organizationId")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`[{"id":"sim-2001"},{"id":"sim-200"}]`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflows, err := client.SearchWorkflows("200", orgID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error searching workflows")
+		assert.Equal(t, "200", receivedQuery, "Expected the query to be sent")
+		assert.Equal(t, "10", receivedOrgID, "Expected the organization ID to be sent")
+		if assert.Len(t, workflows, 2, "Expected every match to be returned") {
+			assert.Equal(t, "sim-2001", workflows[0].ID, "Expected the matches in the order of the server")
+			assert.Equal(t, "sim-200", workflows[1].ID, "Expected the matches in the order of the server")
+		}
+	})
+
+	t.Run("WhenQueryBlankExpectsErrEmptySearchQueryWithoutRequest", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflows, err := client.SearchWorkflows("  ", orgID)
+
+		// assert
+		assert.Nil(t, workflows, "Expected no workflows to be returned")
+		assert.Equal(t, ErrEmptySearchQuery, err, "Expected ErrEmptySearchQuery")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no request to be made")
+	})
+
+	t.Run("WhenAPIErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(500)
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflows, err := client.SearchWorkflows("200", orgID)
+
+		// assert
+		assert.Nil(t, workflows, "Expected no workflows to be returned")
+		assert.NotNil(t, err, "Expected an error returned because workflow API sent a 500 error")
+	})
+}
+
+func TestForEachHistoryEvent(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/events"
+	// three pages of events one minute apart, the cursor is the number of the next page
+	pages := []string{
+		`{"events":[{"eventId":1,"eventType":"WorkflowStarted","time":"2017-06-01T10:00:00.000Z"},{"eventId":2,"eventType":"ActivityTaskScheduled","time":"2017-06-01T10:01:00.000Z"}],"nextCursor":"1"}`,
+		`{"events":[{"eventId":3,"eventType":"ActivityTaskCompleted","time":"2017-06-01T10:02:00.000Z"},{"eventId":4,"eventType":"ActivityTaskScheduled","time":"2017-06-01T10:03:00.000Z"}],"nextCursor":"2"}`,
+		`{"events":[{"eventId":5,"eventType":"ActivityTaskCompleted","time":"2017-06-01T10:04:00.000Z"},{"eventId":6,"eventType":"WorkflowCompleted","time":"2017-06-01T10:05:00.000Z"}]}`,
+	}
+	newServer := func(fetched *[]int) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			assert.EqualValues(t, workflowID, mux.Vars(r)["workflowID"], "Expected workflow id received to match what was passed in")
+			w.Header().Set("Content-Type", "application/json")
+			page := 0
+			if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+				page, _ = strconv.Atoi(cursor)
+			}
+			*fetched = append(*fetched, page)
+			w.Write([]byte(pages[page]))
+		})
+		return httptest.NewServer(r)
+	}
+	// visit returns the IDs of the events given to the callback
+	visit := func(client Client, filter HistoryFilter) ([]int64, error) {
+		var visited []int64
+		err := client.ForEachHistoryEvent(workflowID, filter, func(event *models.HistoryEvent) error {
+			visited = append(visited, swag.Int64Value(event.EventID))
+			return nil
+		})
+		return visited, err
+	}
+
+	t.Run("WhenFilteredByEventTypeExpectsMatchingEventsOfEveryPage", func(t *testing.T) {
+		// arrange
+		var fetched []int
+		testServer := newServer(&fetched)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		visited, err := visit(client, HistoryFilter{EventTypes: []string{"ActivityTaskScheduled", "WorkflowCompleted"}})
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.Equal(t, []int64{2, 4, 6}, visited, "Expected only the events of the given types, in order")
+		assert.Equal(t, []int{0, 1, 2}, fetched, "Expected every page to be fetched once")
+	})
+
+	t.Run("WhenFilteredByTimeRangeExpectsPagesAfterUntilNotFetched", func(t *testing.T) {
+		// arrange
+		var fetched []int
+		testServer := newServer(&fetched)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		filter := HistoryFilter{
+			Since: time.Date(2017, 6, 1, 10, 1, 0, 0, time.UTC),
+			Until: time.Date(2017, 6, 1, 10, 2, 30, 0, time.UTC),
+		}
+
+		// act
+		visited, err := visit(client, filter)
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.Equal(t, []int64{2, 3}, visited, "Expected only the events within the time range")
+		assert.Equal(t, []int{0, 1}, fetched, "Expected no page to be fetched after an event past Until")
+	})
+
+	t.Run("WhenCallbackErrorsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		var fetched []int
+		testServer := newServer(&fetched)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		expectedError := errors.New("disk full")
+
+		// act
+		err := client.ForEachHistoryEvent(workflowID, HistoryFilter{}, func(event *models.HistoryEvent) error {
+			return expectedError
+		})
+
+		// assert
+		assert.Equal(t, expectedError, err, "Expected the error of the callback")
+		assert.Equal(t, []int{0}, fetched, "Expected the remaining pages not to be fetched")
+	})
+}
+
+func TestForEachWorkflow(t *testing.T) {
+	// arrange
+	endpoint := "/" + workflowAPIBasePath + "/workflows"
+	// three pages of two workflows, the cursor is the number of the next page
+	pages := []string{
+		`{"workflows":[{"id":"workflow-1"},{"id":"workflow-2"}],"nextCursor":"1"}`,
+		`{"workflows":[{"id":"workflow-3"},{"id":"workflow-4"}],"nextCursor":"2"}`,
+		`{"workflows":[{"id":"workflow-5"},{"id":"workflow-6"}]}`,
+	}
+	newServer := func(fetched *[]int) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			page := 0
+			if cursor := r.URL.Query().Get("cursor"); cursor != "" {
+				page, _ = strconv.Atoi(cursor)
+			}
+			*fetched = append(*fetched, page)
+			w.Write([]byte(pages[page]))
+		})
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenCallbackSucceedsExpectsEveryWorkflowVisited", func(t *testing.T) {
+		// arrange
+		var fetched []int
+		testServer := newServer(&fetched)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		var visited []string
+
+		// act
+		err := client.ForEachWorkflow(WorkflowFilter{}, func(workflow *models.Workflow) error {
+			visited = append(visited, workflow.ID)
+			return nil
+		})
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.Equal(t, []string{"workflow-1", "workflow-2", "workflow-3", "workflow-4", "workflow-5", "workflow-6"}, visited, "Expected every workflow to be visited in order")
+		assert.Equal(t, []int{0, 1, 2}, fetched, "Expected every page to be fetched once")
+	})
+
+	t.Run("WhenCallbackErrorsExpectsRemainingPagesNotFetched", func(t *testing.T) {
+		// arrange
+		var fetched []int
+		testServer := newServer(&fetched)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		expectedError := errors.New("found it")
+		var visited []string
+
+		// act
+		err := client.ForEachWorkflow(WorkflowFilter{}, func(workflow *models.Workflow) error {
+			visited = append(visited, workflow.ID)
+			if workflow.ID == "workflow-3" {
+				return expectedError
+			}
+			return nil
+		})
+
+		// assert
+		assert.Equal(t, expectedError, err, "Expected the callback error to be returned")
+		assert.Equal(t, []string{"workflow-1", "workflow-2", "workflow-3"}, visited, "Expected to stop at the workflow that errored")
+		assert.Equal(t, []int{0, 1}, fetched, "Expected the last page to not be fetched")
+	})
+}
+
+func TestCancelWorkflowsForEntity(t *testing.T) {
+	// arrange
+	listEndpoint := "/" + workflowAPIBasePath + "/workflows"
+	cancelEndpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/cancel"
+	var entityID, organizationID int32 = 5, 7
+	listHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		assert.Equal(t, "5", r.URL.Query().Get("entityId"), "Expected entity ID to be sent")
+		assert.Equal(t, "7", r.URL.Query().Get("organizationId"), "Expected organization ID to be sent")
+		assert.Equal(t, models.WorkflowStateRunning, r.URL.Query().Get("state"), "Expected only running workflows to be listed")
+		w.Write([]byte(`{"workflows":[{"id":"workflow-1","state":"Running"},{"id":"workflow-2","state":"Running"}]}`))
+	})
+
+	t.Run("WhenBothRunningExpectsBothCancelled", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		var mu sync.Mutex
+		var cancelRequests []string
+		cancelHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+			cancelRequests = append(cancelRequests, mux.Vars(r)["workflowID"])
+			w.Header().Set("Content-Type", "application/json")
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(listEndpoint, listHandler)
+		r.HandleFunc(cancelEndpoint, cancelHandler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		cancelled, err := client.CancelWorkflowsForEntity(entityID, organizationID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.Equal(t, []string{"workflow-1", "workflow-2"}, cancelled, "Expected both workflows to be cancelled")
+		assert.Equal(t, []string{"workflow-1", "workflow-2"}, cancelRequests, "Expected a cancel request per workflow")
+	})
+
+	t.Run("WhenOneFinishedBeforeCancelExpectsItSkipped", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		cancelHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if mux.Vars(r)["workflowID"] == "workflow-1" {
+				w.WriteHeader(http.StatusConflict)
+				w.Write([]byte(`{"code":409,"message":"workflow already completed"}`))
+			}
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(listEndpoint, listHandler)
+		r.HandleFunc(cancelEndpoint, cancelHandler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		cancelled, err := client.CancelWorkflowsForEntity(entityID, organizationID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error because a finished workflow needs no cancelling")
+		assert.Equal(t, []string{"workflow-2"}, cancelled, "Expected only the running workflow to be reported as cancelled")
+	})
+
+	t.Run("WhenOneCancelFailsExpectsErrorsAggregated", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		cancelHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if mux.Vars(r)["workflowID"] == "workflow-1" {
+				w.WriteHeader(http.StatusInternalServerError)
+			}
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(listEndpoint, listHandler)
+		r.HandleFunc(cancelEndpoint, cancelHandler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		cancelled, err := client.CancelWorkflowsForEntity(entityID, organizationID)
+
+		// assert
+		assert.Equal(t, []string{"workflow-2"}, cancelled, "Expected the other workflow to still be cancelled")
+		if assert.IsType(t, &MultiError{}, err, "Expected the errors to be aggregated") {
+			errs := err.(*MultiError).Errors
+			if assert.Len(t, errs, 1, "Expected one error") && assert.IsType(t, &WorkflowError{}, errs[0], "Expected a workflow error") {
+				assert.Equal(t, "workflow-1", errs[0].(*WorkflowError).WorkflowID, "Expected the error to name the failed workflow")
+			}
+		}
+	})
+
+	t.Run("WhenListFailsExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		expectedError := errors.New("Some auth0 error")
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", expectedError)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+
+		// act
+		cancelled, err := client.CancelWorkflowsForEntity(entityID, organizationID)
+
+		// assert
+		assert.Nil(t, cancelled, "Expected nothing cancelled")
+		assert.Equal(t, expectedError, err, "Expected an error returned")
+	})
+}
+
+func TestWithContext(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	newServer := func(receivedAuthorization *string) *httptest.Server {
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*receivedAuthorization = r.Header.Get("Authorization")
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"` + workflowID + `"}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenTokenInContextExpectsTokenUsedAndFetcherNotCalled", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("fetched token", nil)
+		var receivedAuthorization string
+		testServer := newServer(&receivedAuthorization)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		ctx := ContextWithToken(context.Background(), "forwarded token")
+
+		// act
+		workflow, err := client.WithContext(ctx).GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error getting workflow")
+		assert.NotNil(t, workflow, "Expected workflow to be returned")
+		assert.Equal(t, "Bearer forwarded token", receivedAuthorization, "Expected the token from the context to be sent")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected the token fetcher to not be called")
+	})
+
+	t.Run("WhenNoTokenInContextExpectsFetcherCalled", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("fetched token", nil)
+		var receivedAuthorization string
+		testServer := newServer(&receivedAuthorization)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.WithContext(context.Background()).GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error getting workflow")
+		assert.Equal(t, "Bearer fetched token", receivedAuthorization, "Expected the fetched token to be sent")
+		assert.Equal(t, 1, fakeTokenFetcher.TokenCallCount(), "Expected the token fetcher to be called")
+	})
+
+	t.Run("WhenCalledExpectsOriginalClientUnchanged", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("fetched token", nil)
+		var receivedAuthorization string
+		testServer := newServer(&receivedAuthorization)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		client.WithContext(ContextWithToken(context.Background(), "forwarded token"))
+
+		// act
+		_, err := client.GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error getting workflow")
+		assert.Equal(t, "Bearer fetched token", receivedAuthorization, "Expected the original client to still fetch tokens")
+	})
+
+	t.Run("WhenContextCancelledExpectsErrorReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("fetched token", nil)
+		var receivedAuthorization string
+		testServer := newServer(&receivedAuthorization)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// act
+		workflow, err := client.WithContext(ctx).GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, workflow, "Expected no workflow to be returned")
+		assert.NotNil(t, err, "Expected an error because the context was cancelled")
+		assert.Empty(t, receivedAuthorization, "Expected no request to reach the server")
+	})
+
+	t.Run("WhenContextDetachedAfterCancelExpectsTokenUsed", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("fetched token", nil)
+		var receivedAuthorization string
+		testServer := newServer(&receivedAuthorization)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		ctx, cancel := context.WithCancel(ContextWithToken(context.Background(), "forwarded token"))
+		cancel()
+
+		// act
+		_, err := client.WithContext(DetachContext(ctx)).GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error getting workflow")
+		assert.Equal(t, "Bearer forwarded token", receivedAuthorization, "Expected the token from the context to be sent")
+	})
+}
+
+func TestClose(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"` + workflowID + `"}`))
+	})
+
+	t.Run("WhenTransportCreatedExpectsIdleConnectionsClosed", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		closedConnections := make(chan struct{}, 1)
+		testServer := httptest.NewUnstartedServer(r)
+		testServer.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+			if state == http.StateClosed {
+				closedConnections <- struct{}{}
+			}
+		}
+		testServer.Start()
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithMaxIdleConnsPerHost(4))
+		_, err := client.GetWorkflow(workflowID)
+		assert.Nil(t, err, "Expected no error getting workflow")
+
+		// act
+		err = client.Close()
+
+		// assert
+		assert.Nil(t, err, "Expected no error closing the client")
+		select {
+		case <-closedConnections:
+		case <-time.After(1 * time.Second):
+			t.Error("Expected the idle connection to be closed")
+		}
+	})
+
+	t.Run("WhenClosedExpectsCallsToReturnErrClientClosed", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+		withContext := client.WithContext(context.Background())
+		client.Close()
+
+		// act
+		workflow, err := client.GetWorkflow(workflowID)
+		_, errWithContext := withContext.GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, workflow, "Expected no workflow to be returned")
+		assert.Equal(t, ErrClientClosed, err, "Expected ErrClientClosed")
+		assert.Equal(t, ErrClientClosed, errWithContext, "Expected copies made by WithContext to be closed too")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no token to be fetched")
+	})
+
+	t.Run("WhenClosedTwiceExpectsNoError", func(t *testing.T) {
+		// arrange
+		client := NewClient(&auth0fakes.FakeTokenFetcher{}, gatewayURL, workflowAPIBasePath, audience, logger)
+		client.Close()
+
+		// act
+		err := client.Close()
+
+		// assert
+		assert.Nil(t, err, "Expected no error closing the client again")
+	})
 }
@@ -0,0 +1,62 @@
+package workflow
+
+import (
+	"context"
+	"time"
+)
+
+type tokenKey struct{}
+
+// ContextWithToken returns a copy of ctx holding token.  Requests made by a client from Client.WithContext with that
+// context send token as is instead of getting one from the TokenFetcher, e.g. to forward the token of an upstream
+// request.  The token is not checked or refreshed, so it must be valid for the audience of the client.
+func ContextWithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, tokenKey{}, token)
+}
+
+func tokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(tokenKey{}).(string)
+	return token, ok && token != ""
+}
+
+type attemptKey struct{}
+
+// ContextWithAttempt returns a copy of ctx holding the attempt number of an activity, starting at 1.  The activities
+// sent by a client from Client.WithContext with that context (completions and progress updates) carry the attempt
+// number so the workflow API can reject a late report of a previous attempt instead of applying it to the current one.
+func ContextWithAttempt(ctx context.Context, attempt int32) context.Context {
+	return context.WithValue(ctx, attemptKey{}, attempt)
+}
+
+// AttemptFromContext returns the attempt number held by ctx, see ContextWithAttempt.  ok is false if ctx holds none.
+func AttemptFromContext(ctx context.Context) (attempt int32, ok bool) {
+	attempt, ok = ctx.Value(attemptKey{}).(int32)
+	return attempt, ok && attempt > 0
+}
+
+// DetachContext returns a context holding the values of ctx, e.g. the token of ContextWithToken and the attempt of
+// ContextWithAttempt, that is never cancelled and has no deadline.  Bind a client to it with Client.WithContext to send
+// requests that must outlive ctx, e.g. the completion of an activity whose context was cancelled.
+func DetachContext(ctx context.Context) context.Context {
+	return detachedContext{ctx}
+}
+
+type detachedContext struct {
+	values context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
+
+func (c detachedContext) Value(key interface{}) interface{} {
+	return c.values.Value(key)
+}
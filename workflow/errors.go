@@ -0,0 +1,392 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/swag"
+	"golang.org/x/time/rate"
+)
+
+// ErrClientClosed is returned by the calls made on a client after its Close method was called
+var ErrClientClosed = errors.New("Workflow client is closed")
+
+// ErrTimeout is returned when a request to the workflow API exceeds its timeout or the deadline of the context given to
+// WithContext
+var ErrTimeout = errors.New("Request to the workflow API timed out")
+
+// ErrEmptySearchQuery is returned by SearchWorkflows when the query is empty or only white space
+var ErrEmptySearchQuery = errors.New("Search query is empty")
+
+// ActivityConflictError is returned when an activity cannot be completed because the workflow API reports that it
+// already reached a different terminal status.
+type ActivityConflictError struct {
+	WorkflowID string
+	ActivityID string
+	// Status is the status that was being set
+	Status string
+	// ExistingStatus is the status the activity already has.  Empty if the workflow API did not send it.
+	ExistingStatus string
+}
+
+func (e *ActivityConflictError) Error() string {
+	return fmt.Sprintf("Activity %v of workflow %v is already %v and cannot be changed to %v", e.ActivityID, e.WorkflowID, e.ExistingStatus, e.Status)
+}
+
+// WorkflowError ties an error to the workflow it happened for
+type WorkflowError struct {
+	WorkflowID string
+	Err        error
+}
+
+func (e *WorkflowError) Error() string {
+	return fmt.Sprintf("Workflow %v: %v", e.WorkflowID, e.Err)
+}
+
+// ActivityError ties an error to the activity it happened for
+type ActivityError struct {
+	ActivityID string
+	Err        error
+}
+
+func (e *ActivityError) Error() string {
+	return fmt.Sprintf("Activity %v: %v", e.ActivityID, e.Err)
+}
+
+// MultiError is returned by operations that make several requests and carry on when some of them fail.  Errors holds
+// one error per failed request.
+type MultiError struct {
+	Errors []error
+}
+
+func (e *MultiError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("%d errors occurred: %v", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// ResponseTooLargeError is returned when the body of a response from the workflow API is larger than the limit set with
+// WithMaxResponseSize
+type ResponseTooLargeError struct {
+	Limit int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	return fmt.Sprintf("Response from the workflow API is larger than the limit of %d bytes", e.Limit)
+}
+
+// PercentCompleteRangeError is returned when a percent complete outside of [0,100] is sent by a client created with
+// WithStrictPercentComplete
+type PercentCompleteRangeError struct {
+	PercentComplete int
+}
+
+func (e *PercentCompleteRangeError) Error() string {
+	return fmt.Sprintf("Percent complete %v is out of range, it must be between 0 and 100", e.PercentComplete)
+}
+
+// ActivityOutcomeError is returned by ActivityOutcome.Validate, and by CompleteActivity without sending a request, when
+// the outcome is not a sensible terminal transition of an activity
+type ActivityOutcomeError struct {
+	Status string
+	Reason string
+}
+
+func (e *ActivityOutcomeError) Error() string {
+	return fmt.Sprintf("Activity outcome with status %q is not valid: %v", e.Status, e.Reason)
+}
+
+// ActivityVersionConflictError is returned by UpdateActivity when the activity was changed since the version that was
+// sent.  Fetch the activity again and retry the update with the new version.
+type ActivityVersionConflictError struct {
+	WorkflowID string
+	ActivityID string
+	// Version is the version that was sent
+	Version string
+}
+
+func (e *ActivityVersionConflictError) Error() string {
+	return fmt.Sprintf("Activity %v of workflow %v was changed since version %v", e.ActivityID, e.WorkflowID, e.Version)
+}
+
+// ActivityNotFailedError is returned by RetryActivity when the activity cannot be retried because it is not failed
+type ActivityNotFailedError struct {
+	WorkflowID string
+	ActivityID string
+	// Status is the status the activity has, one of the models.ActivityStatus... constants.  Empty if the workflow API
+	// did not send it.
+	Status string
+}
+
+func (e *ActivityNotFailedError) Error() string {
+	return fmt.Sprintf("Activity %v of workflow %v is %v, only failed activities can be retried", e.ActivityID, e.WorkflowID, e.Status)
+}
+
+// OrganizationIDError is returned by TransferWorkflow, WatchCapacity and GetWorkflowByExternalID when the organization ID is not positive
+type OrganizationIDError struct {
+	OrganizationID int32
+}
+
+func (e *OrganizationIDError) Error() string {
+	return fmt.Sprintf("Organization ID %d is not valid, it must be positive", e.OrganizationID)
+}
+
+// OrganizationMismatchError is returned by the methods given an organization ID when the client is bound to another
+// organization by WithOrganizationContext.  No request is sent.
+type OrganizationMismatchError struct {
+	OrganizationID        int32
+	ContextOrganizationID int32
+}
+
+func (e *OrganizationMismatchError) Error() string {
+	return fmt.Sprintf("Organization %d does not match organization %d of the client", e.OrganizationID, e.ContextOrganizationID)
+}
+
+// SameOrganizationError is returned by TransferWorkflow when the workflow already belongs to the organization
+type SameOrganizationError struct {
+	WorkflowID     string
+	OrganizationID int32
+}
+
+func (e *SameOrganizationError) Error() string {
+	return fmt.Sprintf("Workflow %v already belongs to organization %d", e.WorkflowID, e.OrganizationID)
+}
+
+// TransferForbiddenError is returned by TransferWorkflow when the workflow API does not allow the caller to transfer the
+// workflow
+type TransferForbiddenError struct {
+	WorkflowID     string
+	OrganizationID int32
+}
+
+func (e *TransferForbiddenError) Error() string {
+	return fmt.Sprintf("Not allowed to transfer workflow %v to organization %d", e.WorkflowID, e.OrganizationID)
+}
+
+// ActivityNotFoundError is returned when the workflow API does not know the activity or its workflow
+type ActivityNotFoundError struct {
+	WorkflowID string
+	ActivityID string
+}
+
+func (e *ActivityNotFoundError) Error() string {
+	return fmt.Sprintf("Activity %v of workflow %v was not found", e.ActivityID, e.WorkflowID)
+}
+
+// WorkflowNotFoundError is returned by GetWorkflowByExternalID when the organization has no workflow started with the
+// external ID
+type WorkflowNotFoundError struct {
+	ExternalID     string
+	OrganizationID int32
+}
+
+func (e *WorkflowNotFoundError) Error() string {
+	return fmt.Sprintf("No workflow of organization %d has external ID %v", e.OrganizationID, e.ExternalID)
+}
+
+// SignalNotFoundError is returned by GetSignalStatus when no signal of the name was sent to the workflow
+type SignalNotFoundError struct {
+	WorkflowID string
+	SignalName string
+}
+
+func (e *SignalNotFoundError) Error() string {
+	return fmt.Sprintf("Signal %v was not sent to workflow %v", e.SignalName, e.WorkflowID)
+}
+
+// NoActiveTaskTokenError is returned by GetActivityTaskToken when the activity has no task token because it is not
+// running
+type NoActiveTaskTokenError struct {
+	WorkflowID string
+	ActivityID string
+}
+
+func (e *NoActiveTaskTokenError) Error() string {
+	return fmt.Sprintf("Activity %v of workflow %v has no active task token, it is not running", e.ActivityID, e.WorkflowID)
+}
+
+// UnknownTaskTokenError is returned by LookupByTaskToken when the workflow API does not know the task token, e.g.
+// because the activity attempt it identifies ended and the task token expired
+type UnknownTaskTokenError struct {
+	TaskToken string
+}
+
+func (e *UnknownTaskTokenError) Error() string {
+	return fmt.Sprintf("Task token %v is unknown or expired", e.TaskToken)
+}
+
+// WorkflowNotRunningError is returned when an operation requires a running workflow but the workflow is in another state
+type WorkflowNotRunningError struct {
+	WorkflowID string
+	// State is the state the workflow is in, one of the models.WorkflowState... constants
+	State string
+}
+
+func (e *WorkflowNotRunningError) Error() string {
+	return fmt.Sprintf("Workflow %v is not running, it is %v", e.WorkflowID, e.State)
+}
+
+// NoCurrentActivityError is returned by CurrentActivity when none of the activities of the workflow is running
+type NoCurrentActivityError struct {
+	WorkflowID string
+	// State is the state the workflow is in, one of the models.WorkflowState... constants
+	State string
+	// WaitingOnCapacity tells if the workflow waits on capacity before it can run its next activity
+	WaitingOnCapacity bool
+}
+
+func (e *NoCurrentActivityError) Error() string {
+	if e.WaitingOnCapacity {
+		return fmt.Sprintf("Workflow %v has no running activity, it is waiting on capacity", e.WorkflowID)
+	}
+	return fmt.Sprintf("Workflow %v has no running activity, it is %v", e.WorkflowID, e.State)
+}
+
+// PriorityError is returned by StartWorkflow when the priority of the workflow is not one of the
+// models.PostWorkflowPriority... constants
+type PriorityError struct {
+	Priority string
+}
+
+func (e *PriorityError) Error() string {
+	return fmt.Sprintf("Priority %q is not valid, it must be one of Low, Normal or High", e.Priority)
+}
+
+// WorkflowTimeoutError is returned by StartWorkflow when the TimeoutSeconds of the workflow is negative
+type WorkflowTimeoutError struct {
+	TimeoutSeconds int64
+}
+
+func (e *WorkflowTimeoutError) Error() string {
+	return fmt.Sprintf("Workflow timeout of %v seconds is not valid, it must be positive", e.TimeoutSeconds)
+}
+
+// HeartbeatExtensionError is returned by ExtendHeartbeatTimeout when the extension is not positive
+type HeartbeatExtensionError struct {
+	Extension time.Duration
+}
+
+func (e *HeartbeatExtensionError) Error() string {
+	return fmt.Sprintf("Heartbeat timeout extension of %v is not valid, it must be positive", e.Extension)
+}
+
+// StartTimeError is returned by StartWorkflow when the StartAt of the workflow is not in the future
+type StartTimeError struct {
+	StartAt time.Time
+}
+
+func (e *StartTimeError) Error() string {
+	return fmt.Sprintf("Start time %v is not valid, it must be in the future", e.StartAt.Format(time.RFC3339))
+}
+
+// RetryPolicyError is returned by StartWorkflow when a value of the RetryPolicy of the workflow is out of range
+type RetryPolicyError struct {
+	// Field is the JSON name of the value, e.g. maxAttempts
+	Field string
+	Value interface{}
+	// Reason tells what the value must be, e.g. positive
+	Reason string
+}
+
+func (e *RetryPolicyError) Error() string {
+	return fmt.Sprintf("Retry policy %v of %v is not valid, it must be %v", e.Field, e.Value, e.Reason)
+}
+
+// ArtifactDownloadError is returned by DownloadActivityResult when the request for the artifact of a result got an error
+// status
+type ArtifactDownloadError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *ArtifactDownloadError) Error() string {
+	return fmt.Sprintf("Downloading artifact %v failed with status %d", e.URL, e.StatusCode)
+}
+
+// WorkflowValidationError is returned by ValidateWorkflow when the workflow API finds problems with the workflow request
+type WorkflowValidationError struct {
+	// Fields has one entry per problem, Field being the JSON name of the offending field
+	Fields []*models.FieldError
+}
+
+func (e *WorkflowValidationError) Error() string {
+	problems := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		problems[i] = fmt.Sprintf("%v: %v", swag.StringValue(field.Field), field.Message)
+	}
+	return fmt.Sprintf("Workflow is not valid: %v", strings.Join(problems, "; "))
+}
+
+// CapacityUnavailableError is returned by StartWorkflowNoQueue (or StartWorkflow with models.PostWorkflow.NoQueue set)
+// when the organization is at capacity, so the workflow would have waited on capacity
+type CapacityUnavailableError struct {
+	OrganizationID int32
+	// Message is the message sent by the workflow API, if any
+	Message string
+}
+
+func (e *CapacityUnavailableError) Error() string {
+	return fmt.Sprintf("Organization %d is at capacity, try again later", e.OrganizationID)
+}
+
+// QuotaExceededError is returned by StartWorkflow when the organization already runs as many workflows as its quota
+// allows
+type QuotaExceededError struct {
+	// Limit is how many workflows the organization may run at the same time
+	Limit int32
+	// Usage is how many workflows the organization is running
+	Usage int32
+	// ResetTime is when the quota resets.  Zero if the workflow API did not send it, in which case the quota frees up
+	// as running workflows finish.
+	ResetTime time.Time
+	// Message is the message sent by the workflow API, if any
+	Message string
+}
+
+func (e *QuotaExceededError) Error() string {
+	if e.ResetTime.IsZero() {
+		return fmt.Sprintf("Workflow quota exceeded, %d/%d workflows are running", e.Usage, e.Limit)
+	}
+	return fmt.Sprintf("Workflow quota exceeded, %d/%d workflows are running, try again at %v", e.Usage, e.Limit, e.ResetTime.Format(time.RFC3339))
+}
+
+// CassetteMissError is returned when a client replaying a cassette (see WithCassette) sends a request that was not
+// recorded
+type CassetteMissError struct {
+	Method string
+	// Path is the path of the request along with its query
+	Path string
+}
+
+func (e *CassetteMissError) Error() string {
+	return fmt.Sprintf("No interaction recorded for %v %v", e.Method, e.Path)
+}
+
+// RateLimitError is returned by a client created with WithRateLimitFailFast when a request exceeds the rate set by
+// WithRateLimit for its operation
+type RateLimitError struct {
+	// Operation is the ID of the workflow API operation, e.g. startWorkflow
+	Operation string
+	Limit     rate.Limit
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("Rate limit of %v requests per second exceeded for operation %v", float64(e.Limit), e.Operation)
+}
+
+// StateNotReachedError is returned by WaitForState when the workflow ended in another state than the one waited for
+type StateNotReachedError struct {
+	WorkflowID  string
+	TargetState string
+	// State is the terminal state the workflow ended in, one of the models.WorkflowState... constants
+	State string
+}
+
+func (e *StateNotReachedError) Error() string {
+	return fmt.Sprintf("Workflow %v ended in state %v without reaching state %v", e.WorkflowID, e.State, e.TargetState)
+}
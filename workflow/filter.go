@@ -0,0 +1,103 @@
+package workflow
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/3dsim/workflow-goclient/genclient/operations"
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/swag"
+)
+
+// Orders of the workflows returned by ListWorkflows, see WorkflowFilter.SortBy
+const (
+	// SortByCreatedAt orders the workflows by models.Workflow.CreatedAt, oldest first
+	SortByCreatedAt = "createdAt"
+	// SortByState orders the workflows by models.Workflow.State, alphabetically
+	SortByState = "state"
+	// SortByID orders the workflows by models.Workflow.ID, alphabetically
+	SortByID = "id"
+)
+
+// WorkflowFilter selects the workflows returned by ListWorkflows.  Fields left at their zero value do not filter.
+type WorkflowFilter struct {
+	EntityID       int32
+	OrganizationID int32
+	// State is one of the models.WorkflowState... constants
+	State string
+	// SortBy is one of the SortBy... constants.  ListWorkflows sorts the workflows of all pages by it once they are
+	// fetched, workflows with the same value are ordered by ID so the order is the same from one call to the next.  By
+	// default the workflows are returned in the order the workflow API sends them, which may change between calls.  The
+	// workflow API does not sort, so ListWorkflowsPage and ForEachWorkflow ignore it.
+	SortBy string
+}
+
+// sort orders workflows as SortBy tells
+func (f WorkflowFilter) sort(workflows []*models.Workflow) {
+	var less func(a, b *models.Workflow) bool
+	switch f.SortBy {
+	case SortByCreatedAt:
+		less = func(a, b *models.Workflow) bool { return time.Time(a.CreatedAt).Before(time.Time(b.CreatedAt)) }
+	case SortByState:
+		less = func(a, b *models.Workflow) bool { return a.State < b.State }
+	case SortByID:
+		less = func(a, b *models.Workflow) bool { return false }
+	default:
+		return
+	}
+	sort.SliceStable(workflows, func(i, j int) bool {
+		if less(workflows[i], workflows[j]) {
+			return true
+		}
+		if less(workflows[j], workflows[i]) {
+			return false
+		}
+		return workflows[i].ID < workflows[j].ID
+	})
+}
+
+func (f WorkflowFilter) params(ctx context.Context) *operations.ListWorkflowsParams {
+	params := operations.NewListWorkflowsParams().WithContext(ctx)
+	if f.EntityID != 0 {
+		params.SetEntityID(swag.Int32(f.EntityID))
+	}
+	if f.OrganizationID != 0 {
+		params.SetOrganizationID(swag.Int32(f.OrganizationID))
+	}
+	if f.State != "" {
+		params.SetState(swag.String(f.State))
+	}
+	return params
+}
+
+// HistoryFilter selects the history events given to fn by ForEachHistoryEvent.  Fields left at their zero value do not
+// filter.
+type HistoryFilter struct {
+	// EventTypes keeps the events of any of these types, e.g. ActivityTaskScheduled
+	EventTypes []string
+	// Since and Until keep the events that happened within [Since, Until]
+	Since time.Time
+	Until time.Time
+}
+
+func (f HistoryFilter) matches(event *models.HistoryEvent) bool {
+	eventTime := time.Time(event.Time)
+	if !f.Since.IsZero() && eventTime.Before(f.Since) {
+		return false
+	}
+	if len(f.EventTypes) == 0 {
+		return true
+	}
+	for _, eventType := range f.EventTypes {
+		if swag.StringValue(event.EventType) == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// past tells if event happened after Until
+func (f HistoryFilter) past(event *models.HistoryEvent) bool {
+	return !f.Until.IsZero() && time.Time(event.Time).After(f.Until)
+}
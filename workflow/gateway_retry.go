@@ -0,0 +1,96 @@
+package workflow
+
+import (
+	"io"
+	"io/ioutil"
+	"net/http"
+	"time"
+)
+
+// defaultGatewayRetries is how many times a request is retried after a gateway error unless WithGatewayRetries is used
+const defaultGatewayRetries = 2
+
+// gatewayRetryDelay is the delay before the first gateway retry, it doubles for every retry after that.  It is a
+// variable so tests can shorten it.
+var gatewayRetryDelay = 100 * time.Millisecond
+
+// gatewayRetrier is a http.RoundTripper that retries the short lived 502/503/504 errors the API gateway returns, e.g.
+// while the workflow API is being deployed.
+type gatewayRetrier struct {
+	next    http.RoundTripper
+	retries int
+	// exhausted is called when the response of the last attempt is still a gateway error
+	exhausted retriesExhaustedFunc
+}
+
+func newGatewayRetrier(retries int, next http.RoundTripper, exhausted retriesExhaustedFunc) *gatewayRetrier {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &gatewayRetrier{next: next, retries: retries, exhausted: exhausted}
+}
+
+func (g *gatewayRetrier) RoundTrip(req *http.Request) (*http.Response, error) {
+	delay := gatewayRetryDelay
+	first := time.Now()
+	for retry := 0; ; retry++ {
+		start := time.Now()
+		resp, err := g.next.RoundTrip(req)
+		if err != nil || !isGatewayError(req, resp) {
+			return resp, err
+		}
+		// stop when out of retries or when the deadline would pass before another attempt completes
+		if retry >= g.retries || !hasTimeFor(req, delay+time.Since(start)) {
+			if g.exhausted != nil {
+				g.exhausted(req, retry+1, time.Since(first), resp, err)
+			}
+			return resp, err
+		}
+		retryReq, ok := rewind(req)
+		if !ok {
+			return resp, err
+		}
+		io.Copy(ioutil.Discard, resp.Body)
+		resp.Body.Close()
+		select {
+		case <-req.Context().Done():
+			return nil, req.Context().Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+		req = retryReq
+	}
+}
+
+// isGatewayError tells if resp is a gateway error that is safe to retry.  A 503 means the request was not handled.  A
+// 502 or 504 may come after the workflow API handled the request, so those are only retried for idempotent methods.
+func isGatewayError(req *http.Request, resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusServiceUnavailable:
+		return true
+	case http.StatusBadGateway, http.StatusGatewayTimeout:
+		switch req.Method {
+		case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+			return true
+		}
+	}
+	return false
+}
+
+// rewind returns a copy of req that can be sent again, or false if the body can't be read a second time
+func rewind(req *http.Request) (*http.Request, bool) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return req, true
+	}
+	if req.GetBody == nil {
+		return nil, false
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, false
+	}
+	retryReq := new(http.Request)
+	*retryReq = *req
+	retryReq.Body = body
+	return retryReq, true
+}
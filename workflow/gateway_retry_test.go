@@ -0,0 +1,121 @@
+package workflow
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	// keep the delay between gateway retries short so tests run quickly
+	gatewayRetryDelay = 1 * time.Millisecond
+}
+
+func TestGatewayRetries(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/activities/{activityID}"
+
+	// newFlappingServer fails the first failures requests with status, then succeeds.  Every body received is kept.
+	newFlappingServer := func(status, failures int, bodies *[]string) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			body, _ := ioutil.ReadAll(r.Body)
+			*bodies = append(*bodies, string(body))
+			w.Header().Set("Content-Type", "application/json")
+			if len(*bodies) <= failures {
+				w.WriteHeader(status)
+				return
+			}
+			w.Write([]byte(`{}`))
+		})
+		r.HandleFunc("/"+workflowAPIBasePath+"/workflows", func(w http.ResponseWriter, r *http.Request) {
+			*bodies = append(*bodies, "")
+			w.WriteHeader(status)
+		})
+		return httptest.NewServer(r)
+	}
+	activity := &models.Activity{ID: swag.String(activityID), Status: swag.String(models.ActivityStatusRunning)}
+
+	t.Run("WhenGatewayFlapsExpectsRetriedWithSameBody", func(t *testing.T) {
+		for _, status := range []int{http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout} {
+			// arrange
+			var bodies []string
+			testServer := newFlappingServer(status, 2, &bodies)
+			fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+			fakeTokenFetcher.TokenReturns("token", nil)
+			client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+			// act
+			_, err := client.UpdateActivity(workflowID, activity)
+
+			// assert
+			assert.Nil(t, err, "Expected the update to succeed after retrying status %v", status)
+			if assert.Len(t, bodies, 3, "Expected two retries for status %v", status) {
+				assert.NotEmpty(t, bodies[0], "Expected the activity to be sent")
+				assert.Equal(t, bodies[0], bodies[2], "Expected the retry to send the same body")
+			}
+			testServer.Close()
+		}
+	})
+
+	t.Run("WhenGatewayKeepsFailingExpectsErrorAfterConfiguredRetries", func(t *testing.T) {
+		// arrange
+		var bodies []string
+		testServer := newFlappingServer(http.StatusBadGateway, 10, &bodies)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithGatewayRetries(4))
+
+		// act
+		_, err := client.UpdateActivity(workflowID, activity)
+
+		// assert
+		assert.NotNil(t, err, "Expected an error once the retries ran out")
+		assert.Len(t, bodies, 5, "Expected the first attempt and four retries")
+	})
+
+	t.Run("WhenDisabledExpectsNoRetry", func(t *testing.T) {
+		// arrange
+		var bodies []string
+		testServer := newFlappingServer(http.StatusBadGateway, 1, &bodies)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithGatewayRetries(0))
+
+		// act
+		_, err := client.UpdateActivity(workflowID, activity)
+
+		// assert
+		assert.NotNil(t, err, "Expected the gateway error to be returned")
+		assert.Len(t, bodies, 1, "Expected no retry")
+	})
+
+	t.Run("WhenPostGetsBadGatewayExpectsNoRetry", func(t *testing.T) {
+		// arrange
+		var bodies []string
+		testServer := newFlappingServer(http.StatusBadGateway, 1, &bodies)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.StartWorkflow(models.NewPostWorkflow(models.PostWorkflowWorkflowTypePart, 1, 2))
+
+		// assert
+		assert.NotNil(t, err, "Expected the gateway error to be returned")
+		assert.Len(t, bodies, 1, "Expected no retry because the workflow may have been started")
+	})
+}
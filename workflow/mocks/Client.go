@@ -2,7 +2,12 @@ package mocks
 
 import "github.com/stretchr/testify/mock"
 
+import "context"
+import "encoding/json"
+import "io"
+import "time"
 import "github.com/3dsim/workflow-goclient/models"
+import "github.com/3dsim/workflow-goclient/workflow"
 
 type Client struct {
 	mock.Mock
@@ -29,6 +34,113 @@ func (_m *Client) StartWorkflow(_a0 *models.PostWorkflow) (string, error) {
 	return r0, r1
 }
 
+// StartWorkflowNoQueue provides a mock function with given fields: _a0
+func (_m *Client) StartWorkflowNoQueue(_a0 *models.PostWorkflow) (string, error) {
+	ret := _m.Called(_a0)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*models.PostWorkflow) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*models.PostWorkflow) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartWorkflowIfNotExists provides a mock function with given fields: _a0
+func (_m *Client) StartWorkflowIfNotExists(_a0 *models.PostWorkflow) (string, bool, error) {
+	ret := _m.Called(_a0)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(*models.PostWorkflow) string); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(*models.PostWorkflow) bool); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*models.PostWorkflow) error); ok {
+		r2 = rf(_a0)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CloneWorkflow provides a mock function with given fields: sourceWorkflowID, overrides
+func (_m *Client) CloneWorkflow(sourceWorkflowID string, overrides *models.PostWorkflow) (string, error) {
+	ret := _m.Called(sourceWorkflowID, overrides)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, *models.PostWorkflow) string); ok {
+		r0 = rf(sourceWorkflowID, overrides)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *models.PostWorkflow) error); ok {
+		r1 = rf(sourceWorkflowID, overrides)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWorkflowParameters provides a mock function with given fields: workflowID
+func (_m *Client) GetWorkflowParameters(workflowID string) (*models.PostWorkflow, error) {
+	ret := _m.Called(workflowID)
+
+	var r0 *models.PostWorkflow
+	if rf, ok := ret.Get(0).(func(string) *models.PostWorkflow); ok {
+		r0 = rf(workflowID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.PostWorkflow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workflowID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ValidateWorkflow provides a mock function with given fields: _a0
+func (_m *Client) ValidateWorkflow(_a0 *models.PostWorkflow) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.PostWorkflow) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CancelWorkflow provides a mock function with given fields: workflowID
 func (_m *Client) CancelWorkflow(workflowID string) error {
 	ret := _m.Called(workflowID)
@@ -43,22 +155,50 @@ func (_m *Client) CancelWorkflow(workflowID string) error {
 	return r0
 }
 
-// UpdateActivity provides a mock function with given fields: workflowID, activity
-func (_m *Client) UpdateActivity(workflowID string, activity *models.Activity) (*models.Activity, error) {
-	ret := _m.Called(workflowID, activity)
+// TransferWorkflow provides a mock function with given fields: workflowID, newOrganizationID
+func (_m *Client) TransferWorkflow(workflowID string, newOrganizationID int32) error {
+	ret := _m.Called(workflowID, newOrganizationID)
 
-	var r0 *models.Activity
-	if rf, ok := ret.Get(0).(func(string, *models.Activity) *models.Activity); ok {
-		r0 = rf(workflowID, activity)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, int32) error); ok {
+		r0 = rf(workflowID, newOrganizationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateWorkflowMetadata provides a mock function with given fields: workflowID, description, metadata
+func (_m *Client) UpdateWorkflowMetadata(workflowID string, description string, metadata map[string]string) error {
+	ret := _m.Called(workflowID, description, metadata)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, map[string]string) error); ok {
+		r0 = rf(workflowID, description, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetWorkflow provides a mock function with given fields: workflowID
+func (_m *Client) GetWorkflow(workflowID string) (*models.Workflow, error) {
+	ret := _m.Called(workflowID)
+
+	var r0 *models.Workflow
+	if rf, ok := ret.Get(0).(func(string) *models.Workflow); ok {
+		r0 = rf(workflowID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Activity)
+			r0 = ret.Get(0).(*models.Workflow)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, *models.Activity) error); ok {
-		r1 = rf(workflowID, activity)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workflowID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -66,22 +206,22 @@ func (_m *Client) UpdateActivity(workflowID string, activity *models.Activity) (
 	return r0, r1
 }
 
-// UpdateActivityPercentComplete provides a mock function with given fields: workflowID, activityID, percentComplete
-func (_m *Client) UpdateActivityPercentComplete(workflowID string, activityID string, percentComplete int) (*models.Activity, error) {
-	ret := _m.Called(workflowID, activityID, percentComplete)
+// GetWorkflowByExternalID provides a mock function with given fields: externalID, organizationID
+func (_m *Client) GetWorkflowByExternalID(externalID string, organizationID int32) (*models.Workflow, error) {
+	ret := _m.Called(externalID, organizationID)
 
-	var r0 *models.Activity
-	if rf, ok := ret.Get(0).(func(string, string, int) *models.Activity); ok {
-		r0 = rf(workflowID, activityID, percentComplete)
+	var r0 *models.Workflow
+	if rf, ok := ret.Get(0).(func(string, int32) *models.Workflow); ok {
+		r0 = rf(externalID, organizationID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Activity)
+			r0 = ret.Get(0).(*models.Workflow)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, int) error); ok {
-		r1 = rf(workflowID, activityID, percentComplete)
+	if rf, ok := ret.Get(1).(func(string, int32) error); ok {
+		r1 = rf(externalID, organizationID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -89,22 +229,22 @@ func (_m *Client) UpdateActivityPercentComplete(workflowID string, activityID st
 	return r0, r1
 }
 
-// CompleteSuccessfulActivity provides a mock function with given fields: workflowID, activityID, result
-func (_m *Client) CompleteSuccessfulActivity(workflowID string, activityID string, result interface{}) (*models.Activity, error) {
-	ret := _m.Called(workflowID, activityID, result)
+// GetChildWorkflows provides a mock function with given fields: parentWorkflowID
+func (_m *Client) GetChildWorkflows(parentWorkflowID string) ([]*models.Workflow, error) {
+	ret := _m.Called(parentWorkflowID)
 
-	var r0 *models.Activity
-	if rf, ok := ret.Get(0).(func(string, string, interface{}) *models.Activity); ok {
-		r0 = rf(workflowID, activityID, result)
+	var r0 []*models.Workflow
+	if rf, ok := ret.Get(0).(func(string) []*models.Workflow); ok {
+		r0 = rf(parentWorkflowID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Activity)
+			r0 = ret.Get(0).([]*models.Workflow)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, interface{}) error); ok {
-		r1 = rf(workflowID, activityID, result)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(parentWorkflowID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -112,22 +252,22 @@ func (_m *Client) CompleteSuccessfulActivity(workflowID string, activityID strin
 	return r0, r1
 }
 
-// CompleteCancelledActivity provides a mock function with given fields: workflowID, activityID, reason, details
-func (_m *Client) CompleteCancelledActivity(workflowID string, activityID string, reason string, details string) (*models.Activity, error) {
-	ret := _m.Called(workflowID, activityID, reason, details)
+// GetWorkflowHistory provides a mock function with given fields: workflowID
+func (_m *Client) GetWorkflowHistory(workflowID string) ([]*models.HistoryEvent, error) {
+	ret := _m.Called(workflowID)
 
-	var r0 *models.Activity
-	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.Activity); ok {
-		r0 = rf(workflowID, activityID, reason, details)
+	var r0 []*models.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string) []*models.HistoryEvent); ok {
+		r0 = rf(workflowID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Activity)
+			r0 = ret.Get(0).([]*models.HistoryEvent)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
-		r1 = rf(workflowID, activityID, reason, details)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workflowID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -135,22 +275,66 @@ func (_m *Client) CompleteCancelledActivity(workflowID string, activityID string
 	return r0, r1
 }
 
-// CompleteFailedActivity provides a mock function with given fields: workflowID, activityID, reason, details
-func (_m *Client) CompleteFailedActivity(workflowID string, activityID string, reason string, details string) (*models.Activity, error) {
-	ret := _m.Called(workflowID, activityID, reason, details)
+// ListWorkflowHistoryPage provides a mock function with given fields: workflowID, cursor, limit
+func (_m *Client) ListWorkflowHistoryPage(workflowID string, cursor string, limit int) ([]*models.HistoryEvent, string, error) {
+	ret := _m.Called(workflowID, cursor, limit)
 
-	var r0 *models.Activity
-	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.Activity); ok {
-		r0 = rf(workflowID, activityID, reason, details)
+	var r0 []*models.HistoryEvent
+	if rf, ok := ret.Get(0).(func(string, string, int) []*models.HistoryEvent); ok {
+		r0 = rf(workflowID, cursor, limit)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Activity)
+			r0 = ret.Get(0).([]*models.HistoryEvent)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string, string, int) string); ok {
+		r1 = rf(workflowID, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, int) error); ok {
+		r2 = rf(workflowID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ForEachHistoryEvent provides a mock function with given fields: workflowID, filter, fn
+func (_m *Client) ForEachHistoryEvent(workflowID string, filter workflow.HistoryFilter, fn func(*models.HistoryEvent) error) error {
+	ret := _m.Called(workflowID, filter, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, workflow.HistoryFilter, func(*models.HistoryEvent) error) error); ok {
+		r0 = rf(workflowID, filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportWorkflow provides a mock function with given fields: workflowID
+func (_m *Client) ExportWorkflow(workflowID string) (*models.WorkflowBundle, error) {
+	ret := _m.Called(workflowID)
+
+	var r0 *models.WorkflowBundle
+	if rf, ok := ret.Get(0).(func(string) *models.WorkflowBundle); ok {
+		r0 = rf(workflowID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WorkflowBundle)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
-		r1 = rf(workflowID, activityID, reason, details)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workflowID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -158,22 +342,50 @@ func (_m *Client) CompleteFailedActivity(workflowID string, activityID string, r
 	return r0, r1
 }
 
-// HeartbeatActivity provides a mock function with given fields: workflowID, activityID
-func (_m *Client) HeartbeatActivity(workflowID string, activityID string) (*models.Heartbeat, error) {
-	ret := _m.Called(workflowID, activityID)
+// SignalWorkflow provides a mock function with given fields: workflowID, signal
+func (_m *Client) SignalWorkflow(workflowID string, signal *models.Signal) error {
+	ret := _m.Called(workflowID, signal)
 
-	var r0 *models.Heartbeat
-	if rf, ok := ret.Get(0).(func(string, string) *models.Heartbeat); ok {
-		r0 = rf(workflowID, activityID)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *models.Signal) error); ok {
+		r0 = rf(workflowID, signal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SignalWorkflowIfRunning provides a mock function with given fields: workflowID, signal
+func (_m *Client) SignalWorkflowIfRunning(workflowID string, signal *models.Signal) error {
+	ret := _m.Called(workflowID, signal)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *models.Signal) error); ok {
+		r0 = rf(workflowID, signal)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetSignalStatus provides a mock function with given fields: workflowID, signalName
+func (_m *Client) GetSignalStatus(workflowID string, signalName string) (*models.SignalStatus, error) {
+	ret := _m.Called(workflowID, signalName)
+
+	var r0 *models.SignalStatus
+	if rf, ok := ret.Get(0).(func(string, string) *models.SignalStatus); ok {
+		r0 = rf(workflowID, signalName)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Heartbeat)
+			r0 = ret.Get(0).(*models.SignalStatus)
 		}
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(string, string) error); ok {
-		r1 = rf(workflowID, activityID)
+		r1 = rf(workflowID, signalName)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -181,25 +393,955 @@ func (_m *Client) HeartbeatActivity(workflowID string, activityID string) (*mode
 	return r0, r1
 }
 
-// HeartbeatActivityWithToken provides a mock function with given fields: taskToken, activityID, details
-func (_m *Client) HeartbeatActivityWithToken(taskToken string, activityID string, details string) (*models.Heartbeat, error) {
-	ret := _m.Called(taskToken, activityID, details)
+// RegisterWebhook provides a mock function with given fields: workflowID, callbackURL, events
+func (_m *Client) RegisterWebhook(workflowID string, callbackURL string, events []string) (string, error) {
+	ret := _m.Called(workflowID, callbackURL, events)
 
-	var r0 *models.Heartbeat
-	if rf, ok := ret.Get(0).(func(string, string, string) *models.Heartbeat); ok {
-		r0 = rf(taskToken, activityID, details)
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string, []string) string); ok {
+		r0 = rf(workflowID, callbackURL, events)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, []string) error); ok {
+		r1 = rf(workflowID, callbackURL, events)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UnregisterWebhook provides a mock function with given fields: webhookID
+func (_m *Client) UnregisterWebhook(webhookID string) error {
+	ret := _m.Called(webhookID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(webhookID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WorkflowRaw provides a mock function with given fields: workflowID
+func (_m *Client) WorkflowRaw(workflowID string) (json.RawMessage, error) {
+	ret := _m.Called(workflowID)
+
+	var r0 json.RawMessage
+	if rf, ok := ret.Get(0).(func(string) json.RawMessage); ok {
+		r0 = rf(workflowID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*models.Heartbeat)
+			r0 = ret.Get(0).(json.RawMessage)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
-		r1 = rf(taskToken, activityID, details)
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workflowID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateActivity provides a mock function with given fields: workflowID, activity
+func (_m *Client) UpdateActivity(workflowID string, activity *models.Activity) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activity)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, *models.Activity) *models.Activity); ok {
+		r0 = rf(workflowID, activity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, *models.Activity) error); ok {
+		r1 = rf(workflowID, activity)
 	} else {
 		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
+
+// UpdateActivityPercentComplete provides a mock function with given fields: workflowID, activityID, percentComplete
+func (_m *Client) UpdateActivityPercentComplete(workflowID string, activityID string, percentComplete int) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activityID, percentComplete)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, string, int) *models.Activity); ok {
+		r0 = rf(workflowID, activityID, percentComplete)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, int) error); ok {
+		r1 = rf(workflowID, activityID, percentComplete)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateActivitiesPercentComplete provides a mock function with given fields: workflowID, progress
+func (_m *Client) UpdateActivitiesPercentComplete(workflowID string, progress map[string]int) ([]*models.Activity, []error) {
+	ret := _m.Called(workflowID, progress)
+
+	var r0 []*models.Activity
+	if rf, ok := ret.Get(0).(func(string, map[string]int) []*models.Activity); ok {
+		r0 = rf(workflowID, progress)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Activity)
+		}
+	}
+
+	var r1 []error
+	if rf, ok := ret.Get(1).(func(string, map[string]int) []error); ok {
+		r1 = rf(workflowID, progress)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]error)
+		}
+	}
+
+	return r0, r1
+}
+
+// PatchActivity provides a mock function with given fields: workflowID, activityID, fields
+func (_m *Client) PatchActivity(workflowID string, activityID string, fields map[string]interface{}) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activityID, fields)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, string, map[string]interface{}) *models.Activity); ok {
+		r0 = rf(workflowID, activityID, fields)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, map[string]interface{}) error); ok {
+		r1 = rf(workflowID, activityID, fields)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteSuccessfulActivity provides a mock function with given fields: workflowID, activityID, result
+func (_m *Client) CompleteSuccessfulActivity(workflowID string, activityID string, result interface{}) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activityID, result)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, string, interface{}) *models.Activity); ok {
+		r0 = rf(workflowID, activityID, result)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, interface{}) error); ok {
+		r1 = rf(workflowID, activityID, result)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteSuccessfulActivityStream provides a mock function with given fields: workflowID, activityID, r
+func (_m *Client) CompleteSuccessfulActivityStream(workflowID string, activityID string, r io.Reader) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activityID, r)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, string, io.Reader) *models.Activity); ok {
+		r0 = rf(workflowID, activityID, r)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, io.Reader) error); ok {
+		r1 = rf(workflowID, activityID, r)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteCancelledActivity provides a mock function with given fields: workflowID, activityID, reason, details
+func (_m *Client) CompleteCancelledActivity(workflowID string, activityID string, reason string, details string) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activityID, reason, details)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.Activity); ok {
+		r0 = rf(workflowID, activityID, reason, details)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(workflowID, activityID, reason, details)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteCancelledActivityWithResult provides a mock function with given fields: workflowID, activityID, reason, details, partialResult
+func (_m *Client) CompleteCancelledActivityWithResult(workflowID string, activityID string, reason string, details string, partialResult interface{}) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activityID, reason, details, partialResult)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, string, string, string, interface{}) *models.Activity); ok {
+		r0 = rf(workflowID, activityID, reason, details, partialResult)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, string, interface{}) error); ok {
+		r1 = rf(workflowID, activityID, reason, details, partialResult)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteFailedActivity provides a mock function with given fields: workflowID, activityID, reason, details
+func (_m *Client) CompleteFailedActivity(workflowID string, activityID string, reason string, details string) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activityID, reason, details)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.Activity); ok {
+		r0 = rf(workflowID, activityID, reason, details)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(workflowID, activityID, reason, details)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteFailedActivityNonBlocking provides a mock function with given fields: workflowID, activityID, reason, details
+func (_m *Client) CompleteFailedActivityNonBlocking(workflowID string, activityID string, reason string, details string) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activityID, reason, details)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, string, string, string) *models.Activity); ok {
+		r0 = rf(workflowID, activityID, reason, details)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string, string) error); ok {
+		r1 = rf(workflowID, activityID, reason, details)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CompleteActivity provides a mock function with given fields: workflowID, activityID, outcome
+func (_m *Client) CompleteActivity(workflowID string, activityID string, outcome workflow.ActivityOutcome) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activityID, outcome)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, string, workflow.ActivityOutcome) *models.Activity); ok {
+		r0 = rf(workflowID, activityID, outcome)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, workflow.ActivityOutcome) error); ok {
+		r1 = rf(workflowID, activityID, outcome)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RetryActivity provides a mock function with given fields: workflowID, activityID
+func (_m *Client) RetryActivity(workflowID string, activityID string) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activityID)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, string) *models.Activity); ok {
+		r0 = rf(workflowID, activityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(workflowID, activityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HeartbeatActivity provides a mock function with given fields: workflowID, activityID
+func (_m *Client) HeartbeatActivity(workflowID string, activityID string) (*models.Heartbeat, error) {
+	ret := _m.Called(workflowID, activityID)
+
+	var r0 *models.Heartbeat
+	if rf, ok := ret.Get(0).(func(string, string) *models.Heartbeat); ok {
+		r0 = rf(workflowID, activityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Heartbeat)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(workflowID, activityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AppendActivityLogs provides a mock function with given fields: workflowID, activityID, lines
+func (_m *Client) AppendActivityLogs(workflowID string, activityID string, lines []*models.LogLine) error {
+	ret := _m.Called(workflowID, activityID, lines)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, []*models.LogLine) error); ok {
+		r0 = rf(workflowID, activityID, lines)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetActivityLogs provides a mock function with given fields: workflowID, activityID, since
+func (_m *Client) GetActivityLogs(workflowID string, activityID string, since time.Time) ([]*models.LogLine, error) {
+	ret := _m.Called(workflowID, activityID, since)
+
+	var r0 []*models.LogLine
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) []*models.LogLine); ok {
+		r0 = rf(workflowID, activityID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.LogLine)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
+		r1 = rf(workflowID, activityID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DownloadActivityResult provides a mock function with given fields: activity, w
+func (_m *Client) DownloadActivityResult(activity *models.Activity, w io.Writer) error {
+	ret := _m.Called(activity, w)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Activity, io.Writer) error); ok {
+		r0 = rf(activity, w)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// HeartbeatActivityWithToken provides a mock function with given fields: taskToken, activityID, details
+func (_m *Client) HeartbeatActivityWithToken(taskToken string, activityID string, details string) (*models.Heartbeat, error) {
+	ret := _m.Called(taskToken, activityID, details)
+
+	var r0 *models.Heartbeat
+	if rf, ok := ret.Get(0).(func(string, string, string) *models.Heartbeat); ok {
+		r0 = rf(taskToken, activityID, details)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Heartbeat)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(taskToken, activityID, details)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AbandonActivity provides a mock function with given fields: taskToken, activityID, details
+func (_m *Client) AbandonActivity(taskToken string, activityID string, details string) error {
+	ret := _m.Called(taskToken, activityID, details)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(taskToken, activityID, details)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExtendHeartbeatTimeout provides a mock function with given fields: taskToken, activityID, extension
+func (_m *Client) ExtendHeartbeatTimeout(taskToken string, activityID string, extension time.Duration) (*models.Heartbeat, error) {
+	ret := _m.Called(taskToken, activityID, extension)
+
+	var r0 *models.Heartbeat
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration) *models.Heartbeat); ok {
+		r0 = rf(taskToken, activityID, extension)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Heartbeat)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string, time.Duration) error); ok {
+		r1 = rf(taskToken, activityID, extension)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsCancellationRequested provides a mock function with given fields: workflowID, activityID
+func (_m *Client) IsCancellationRequested(workflowID string, activityID string) (bool, error) {
+	ret := _m.Called(workflowID, activityID)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(string, string) bool); ok {
+		r0 = rf(workflowID, activityID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(workflowID, activityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetActivityTaskToken provides a mock function with given fields: workflowID, activityID
+func (_m *Client) GetActivityTaskToken(workflowID string, activityID string) (string, error) {
+	ret := _m.Called(workflowID, activityID)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string, string) string); ok {
+		r0 = rf(workflowID, activityID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(workflowID, activityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LookupByTaskToken provides a mock function with given fields: taskToken
+func (_m *Client) LookupByTaskToken(taskToken string) (string, string, error) {
+	ret := _m.Called(taskToken)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(string) string); ok {
+		r0 = rf(taskToken)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string) string); ok {
+		r1 = rf(taskToken)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(taskToken)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// HeartbeatActivities provides a mock function with given fields: taskTokens
+func (_m *Client) HeartbeatActivities(taskTokens map[string]string) (map[string]*models.Heartbeat, error) {
+	ret := _m.Called(taskTokens)
+
+	var r0 map[string]*models.Heartbeat
+	if rf, ok := ret.Get(0).(func(map[string]string) map[string]*models.Heartbeat); ok {
+		r0 = rf(taskTokens)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*models.Heartbeat)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(map[string]string) error); ok {
+		r1 = rf(taskTokens)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetActivity provides a mock function with given fields: workflowID, activityID
+func (_m *Client) GetActivity(workflowID string, activityID string) (*models.Activity, error) {
+	ret := _m.Called(workflowID, activityID)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string, string) *models.Activity); ok {
+		r0 = rf(workflowID, activityID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(workflowID, activityID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListActivities provides a mock function with given fields: workflowID
+func (_m *Client) ListActivities(workflowID string) ([]*models.Activity, error) {
+	ret := _m.Called(workflowID)
+
+	var r0 []*models.Activity
+	if rf, ok := ret.Get(0).(func(string) []*models.Activity); ok {
+		r0 = rf(workflowID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workflowID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WorkflowProgress provides a mock function with given fields: workflowID
+func (_m *Client) WorkflowProgress(workflowID string) (int, error) {
+	ret := _m.Called(workflowID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(string) int); ok {
+		r0 = rf(workflowID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workflowID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CurrentActivity provides a mock function with given fields: workflowID
+func (_m *Client) CurrentActivity(workflowID string) (*models.Activity, error) {
+	ret := _m.Called(workflowID)
+
+	var r0 *models.Activity
+	if rf, ok := ret.Get(0).(func(string) *models.Activity); ok {
+		r0 = rf(workflowID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Activity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workflowID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListActivitiesPage provides a mock function with given fields: workflowID, cursor, limit
+func (_m *Client) ListActivitiesPage(workflowID string, cursor string, limit int) ([]*models.Activity, string, error) {
+	ret := _m.Called(workflowID, cursor, limit)
+
+	var r0 []*models.Activity
+	if rf, ok := ret.Get(0).(func(string, string, int) []*models.Activity); ok {
+		r0 = rf(workflowID, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Activity)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(string, string, int) string); ok {
+		r1 = rf(workflowID, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, int) error); ok {
+		r2 = rf(workflowID, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListWorkflows provides a mock function with given fields: filter
+func (_m *Client) ListWorkflows(filter workflow.WorkflowFilter) ([]*models.Workflow, error) {
+	ret := _m.Called(filter)
+
+	var r0 []*models.Workflow
+	if rf, ok := ret.Get(0).(func(workflow.WorkflowFilter) []*models.Workflow); ok {
+		r0 = rf(filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Workflow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(workflow.WorkflowFilter) error); ok {
+		r1 = rf(filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchWorkflows provides a mock function with given fields: query, organizationID
+func (_m *Client) SearchWorkflows(query string, organizationID int32) ([]*models.Workflow, error) {
+	ret := _m.Called(query, organizationID)
+
+	var r0 []*models.Workflow
+	if rf, ok := ret.Get(0).(func(string, int32) []*models.Workflow); ok {
+		r0 = rf(query, organizationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Workflow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, int32) error); ok {
+		r1 = rf(query, organizationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListWorkflowsPage provides a mock function with given fields: filter, cursor, limit
+func (_m *Client) ListWorkflowsPage(filter workflow.WorkflowFilter, cursor string, limit int) ([]*models.Workflow, string, error) {
+	ret := _m.Called(filter, cursor, limit)
+
+	var r0 []*models.Workflow
+	if rf, ok := ret.Get(0).(func(workflow.WorkflowFilter, string, int) []*models.Workflow); ok {
+		r0 = rf(filter, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*models.Workflow)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(workflow.WorkflowFilter, string, int) string); ok {
+		r1 = rf(filter, cursor, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(workflow.WorkflowFilter, string, int) error); ok {
+		r2 = rf(filter, cursor, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ForEachWorkflow provides a mock function with given fields: filter, fn
+func (_m *Client) ForEachWorkflow(filter workflow.WorkflowFilter, fn func(*models.Workflow) error) error {
+	ret := _m.Called(filter, fn)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(workflow.WorkflowFilter, func(*models.Workflow) error) error); ok {
+		r0 = rf(filter, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CancelWorkflowsForEntity provides a mock function with given fields: entityID, organizationID
+func (_m *Client) CancelWorkflowsForEntity(entityID int32, organizationID int32) ([]string, error) {
+	ret := _m.Called(entityID, organizationID)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(int32, int32) []string); ok {
+		r0 = rf(entityID, organizationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, int32) error); ok {
+		r1 = rf(entityID, organizationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecentRequests provides a mock function with given fields:
+func (_m *Client) RecentRequests() []workflow.RecordedRequest {
+	ret := _m.Called()
+
+	var r0 []workflow.RecordedRequest
+	if rf, ok := ret.Get(0).(func() []workflow.RecordedRequest); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]workflow.RecordedRequest)
+		}
+	}
+
+	return r0
+}
+
+// GetWorkflowType provides a mock function with given fields: workflowType
+func (_m *Client) GetWorkflowType(workflowType string) (*models.WorkflowTypeInfo, error) {
+	ret := _m.Called(workflowType)
+
+	var r0 *models.WorkflowTypeInfo
+	if rf, ok := ret.Get(0).(func(string) *models.WorkflowTypeInfo); ok {
+		r0 = rf(workflowType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.WorkflowTypeInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(workflowType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WatchCapacity provides a mock function with given fields: ctx, organizationID
+func (_m *Client) WatchCapacity(ctx context.Context, organizationID int32) (<-chan *models.CapacityEvent, error) {
+	ret := _m.Called(ctx, organizationID)
+
+	var r0 <-chan *models.CapacityEvent
+	if rf, ok := ret.Get(0).(func(context.Context, int32) <-chan *models.CapacityEvent); ok {
+		r0 = rf(ctx, organizationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan *models.CapacityEvent)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int32) error); ok {
+		r1 = rf(ctx, organizationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WaitForState provides a mock function with given fields: ctx, workflowID, target, pollInterval
+func (_m *Client) WaitForState(ctx context.Context, workflowID string, target string, pollInterval time.Duration) (*models.Workflow, error) {
+	ret := _m.Called(ctx, workflowID, target, pollInterval)
+
+	var r0 *models.Workflow
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) *models.Workflow); ok {
+		r0 = rf(ctx, workflowID, target, pollInterval)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Workflow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, workflowID, target, pollInterval)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// APIGatewayURL provides a mock function with given fields:
+func (_m *Client) APIGatewayURL() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// APIBasePath provides a mock function with given fields:
+func (_m *Client) APIBasePath() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// Audience provides a mock function with given fields:
+func (_m *Client) Audience() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// WithContext provides a mock function with given fields: ctx
+func (_m *Client) WithContext(ctx context.Context) workflow.Client {
+	ret := _m.Called(ctx)
+
+	var r0 workflow.Client
+	if rf, ok := ret.Get(0).(func(context.Context) workflow.Client); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(workflow.Client)
+		}
+	}
+
+	return r0
+}
+
+// Close provides a mock function with given fields:
+func (_m *Client) Close() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
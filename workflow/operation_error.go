@@ -0,0 +1,364 @@
+package workflow
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"time"
+
+	"github.com/3dsim/workflow-goclient/models"
+)
+
+// OperationError is returned by the methods of a client created with WithOperationErrors.  It names the method that
+// failed and holds the error the method returned.
+type OperationError struct {
+	// Op is the name of the Client method that failed, e.g. GetWorkflow
+	Op  string
+	Err error
+}
+
+func (e *OperationError) Error() string {
+	return e.Op + ": " + e.Err.Error()
+}
+
+// Unwrap returns the error returned by the method so errors.Unwrap and errors.As find it
+func (e *OperationError) Unwrap() error {
+	return e.Err
+}
+
+// Cause returns the error returned by the method like Unwrap, for github.com/pkg/errors
+func (e *OperationError) Cause() error {
+	return e.Err
+}
+
+// wrapOperationError returns err in an *OperationError for op, or nil if err is nil
+func wrapOperationError(op string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &OperationError{Op: op, Err: err}
+}
+
+// operationErrorClient is the Client returned when WithOperationErrors is given.  It wraps the errors returned by next in
+// an *OperationError, the calls next makes to itself (e.g. CloneWorkflow starting the workflow) are not wrapped again.
+type operationErrorClient struct {
+	next Client
+}
+
+func (c *operationErrorClient) StartWorkflow(workflow *models.PostWorkflow) (string, error) {
+	result, err := c.next.StartWorkflow(workflow)
+	return result, wrapOperationError("StartWorkflow", err)
+}
+
+func (c *operationErrorClient) StartWorkflowNoQueue(workflow *models.PostWorkflow) (string, error) {
+	result, err := c.next.StartWorkflowNoQueue(workflow)
+	return result, wrapOperationError("StartWorkflowNoQueue", err)
+}
+
+func (c *operationErrorClient) StartWorkflowIfNotExists(workflow *models.PostWorkflow) (string, bool, error) {
+	workflowID, created, err := c.next.StartWorkflowIfNotExists(workflow)
+	return workflowID, created, wrapOperationError("StartWorkflowIfNotExists", err)
+}
+
+func (c *operationErrorClient) CloneWorkflow(sourceWorkflowID string, overrides *models.PostWorkflow) (string, error) {
+	result, err := c.next.CloneWorkflow(sourceWorkflowID, overrides)
+	return result, wrapOperationError("CloneWorkflow", err)
+}
+
+func (c *operationErrorClient) GetWorkflowParameters(workflowID string) (*models.PostWorkflow, error) {
+	result, err := c.next.GetWorkflowParameters(workflowID)
+	return result, wrapOperationError("GetWorkflowParameters", err)
+}
+
+func (c *operationErrorClient) ValidateWorkflow(workflow *models.PostWorkflow) error {
+	err := c.next.ValidateWorkflow(workflow)
+	return wrapOperationError("ValidateWorkflow", err)
+}
+
+func (c *operationErrorClient) CancelWorkflow(workflowID string) error {
+	err := c.next.CancelWorkflow(workflowID)
+	return wrapOperationError("CancelWorkflow", err)
+}
+
+func (c *operationErrorClient) TransferWorkflow(workflowID string, newOrganizationID int32) error {
+	err := c.next.TransferWorkflow(workflowID, newOrganizationID)
+	return wrapOperationError("TransferWorkflow", err)
+}
+
+func (c *operationErrorClient) UpdateWorkflowMetadata(workflowID string, description string, metadata map[string]string) error {
+	err := c.next.UpdateWorkflowMetadata(workflowID, description, metadata)
+	return wrapOperationError("UpdateWorkflowMetadata", err)
+}
+
+func (c *operationErrorClient) GetWorkflow(workflowID string) (*models.Workflow, error) {
+	result, err := c.next.GetWorkflow(workflowID)
+	return result, wrapOperationError("GetWorkflow", err)
+}
+
+func (c *operationErrorClient) GetWorkflowByExternalID(externalID string, organizationID int32) (*models.Workflow, error) {
+	result, err := c.next.GetWorkflowByExternalID(externalID, organizationID)
+	return result, wrapOperationError("GetWorkflowByExternalID", err)
+}
+
+func (c *operationErrorClient) GetChildWorkflows(parentWorkflowID string) ([]*models.Workflow, error) {
+	result, err := c.next.GetChildWorkflows(parentWorkflowID)
+	return result, wrapOperationError("GetChildWorkflows", err)
+}
+
+func (c *operationErrorClient) GetWorkflowHistory(workflowID string) ([]*models.HistoryEvent, error) {
+	result, err := c.next.GetWorkflowHistory(workflowID)
+	return result, wrapOperationError("GetWorkflowHistory", err)
+}
+
+func (c *operationErrorClient) ListWorkflowHistoryPage(workflowID, cursor string, limit int) ([]*models.HistoryEvent, string, error) {
+	events, nextCursor, err := c.next.ListWorkflowHistoryPage(workflowID, cursor, limit)
+	return events, nextCursor, wrapOperationError("ListWorkflowHistoryPage", err)
+}
+
+func (c *operationErrorClient) ForEachHistoryEvent(workflowID string, filter HistoryFilter, fn func(*models.HistoryEvent) error) error {
+	err := c.next.ForEachHistoryEvent(workflowID, filter, fn)
+	return wrapOperationError("ForEachHistoryEvent", err)
+}
+
+func (c *operationErrorClient) ExportWorkflow(workflowID string) (*models.WorkflowBundle, error) {
+	result, err := c.next.ExportWorkflow(workflowID)
+	return result, wrapOperationError("ExportWorkflow", err)
+}
+
+func (c *operationErrorClient) SignalWorkflow(workflowID string, signal *models.Signal) error {
+	err := c.next.SignalWorkflow(workflowID, signal)
+	return wrapOperationError("SignalWorkflow", err)
+}
+
+func (c *operationErrorClient) SignalWorkflowIfRunning(workflowID string, signal *models.Signal) error {
+	err := c.next.SignalWorkflowIfRunning(workflowID, signal)
+	return wrapOperationError("SignalWorkflowIfRunning", err)
+}
+
+func (c *operationErrorClient) GetSignalStatus(workflowID, signalName string) (*models.SignalStatus, error) {
+	result, err := c.next.GetSignalStatus(workflowID, signalName)
+	return result, wrapOperationError("GetSignalStatus", err)
+}
+
+func (c *operationErrorClient) RegisterWebhook(workflowID, callbackURL string, events []string) (string, error) {
+	webhookID, err := c.next.RegisterWebhook(workflowID, callbackURL, events)
+	return webhookID, wrapOperationError("RegisterWebhook", err)
+}
+
+func (c *operationErrorClient) UnregisterWebhook(webhookID string) error {
+	err := c.next.UnregisterWebhook(webhookID)
+	return wrapOperationError("UnregisterWebhook", err)
+}
+
+func (c *operationErrorClient) WorkflowRaw(workflowID string) (json.RawMessage, error) {
+	result, err := c.next.WorkflowRaw(workflowID)
+	return result, wrapOperationError("WorkflowRaw", err)
+}
+
+func (c *operationErrorClient) UpdateActivity(workflowID string, activity *models.Activity) (*models.Activity, error) {
+	result, err := c.next.UpdateActivity(workflowID, activity)
+	return result, wrapOperationError("UpdateActivity", err)
+}
+
+func (c *operationErrorClient) UpdateActivityPercentComplete(workflowID, activityID string, percentComplete int) (*models.Activity, error) {
+	result, err := c.next.UpdateActivityPercentComplete(workflowID, activityID, percentComplete)
+	return result, wrapOperationError("UpdateActivityPercentComplete", err)
+}
+
+func (c *operationErrorClient) UpdateActivitiesPercentComplete(workflowID string, progress map[string]int) ([]*models.Activity, []error) {
+	activities, errs := c.next.UpdateActivitiesPercentComplete(workflowID, progress)
+	for i, err := range errs {
+		errs[i] = wrapOperationError("UpdateActivitiesPercentComplete", err)
+	}
+	return activities, errs
+}
+
+func (c *operationErrorClient) PatchActivity(workflowID, activityID string, fields map[string]interface{}) (*models.Activity, error) {
+	result, err := c.next.PatchActivity(workflowID, activityID, fields)
+	return result, wrapOperationError("PatchActivity", err)
+}
+
+func (c *operationErrorClient) CompleteSuccessfulActivity(workflowID, activityID string, result interface{}) (*models.Activity, error) {
+	activity, err := c.next.CompleteSuccessfulActivity(workflowID, activityID, result)
+	return activity, wrapOperationError("CompleteSuccessfulActivity", err)
+}
+
+func (c *operationErrorClient) CompleteSuccessfulActivityStream(workflowID, activityID string, r io.Reader) (*models.Activity, error) {
+	result, err := c.next.CompleteSuccessfulActivityStream(workflowID, activityID, r)
+	return result, wrapOperationError("CompleteSuccessfulActivityStream", err)
+}
+
+func (c *operationErrorClient) CompleteCancelledActivity(workflowID, activityID, reason, details string) (*models.Activity, error) {
+	result, err := c.next.CompleteCancelledActivity(workflowID, activityID, reason, details)
+	return result, wrapOperationError("CompleteCancelledActivity", err)
+}
+
+func (c *operationErrorClient) CompleteCancelledActivityWithResult(workflowID, activityID, reason, details string, partialResult interface{}) (*models.Activity, error) {
+	result, err := c.next.CompleteCancelledActivityWithResult(workflowID, activityID, reason, details, partialResult)
+	return result, wrapOperationError("CompleteCancelledActivityWithResult", err)
+}
+
+func (c *operationErrorClient) CompleteFailedActivity(workflowID, activityID, reason, details string) (*models.Activity, error) {
+	result, err := c.next.CompleteFailedActivity(workflowID, activityID, reason, details)
+	return result, wrapOperationError("CompleteFailedActivity", err)
+}
+
+func (c *operationErrorClient) CompleteFailedActivityNonBlocking(workflowID, activityID, reason, details string) (*models.Activity, error) {
+	result, err := c.next.CompleteFailedActivityNonBlocking(workflowID, activityID, reason, details)
+	return result, wrapOperationError("CompleteFailedActivityNonBlocking", err)
+}
+
+func (c *operationErrorClient) CompleteActivity(workflowID, activityID string, outcome ActivityOutcome) (*models.Activity, error) {
+	result, err := c.next.CompleteActivity(workflowID, activityID, outcome)
+	return result, wrapOperationError("CompleteActivity", err)
+}
+
+func (c *operationErrorClient) RetryActivity(workflowID, activityID string) (*models.Activity, error) {
+	result, err := c.next.RetryActivity(workflowID, activityID)
+	return result, wrapOperationError("RetryActivity", err)
+}
+
+func (c *operationErrorClient) HeartbeatActivity(workflowID, activityID string) (*models.Heartbeat, error) {
+	result, err := c.next.HeartbeatActivity(workflowID, activityID)
+	return result, wrapOperationError("HeartbeatActivity", err)
+}
+
+func (c *operationErrorClient) AppendActivityLogs(workflowID, activityID string, lines []*models.LogLine) error {
+	err := c.next.AppendActivityLogs(workflowID, activityID, lines)
+	return wrapOperationError("AppendActivityLogs", err)
+}
+
+func (c *operationErrorClient) GetActivityLogs(workflowID, activityID string, since time.Time) ([]*models.LogLine, error) {
+	result, err := c.next.GetActivityLogs(workflowID, activityID, since)
+	return result, wrapOperationError("GetActivityLogs", err)
+}
+
+func (c *operationErrorClient) DownloadActivityResult(activity *models.Activity, w io.Writer) error {
+	err := c.next.DownloadActivityResult(activity, w)
+	return wrapOperationError("DownloadActivityResult", err)
+}
+
+func (c *operationErrorClient) HeartbeatActivityWithToken(taskToken, activityID, details string) (*models.Heartbeat, error) {
+	result, err := c.next.HeartbeatActivityWithToken(taskToken, activityID, details)
+	return result, wrapOperationError("HeartbeatActivityWithToken", err)
+}
+
+func (c *operationErrorClient) AbandonActivity(taskToken, activityID, details string) error {
+	err := c.next.AbandonActivity(taskToken, activityID, details)
+	return wrapOperationError("AbandonActivity", err)
+}
+
+func (c *operationErrorClient) ExtendHeartbeatTimeout(taskToken, activityID string, extension time.Duration) (*models.Heartbeat, error) {
+	result, err := c.next.ExtendHeartbeatTimeout(taskToken, activityID, extension)
+	return result, wrapOperationError("ExtendHeartbeatTimeout", err)
+}
+
+func (c *operationErrorClient) IsCancellationRequested(workflowID, activityID string) (bool, error) {
+	result, err := c.next.IsCancellationRequested(workflowID, activityID)
+	return result, wrapOperationError("IsCancellationRequested", err)
+}
+
+func (c *operationErrorClient) GetActivityTaskToken(workflowID, activityID string) (string, error) {
+	result, err := c.next.GetActivityTaskToken(workflowID, activityID)
+	return result, wrapOperationError("GetActivityTaskToken", err)
+}
+
+func (c *operationErrorClient) LookupByTaskToken(taskToken string) (string, string, error) {
+	workflowID, activityID, err := c.next.LookupByTaskToken(taskToken)
+	return workflowID, activityID, wrapOperationError("LookupByTaskToken", err)
+}
+
+func (c *operationErrorClient) HeartbeatActivities(taskTokens map[string]string) (map[string]*models.Heartbeat, error) {
+	result, err := c.next.HeartbeatActivities(taskTokens)
+	return result, wrapOperationError("HeartbeatActivities", err)
+}
+
+func (c *operationErrorClient) GetActivity(workflowID, activityID string) (*models.Activity, error) {
+	result, err := c.next.GetActivity(workflowID, activityID)
+	return result, wrapOperationError("GetActivity", err)
+}
+
+func (c *operationErrorClient) ListActivities(workflowID string) ([]*models.Activity, error) {
+	result, err := c.next.ListActivities(workflowID)
+	return result, wrapOperationError("ListActivities", err)
+}
+
+func (c *operationErrorClient) WorkflowProgress(workflowID string) (int, error) {
+	result, err := c.next.WorkflowProgress(workflowID)
+	return result, wrapOperationError("WorkflowProgress", err)
+}
+
+func (c *operationErrorClient) CurrentActivity(workflowID string) (*models.Activity, error) {
+	result, err := c.next.CurrentActivity(workflowID)
+	return result, wrapOperationError("CurrentActivity", err)
+}
+
+func (c *operationErrorClient) ListActivitiesPage(workflowID, cursor string, limit int) ([]*models.Activity, string, error) {
+	activities, nextCursor, err := c.next.ListActivitiesPage(workflowID, cursor, limit)
+	return activities, nextCursor, wrapOperationError("ListActivitiesPage", err)
+}
+
+func (c *operationErrorClient) ListWorkflows(filter WorkflowFilter) ([]*models.Workflow, error) {
+	result, err := c.next.ListWorkflows(filter)
+	return result, wrapOperationError("ListWorkflows", err)
+}
+
+func (c *operationErrorClient) SearchWorkflows(query string, organizationID int32) ([]*models.Workflow, error) {
+	result, err := c.next.SearchWorkflows(query, organizationID)
+	return result, wrapOperationError("SearchWorkflows", err)
+}
+
+func (c *operationErrorClient) ListWorkflowsPage(filter WorkflowFilter, cursor string, limit int) ([]*models.Workflow, string, error) {
+	workflows, nextCursor, err := c.next.ListWorkflowsPage(filter, cursor, limit)
+	return workflows, nextCursor, wrapOperationError("ListWorkflowsPage", err)
+}
+
+func (c *operationErrorClient) ForEachWorkflow(filter WorkflowFilter, fn func(*models.Workflow) error) error {
+	err := c.next.ForEachWorkflow(filter, fn)
+	return wrapOperationError("ForEachWorkflow", err)
+}
+
+func (c *operationErrorClient) CancelWorkflowsForEntity(entityID, organizationID int32) ([]string, error) {
+	result, err := c.next.CancelWorkflowsForEntity(entityID, organizationID)
+	return result, wrapOperationError("CancelWorkflowsForEntity", err)
+}
+
+func (c *operationErrorClient) RecentRequests() []RecordedRequest {
+	return c.next.RecentRequests()
+}
+
+func (c *operationErrorClient) GetWorkflowType(workflowType string) (*models.WorkflowTypeInfo, error) {
+	result, err := c.next.GetWorkflowType(workflowType)
+	return result, wrapOperationError("GetWorkflowType", err)
+}
+
+func (c *operationErrorClient) WatchCapacity(ctx context.Context, organizationID int32) (<-chan *models.CapacityEvent, error) {
+	result, err := c.next.WatchCapacity(ctx, organizationID)
+	return result, wrapOperationError("WatchCapacity", err)
+}
+
+func (c *operationErrorClient) WaitForState(ctx context.Context, workflowID, target string, pollInterval time.Duration) (*models.Workflow, error) {
+	result, err := c.next.WaitForState(ctx, workflowID, target, pollInterval)
+	return result, wrapOperationError("WaitForState", err)
+}
+
+func (c *operationErrorClient) APIGatewayURL() string {
+	return c.next.APIGatewayURL()
+}
+
+func (c *operationErrorClient) APIBasePath() string {
+	return c.next.APIBasePath()
+}
+
+func (c *operationErrorClient) Audience() string {
+	return c.next.Audience()
+}
+
+func (c *operationErrorClient) WithContext(ctx context.Context) Client {
+	return &operationErrorClient{next: c.next.WithContext(ctx)}
+}
+
+func (c *operationErrorClient) Close() error {
+	err := c.next.Close()
+	return wrapOperationError("Close", err)
+}
@@ -0,0 +1,305 @@
+package workflow
+
+import (
+	"crypto/tls"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/PuerkitoBio/rehttp"
+	log "github.com/inconshreveable/log15"
+	"golang.org/x/net/http2"
+)
+
+// Option configures optional behavior of the client returned by NewClient and NewClientWithRetry.
+type Option func(*options)
+
+type options struct {
+	retryCallback      RetryCallback
+	insecureSkipVerify bool
+	// strictPercentComplete rejects percent complete values outside of [0,100] instead of clamping them
+	strictPercentComplete bool
+	requestRecorderSize   int
+	gatewayRetries        int
+	tokenRetries          int
+	maxResponseSize       int64
+	// nilResult is the result sent when an activity is completed with a nil result
+	nilResult string
+	// operationErrors wraps the errors returned by the client in *OperationError
+	operationErrors bool
+	// organizationID is the organization set by WithOrganizationContext, 0 when the client is not bound to one
+	organizationID int32
+	// defaultOrganizationID is the organization set by WithDefaultOrganization, 0 when there is none
+	defaultOrganizationID int32
+	// connection pool settings of the http.Transport, 0 keeps the http.DefaultTransport setting
+	maxIdleConns        int
+	maxIdleConnsPerHost int
+	idleConnTimeout     time.Duration
+	// protocol is the HTTP protocol set by WithHTTPProtocol
+	protocol HTTPProtocol
+	// cassettePath is the cassette file set by WithCassette, "" when interactions are not recorded
+	cassettePath string
+	// rateLimits holds the limits set by WithRateLimit by lower case operation ID
+	rateLimits        map[string]rateLimit
+	rateLimitFailFast bool
+	// recorder is created by buildTransport when requestRecorderSize > 0
+	recorder *requestRecorder
+	// transport is the http.Transport created by buildTransport, nil when http.DefaultTransport is used
+	transport *http.Transport
+	// logger is the logger of the client, set by newClient once the transport is built
+	logger log.Logger
+	// closed is set to 1 by Client.Close.  It lives here so the copies made by Client.WithContext see it.
+	closed int32
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{gatewayRetries: defaultGatewayRetries, tokenRetries: defaultTokenRetries, maxResponseSize: defaultMaxResponseSize}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// RetryCallback is called before a request is retried.  attempt is the number of the retry about to be made (starting
+// at 1).  resp and err are the outcome of the previous attempt that caused the retry, either one may be nil.  The
+// response body must not be read.
+type RetryCallback func(attempt int, req *http.Request, resp *http.Response, err error)
+
+// WithRetryCallback registers a callback that is invoked before each retry made by a client created with
+// NewClientWithRetry.  Use it to log or count retries.  Clients without retry never call it.  A panic of the callback
+// is logged and does not stop the retry.
+func WithRetryCallback(callback RetryCallback) Option {
+	return func(o *options) {
+		o.retryCallback = callback
+	}
+}
+
+// retryFn wraps the given retry decision so that the retry callback, if any, is told about every retry
+func (o *options) retryFn(retry rehttp.RetryFn) rehttp.RetryFn {
+	if o.retryCallback == nil {
+		return retry
+	}
+	return func(attempt rehttp.Attempt) bool {
+		if !retry(attempt) {
+			return false
+		}
+		o.callRetryCallback(attempt)
+		return true
+	}
+}
+
+// callRetryCallback calls the retry callback for attempt.  A panic of the callback is logged and swallowed so that a
+// broken metrics or logging backend never fails the request being retried.
+func (o *options) callRetryCallback(attempt rehttp.Attempt) {
+	defer func() {
+		if r := recover(); r != nil && o.logger != nil {
+			o.logger.Error("Retry callback panicked", "panic", r, "url", attempt.Request.URL.String())
+		}
+	}()
+	o.retryCallback(attempt.Index+1, attempt.Request, attempt.Response, attempt.Error)
+}
+
+// retriesExhaustedFunc is called by the retrying transports when a request still fails after the last attempt they
+// were allowed to make.  resp and err are the outcome of that attempt, either one may be nil.
+type retriesExhaustedFunc func(req *http.Request, attempts int, elapsed time.Duration, resp *http.Response, err error)
+
+// retriesExhausted logs that the retries of req are exhausted so that sustained unavailability can be told apart from a
+// single transient failure
+func (o *options) retriesExhausted(req *http.Request, attempts int, elapsed time.Duration, resp *http.Response, err error) {
+	if o.logger == nil {
+		return
+	}
+	logContext := []interface{}{"method", req.Method, "url", req.URL.String(), "attempts", attempts, "elapsed", elapsed}
+	if resp != nil {
+		logContext = append(logContext, "status", resp.StatusCode)
+	}
+	if err != nil {
+		logContext = append(logContext, "error", err)
+	}
+	o.logger.Error("Retries exhausted", logContext...)
+}
+
+// WithStrictPercentComplete makes UpdateActivityPercentComplete return a *PercentCompleteRangeError for values outside
+// of [0,100].  By default such values are clamped to the nearest bound.
+func WithStrictPercentComplete() Option {
+	return func(o *options) {
+		o.strictPercentComplete = true
+	}
+}
+
+// WithNilResult sets the result sent when an activity is completed with a nil result, e.g. "{}" for consumers that
+// always expect an object.  By default a nil result is sent as an empty result, never as the JSON null.  result must
+// be serialized JSON.
+func WithNilResult(result string) Option {
+	return func(o *options) {
+		o.nilResult = result
+	}
+}
+
+// WithOperationErrors makes the client return every error in an *OperationError naming the method that failed, e.g.
+// "GetWorkflow: ...", so errors from different methods can be told apart once they bubble up.  The error the method
+// would otherwise return is kept in OperationError.Err: look for typed errors with errors.As (or unwrap the
+// *OperationError) instead of a type assertion on the returned error.
+func WithOperationErrors() Option {
+	return func(o *options) {
+		o.operationErrors = true
+	}
+}
+
+// WithRequestRecorder keeps the last size request/response pairs (including retries) so they can be inspected with
+// Client.RecentRequests, e.g. to add them to an error report after an operation failed.
+func WithRequestRecorder(size int) Option {
+	return func(o *options) {
+		o.requestRecorderSize = size
+	}
+}
+
+// WithGatewayRetries sets how many times a client created with NewClient retries a request that failed with a 502,
+// 503 or 504 from the API gateway.  The default is 2, 0 turns it off.  The retries start after 100ms and back off
+// exponentially, they stop early when the deadline of the context given to WithContext would pass before another
+// attempt completes, a "Retries exhausted" error is logged when the last attempt still got a gateway error.  Clients
+// created with NewClientWithRetry ignore this setting because they already retry those errors.
+func WithGatewayRetries(retries int) Option {
+	return func(o *options) {
+		o.gatewayRetries = retries
+	}
+}
+
+// WithTokenRetries sets how many times fetching the auth0 token is retried after it failed, e.g. because of a
+// momentary auth0 outage.  The default is 2, 0 turns it off.  The retries start after 100ms and back off exponentially,
+// they stop early when the context given to WithContext is closed.
+func WithTokenRetries(retries int) Option {
+	return func(o *options) {
+		o.tokenRetries = retries
+	}
+}
+
+// WithMaxResponseSize caps how many bytes of a response body the client reads, a larger response makes the call
+// return a *ResponseTooLargeError instead of reading it all into memory.  The default is 64MB, 0 turns the limit off.
+func WithMaxResponseSize(bytes int64) Option {
+	return func(o *options) {
+		o.maxResponseSize = bytes
+	}
+}
+
+// WithInsecureSkipVerify turns off verification of the workflow API's TLS certificate.  DEVELOPMENT AND TESTING ONLY, it
+// exists so the client can talk to a locally deployed API with a self-signed certificate.  Never use it in production,
+// it makes the client vulnerable to man-in-the-middle attacks.  A warning is logged whenever it is used.
+func WithInsecureSkipVerify() Option {
+	return func(o *options) {
+		o.insecureSkipVerify = true
+	}
+}
+
+// WithMaxIdleConns sets how many idle connections are kept open across all hosts.  The default is 100.
+func WithMaxIdleConns(n int) Option {
+	return func(o *options) {
+		o.maxIdleConns = n
+	}
+}
+
+// WithMaxIdleConnsPerHost sets how many idle connections are kept open to the API gateway.  The default is 2, which
+// makes a client used by many goroutines (e.g. several Workers heartbeating) close and reopen connections all the time.
+// Set it to about the number of requests made concurrently, e.g. 32 or 64 for a busy worker process.
+func WithMaxIdleConnsPerHost(n int) Option {
+	return func(o *options) {
+		o.maxIdleConnsPerHost = n
+	}
+}
+
+// WithIdleConnTimeout sets how long an idle connection is kept open before it is closed.  The default is 90 sec.
+func WithIdleConnTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.idleConnTimeout = timeout
+	}
+}
+
+// HTTPProtocol is the HTTP protocol version requests are sent with, see WithHTTPProtocol
+type HTTPProtocol int
+
+const (
+	// HTTPProtocolDefault leaves the protocol to the default transport, which negotiates HTTP/2 with the API gateway
+	// unless a transport setting (e.g. WithInsecureSkipVerify) disables it
+	HTTPProtocolDefault HTTPProtocol = iota
+	// HTTPProtocolHTTP2 attempts HTTP/2 whatever the other transport settings are
+	HTTPProtocolHTTP2
+	// HTTPProtocolHTTP1 always uses HTTP/1.1
+	HTTPProtocolHTTP1
+)
+
+// WithHTTPProtocol sets the HTTP protocol version requests are sent with.  HTTP/2 multiplexes the concurrent requests
+// of a client (e.g. several Workers heartbeating) over a single connection to the API gateway instead of opening one per
+// request, use HTTPProtocolHTTP2 for high-concurrency workloads.  HTTP/2 is negotiated during the TLS handshake, so it
+// is only used with an https API gateway URL whose server offers it, requests fall back to HTTP/1.1 otherwise.  Use
+// HTTPProtocolHTTP1 if a proxy in front of the gateway mishandles HTTP/2.
+func WithHTTPProtocol(protocol HTTPProtocol) Option {
+	return func(o *options) {
+		o.protocol = protocol
+	}
+}
+
+// buildTransport returns the transport requests should be sent with, or nil when http.DefaultTransport can be used.
+// gatewayRetry adds retries of gateway errors for clients that don't retry otherwise.
+func (o *options) buildTransport(gatewayRetry bool) http.RoundTripper {
+	var transport http.RoundTripper
+	if o.insecureSkipVerify || o.maxIdleConns > 0 || o.maxIdleConnsPerHost > 0 || o.idleConnTimeout > 0 || o.protocol != HTTPProtocolDefault {
+		o.transport = o.httpTransport()
+		transport = o.transport
+	}
+	if o.cassettePath != "" {
+		transport = newCassetteTransport(o.cassettePath, transport)
+	}
+	if o.requestRecorderSize > 0 {
+		o.recorder = newRequestRecorder(o.requestRecorderSize, transport)
+		transport = o.recorder
+	}
+	if gatewayRetry && o.gatewayRetries > 0 {
+		transport = newGatewayRetrier(o.gatewayRetries, transport, o.retriesExhausted)
+	}
+	if o.organizationID != 0 {
+		transport = newOrganizationHeaderSetter(o.organizationID, transport)
+	}
+	return transport
+}
+
+// httpTransport returns a transport with the same settings as http.DefaultTransport changed by the options
+func (o *options) httpTransport() *http.Transport {
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+	}
+	if o.insecureSkipVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	if o.maxIdleConns > 0 {
+		transport.MaxIdleConns = o.maxIdleConns
+	}
+	if o.maxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = o.maxIdleConnsPerHost
+	}
+	if o.idleConnTimeout > 0 {
+		transport.IdleConnTimeout = o.idleConnTimeout
+	}
+	switch o.protocol {
+	case HTTPProtocolDefault:
+		// a transport with a DialContext of its own doesn't negotiate HTTP/2 unless configured for it, like
+		// http.DefaultTransport does as long as the TLS config isn't changed
+		if !o.insecureSkipVerify {
+			http2.ConfigureTransport(transport)
+		}
+	case HTTPProtocolHTTP2:
+		// only fails when the transport is already configured for HTTP/2, which a new one is not
+		http2.ConfigureTransport(transport)
+	case HTTPProtocolHTTP1:
+		// a non-nil empty map disables HTTP/2
+		transport.TLSNextProto = map[string]func(string, *tls.Conn) http.RoundTripper{}
+	}
+	return transport
+}
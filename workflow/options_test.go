@@ -0,0 +1,528 @@
+package workflow
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/workflow-goclient/genclient/operations"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func init() {
+	// keep the backoff between retries short so tests run quickly
+	retryBaseDelay = 10 * time.Millisecond
+	tokenRetryDelay = 1 * time.Millisecond
+}
+
+func TestWithRetryCallback(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/cancel"
+
+	t.Run("WhenServerFlapsExpectsCallbackCalledBeforeEachRetry", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+
+		// fail the first two requests, then succeed
+		requests := 0
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			if requests <= 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+
+		var mu sync.Mutex
+		var attempts, statuses []int
+		callback := func(attempt int, req *http.Request, resp *http.Response, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			attempts = append(attempts, attempt)
+			if resp != nil {
+				statuses = append(statuses, resp.StatusCode)
+			}
+		}
+		client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, 5*time.Second, logger, WithRetryCallback(callback))
+
+		// act
+		err := client.CancelWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected the call to succeed after retrying")
+		assert.Equal(t, 3, requests, "Expected two failed requests and one successful request")
+		assert.Equal(t, []int{1, 2}, attempts, "Expected the callback to be called before each retry")
+		assert.Equal(t, []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable}, statuses, "Expected the callback to see the responses that caused the retries")
+	})
+
+	t.Run("WhenNoRetryNeededExpectsCallbackNotCalled", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+
+		calls := 0
+		callback := func(int, *http.Request, *http.Response, error) { calls++ }
+		client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, 5*time.Second, logger, WithRetryCallback(callback))
+
+		// act
+		err := client.CancelWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected the call to succeed")
+		assert.Equal(t, 0, calls, "Expected the callback to not be called when nothing is retried")
+	})
+
+	t.Run("WhenCallbackPanicsExpectsCallToSucceedAfterRetrying", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		// fail the first request, then succeed
+		var requests int32
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&requests, 1) == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		callback := func(int, *http.Request, *http.Response, error) { panic("metrics backend down") }
+		client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, 5*time.Second, logger, WithRetryCallback(callback))
+
+		// act
+		err := client.CancelWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected the call to succeed despite the callback")
+		assert.EqualValues(t, 2, atomic.LoadInt32(&requests), "Expected the request to be retried")
+	})
+}
+
+func TestWithInsecureSkipVerify(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/cancel"
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+	})
+	r := mux.NewRouter()
+	r.HandleFunc(endpoint, handler)
+	// the test server uses a self-signed certificate
+	testServer := httptest.NewTLSServer(r)
+	defer testServer.Close()
+
+	t.Run("WhenSetExpectsSelfSignedCertificateAccepted", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithInsecureSkipVerify())
+
+		// act
+		err := client.CancelWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected the self-signed certificate to be accepted")
+	})
+
+	t.Run("WhenSetWithRetryExpectsSelfSignedCertificateAccepted", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, 5*time.Second, logger, WithInsecureSkipVerify())
+
+		// act
+		err := client.CancelWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected the self-signed certificate to be accepted")
+	})
+
+	t.Run("WhenNotSetExpectsSelfSignedCertificateRejected", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		err := client.CancelWorkflow(workflowID)
+
+		// assert
+		assert.NotNil(t, err, "Expected the self-signed certificate to be rejected")
+	})
+}
+
+// newConnectionCounter returns a test server for getting workflows and the number of connections it accepted so far
+func newConnectionCounter() (*httptest.Server, func() int64) {
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"my-workflow"}`))
+	})
+	r := mux.NewRouter()
+	r.HandleFunc(endpoint, handler)
+	var connections int64
+	testServer := httptest.NewUnstartedServer(r)
+	testServer.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt64(&connections, 1)
+		}
+	}
+	testServer.Start()
+	return testServer, func() int64 { return atomic.LoadInt64(&connections) }
+}
+
+// getWorkflowsConcurrently gets a workflow requests times spread over concurrency goroutines
+func getWorkflowsConcurrently(client Client, requests, concurrency int) {
+	var wg sync.WaitGroup
+	next := make(chan struct{})
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for range next {
+				client.GetWorkflow("my-workflow")
+			}
+		}()
+	}
+	for i := 0; i < requests; i++ {
+		next <- struct{}{}
+	}
+	close(next)
+	wg.Wait()
+}
+
+func TestWithTokenRetries(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"` + workflowID + `"}`))
+	})
+	auth0Error := errors.New("auth0 is unavailable")
+
+	t.Run("WhenTokenFetchFailsOnceExpectsRetriedAndRequestSucceeds", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturnsOnCall(0, "", auth0Error)
+		fakeTokenFetcher.TokenReturnsOnCall(1, "token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflow, err := client.GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected the token fetch to be retried")
+		assert.NotNil(t, workflow, "Expected workflow to be returned")
+		assert.Equal(t, 2, fakeTokenFetcher.TokenCallCount(), "Expected the token to be fetched twice")
+	})
+
+	t.Run("WhenTokenFetchKeepsFailingExpectsErrorAfterRetries", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", auth0Error)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger, WithTokenRetries(3))
+
+		// act
+		_, err := client.GetWorkflow(workflowID)
+
+		// assert
+		assert.Equal(t, auth0Error, err, "Expected the error of the last token fetch")
+		assert.Equal(t, 4, fakeTokenFetcher.TokenCallCount(), "Expected the first fetch and 3 retries")
+	})
+
+	t.Run("WhenZeroExpectsNoRetry", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", auth0Error)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger, WithTokenRetries(0))
+
+		// act
+		_, err := client.GetWorkflow(workflowID)
+
+		// assert
+		assert.Equal(t, auth0Error, err, "Expected the error of the token fetch")
+		assert.Equal(t, 1, fakeTokenFetcher.TokenCallCount(), "Expected no retry")
+	})
+
+	t.Run("WhenContextCancelledExpectsRetriesStopped", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("", auth0Error)
+		client := NewClient(fakeTokenFetcher, gatewayURL, workflowAPIBasePath, audience, logger)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// act
+		_, err := client.WithContext(ctx).GetWorkflow(workflowID)
+
+		// assert
+		assert.Equal(t, context.Canceled, err, "Expected the context error")
+		assert.Equal(t, 1, fakeTokenFetcher.TokenCallCount(), "Expected no retry once the context is closed")
+	})
+}
+
+func TestWithMaxResponseSize(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	// newHandler streams a workflow with a result of resultSize bytes in chunks, without a Content-Length
+	newHandler := func(resultSize int) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"` + workflowID + `","result":"`))
+			chunk := []byte(strings.Repeat("a", 1024))
+			for written := 0; written < resultSize; written += len(chunk) {
+				w.Write(chunk)
+				w.(http.Flusher).Flush()
+			}
+			w.Write([]byte(`"}`))
+		}
+	}
+
+	t.Run("WhenResponseStreamsMoreThanLimitExpectsResponseTooLargeError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, newHandler(64*1024))
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithMaxResponseSize(16*1024))
+
+		// act
+		_, err := client.GetWorkflow(workflowID)
+
+		// assert
+		assert.Equal(t, &ResponseTooLargeError{Limit: 16 * 1024}, err, "Expected the response to be rejected")
+	})
+
+	t.Run("WhenResponseUnderLimitExpectsWorkflowReturned", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, newHandler(8*1024))
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, time.Second, logger, WithMaxResponseSize(16*1024))
+
+		// act
+		workflow, err := client.GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, err, "Expected no error for a response under the limit")
+		assert.Len(t, workflow.Result, 8*1024, "Expected the whole result to be read")
+	})
+}
+
+func TestWithOperationErrors(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	activityID := "my-activity"
+	r := mux.NewRouter()
+	r.HandleFunc("/"+workflowAPIBasePath+"/workflows/{workflowID}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"code":404,"message":"Workflow not found"}`))
+	})
+	r.HandleFunc("/"+workflowAPIBasePath+"/workflows/{workflowID}/activities/{activityID}", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"my-activity","status":"Running","percentComplete":5}`))
+	})
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("token", nil)
+	client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithOperationErrors())
+
+	t.Run("WhenMethodFailsExpectsOperationNameAndAPIErrorKept", func(t *testing.T) {
+		// act
+		_, err := client.WithContext(context.Background()).GetWorkflow(workflowID)
+
+		// assert
+		if assert.IsType(t, &OperationError{}, err, "Expected an *OperationError") {
+			opErr := err.(*OperationError)
+			assert.Equal(t, "GetWorkflow", opErr.Op, "Expected the name of the method that failed")
+			assert.True(t, strings.HasPrefix(err.Error(), "GetWorkflow: "), "Expected the message to start with the method name but got %q", err.Error())
+			assert.IsType(t, &operations.GetWorkflowNotFound{}, opErr.Unwrap(), "Expected the error of the workflow API to be kept")
+		}
+	})
+
+	t.Run("WhenMethodSucceedsExpectsNilError", func(t *testing.T) {
+		// act
+		activity, err := client.UpdateActivityPercentComplete(workflowID, activityID, 5)
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.NotNil(t, activity, "Expected the activity to be returned")
+	})
+}
+
+func TestWithMaxIdleConnsPerHost(t *testing.T) {
+	// arrange
+	concurrency := 16
+	requests := 320
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("token", nil)
+
+	t.Run("WhenSetExpectsFewerConnectionsUnderConcurrentLoad", func(t *testing.T) {
+		// arrange
+		defaultServer, defaultConnections := newConnectionCounter()
+		defer defaultServer.Close()
+		pooledServer, pooledConnections := newConnectionCounter()
+		defer pooledServer.Close()
+		// a transport of its own so connections pooled by other tests don't count
+		defaultClient := NewClient(fakeTokenFetcher, defaultServer.URL, workflowAPIBasePath, audience, logger, WithIdleConnTimeout(time.Minute))
+		pooledClient := NewClient(fakeTokenFetcher, pooledServer.URL, workflowAPIBasePath, audience, logger, WithMaxIdleConnsPerHost(concurrency))
+
+		// act
+		getWorkflowsConcurrently(defaultClient, requests, concurrency)
+		getWorkflowsConcurrently(pooledClient, requests, concurrency)
+
+		// assert
+		assert.True(t, pooledConnections() < defaultConnections(), "Expected fewer connections (%v) than with the default pool (%v)", pooledConnections(), defaultConnections())
+		assert.True(t, pooledConnections() <= int64(concurrency)*2, "Expected about one connection per goroutine, got %v", pooledConnections())
+	})
+}
+
+func BenchmarkConcurrentRequests(b *testing.B) {
+	concurrency := 32
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("token", nil)
+	benchmarks := []struct {
+		name string
+		opts []Option
+	}{
+		{"DefaultPool", []Option{WithIdleConnTimeout(time.Minute)}},
+		{"MaxIdleConnsPerHost", []Option{WithMaxIdleConnsPerHost(concurrency)}},
+	}
+	for _, bm := range benchmarks {
+		b.Run(bm.name, func(b *testing.B) {
+			testServer, connections := newConnectionCounter()
+			defer testServer.Close()
+			client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, bm.opts...)
+			b.ResetTimer()
+
+			getWorkflowsConcurrently(client, b.N, concurrency)
+
+			b.StopTimer()
+			b.Logf("%v connections opened for %v requests", connections(), b.N)
+		})
+	}
+}
+
+func TestWithHTTPProtocol(t *testing.T) {
+	// arrange
+	fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+	fakeTokenFetcher.TokenReturns("token", nil)
+	// newTLSServer offers HTTP/2 and HTTP/1.1 and keeps the major version of the protocol of the last request
+	newTLSServer := func(protoMajor *int32) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc("/"+workflowAPIBasePath+"/workflows/{workflowID}", func(w http.ResponseWriter, r *http.Request) {
+			atomic.StoreInt32(protoMajor, int32(r.ProtoMajor))
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"my-workflow"}`))
+		})
+		testServer := httptest.NewUnstartedServer(r)
+		testServer.TLS = &tls.Config{NextProtos: []string{"h2", "http/1.1"}}
+		testServer.StartTLS()
+		return testServer
+	}
+
+	t.Run("WhenHTTP2ExpectsRequestsSentWithHTTP2", func(t *testing.T) {
+		// arrange
+		var protoMajor int32
+		testServer := newTLSServer(&protoMajor)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithInsecureSkipVerify(), WithHTTPProtocol(HTTPProtocolHTTP2))
+
+		// act
+		_, err := client.GetWorkflow("my-workflow")
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.EqualValues(t, 2, atomic.LoadInt32(&protoMajor), "Expected the request sent with HTTP/2")
+	})
+
+	t.Run("WhenHTTP1ExpectsRequestsSentWithHTTP1", func(t *testing.T) {
+		// arrange
+		var protoMajor int32
+		testServer := newTLSServer(&protoMajor)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithInsecureSkipVerify(), WithHTTPProtocol(HTTPProtocolHTTP1))
+
+		// act
+		_, err := client.GetWorkflow("my-workflow")
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		assert.EqualValues(t, 1, atomic.LoadInt32(&protoMajor), "Expected the request sent with HTTP/1.1")
+	})
+
+	t.Run("WhenDefaultWithPoolOptionExpectsRequestsSentWithHTTP2", func(t *testing.T) {
+		// arrange
+		var protoMajor int32
+		testServer := newTLSServer(&protoMajor)
+		defer testServer.Close()
+		transport := newOptions([]Option{WithMaxIdleConnsPerHost(4)}).httpTransport()
+		// trusts the test server without WithInsecureSkipVerify, which disables HTTP/2 by default
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.RootCAs = x509.NewCertPool()
+		transport.TLSClientConfig.RootCAs.AddCert(testServer.Certificate())
+		req, _ := http.NewRequest(http.MethodGet, testServer.URL+"/"+workflowAPIBasePath+"/workflows/my-workflow", nil)
+
+		// act
+		resp, err := transport.RoundTrip(req)
+
+		// assert
+		assert.Nil(t, err, "Expected no error")
+		resp.Body.Close()
+		assert.EqualValues(t, 2, atomic.LoadInt32(&protoMajor), "Expected the request sent with HTTP/2")
+	})
+
+	t.Run("WhenDefaultExpectsDefaultTransport", func(t *testing.T) {
+		// arrange
+		o := newOptions(nil)
+
+		// act
+		transport := o.buildTransport(false)
+
+		// assert
+		assert.Nil(t, transport, "Expected http.DefaultTransport to be used")
+	})
+}
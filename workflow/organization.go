@@ -0,0 +1,81 @@
+package workflow
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/swag"
+)
+
+// organizationHeader is the header the API gateway routes the requests of a tenant by
+const organizationHeader = "X-Organization-ID"
+
+// WithOrganizationContext binds the client to an organization: every request carries the organization ID in the
+// X-Organization-ID header so the API gateway routes it to the organization, and the methods given an organization ID
+// (the OrganizationID of StartWorkflow's PostWorkflow, SearchWorkflows, ListWorkflows and WatchCapacity filters, and
+// GetWorkflowByExternalID) return an *OrganizationMismatchError without sending a request when it is not
+// organizationID.  TransferWorkflow is not checked since moving a workflow to another organization is what it is for.
+func WithOrganizationContext(organizationID int32) Option {
+	return func(o *options) {
+		o.organizationID = organizationID
+	}
+}
+
+// WithDefaultOrganization sets the organization of the workflows started without one: StartWorkflow (and the methods
+// starting a workflow through it) sends a copy of the PostWorkflow with OrganizationID set to organizationID when it is
+// nil.  An OrganizationID set by the caller is always sent as is.  Use it in deployments serving a single organization.
+func WithDefaultOrganization(organizationID int32) Option {
+	return func(o *options) {
+		o.defaultOrganizationID = organizationID
+	}
+}
+
+// withDefaultOrganization returns a copy of workflow with the organization of WithDefaultOrganization if it has none,
+// workflow otherwise
+func (c *client) withDefaultOrganization(workflow *models.PostWorkflow) *models.PostWorkflow {
+	if workflow.OrganizationID != nil || c.options.defaultOrganizationID == 0 {
+		return workflow
+	}
+	withOrganization := *workflow
+	withOrganization.OrganizationID = swag.Int32(c.options.defaultOrganizationID)
+	return &withOrganization
+}
+
+// checkOrganization returns an *OrganizationMismatchError when the client is bound to an organization by
+// WithOrganizationContext and organizationID is another one.  0 means no organization was given and is always accepted,
+// the header still scopes the request to the organization of the client.
+func (c *client) checkOrganization(organizationID int32) error {
+	if c.options.organizationID == 0 || organizationID == 0 || organizationID == c.options.organizationID {
+		return nil
+	}
+	err := &OrganizationMismatchError{OrganizationID: organizationID, ContextOrganizationID: c.options.organizationID}
+	c.logger.Error("Organization does not match the organization of the client", "organizationID", organizationID, "contextOrganizationID", c.options.organizationID)
+	return err
+}
+
+// organizationHeaderSetter is a http.RoundTripper that sets the organization header on every request
+type organizationHeaderSetter struct {
+	next http.RoundTripper
+	// value is the header value, formatted once when the client is created
+	value string
+}
+
+func newOrganizationHeaderSetter(organizationID int32, next http.RoundTripper) *organizationHeaderSetter {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &organizationHeaderSetter{next: next, value: strconv.FormatInt(int64(organizationID), 10)}
+}
+
+func (s *organizationHeaderSetter) RoundTrip(req *http.Request) (*http.Response, error) {
+	// a RoundTripper must not modify the request, send a copy with its own header
+	withHeader := new(http.Request)
+	*withHeader = *req
+	withHeader.Header = make(http.Header, len(req.Header)+1)
+	for k, v := range req.Header {
+		withHeader.Header[k] = v
+	}
+	withHeader.Header.Set(organizationHeader, s.value)
+	return s.next.RoundTrip(withHeader)
+}
@@ -0,0 +1,158 @@
+package workflow
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithOrganizationContext(t *testing.T) {
+	// arrange
+	orgID := int32(10)
+	workflowID := "sim-200"
+	endpoint := "/" + workflowAPIBasePath + "/workflows"
+	newPost := func(organizationID int32) *models.PostWorkflow {
+		return &models.PostWorkflow{
+			EntityID:       swag.Int32(7),
+			OrganizationID: swag.Int32(organizationID),
+			WorkflowType:   swag.String(models.PostWorkflowWorkflowTypeAssumedStrain),
+		}
+	}
+	// newServer starts a workflow for every request, keeping the organization header and counting the requests
+	newServer := func(header *string, requests *int32) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(requests, 1)
+			*header = r.Header.Get("X-Organization-ID")
+			w.Header().Set("Content-Type", "application/json")
+			workflowIDBytes, _ := json.Marshal(workflowID)
+			w.Write(workflowIDBytes)
+		})
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenOrganizationMatchesExpectsHeaderSent", func(t *testing.T) {
+		// arrange
+		var header string
+		var requests int32
+		testServer := newServer(&header, &requests)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithOrganizationContext(orgID))
+
+		// act
+		returnedWorkflowID, err := client.StartWorkflow(newPost(orgID))
+
+		// assert
+		assert.Nil(t, err, "Expected no error starting a workflow of the organization")
+		assert.Equal(t, workflowID, returnedWorkflowID, "Expected returned workflow ID to match response value")
+		assert.Equal(t, "10", header, "Expected the organization ID in the header")
+	})
+
+	t.Run("WhenOrganizationMismatchExpectsErrorBeforeRequest", func(t *testing.T) {
+		// arrange
+		var header string
+		var requests int32
+		testServer := newServer(&header, &requests)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithOrganizationContext(orgID))
+
+		// act
+		returnedWorkflowID, err := client.StartWorkflow(newPost(11))
+
+		// assert
+		assert.Empty(t, returnedWorkflowID, "Expected no workflow ID to be returned")
+		assert.Equal(t, &OrganizationMismatchError{OrganizationID: 11, ContextOrganizationID: orgID}, err, "Expected the mismatch to be rejected")
+		assert.EqualValues(t, 0, atomic.LoadInt32(&requests), "Expected no request to be sent")
+		assert.Equal(t, 0, fakeTokenFetcher.TokenCallCount(), "Expected no token to be fetched")
+	})
+
+	t.Run("WhenNoOrganizationContextExpectsNoHeader", func(t *testing.T) {
+		// arrange
+		header := "not set"
+		var requests int32
+		testServer := newServer(&header, &requests)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		_, err := client.StartWorkflow(newPost(11))
+
+		// assert
+		assert.Nil(t, err, "Expected any organization to be accepted")
+		assert.Empty(t, header, "Expected no organization header")
+	})
+}
+
+func TestWithDefaultOrganization(t *testing.T) {
+	// arrange
+	endpoint := "/" + workflowAPIBasePath + "/workflows"
+	// newServer starts a workflow for every request, keeping the posted workflow
+	newServer := func(posted *models.PostWorkflow) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			if err := json.NewDecoder(r.Body).Decode(posted); err != nil {
+				t.Fatal(err)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`"sim-200"`))
+		})
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenOrganizationOmittedExpectsDefaultApplied", func(t *testing.T) {
+		// arrange
+		var posted models.PostWorkflow
+		testServer := newServer(&posted)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithDefaultOrganization(10))
+		workflow := &models.PostWorkflow{
+			EntityID:     swag.Int32(7),
+			WorkflowType: swag.String(models.PostWorkflowWorkflowTypeAssumedStrain),
+		}
+
+		// act
+		_, err := client.StartWorkflow(workflow)
+
+		// assert
+		assert.Nil(t, err, "Expected no error starting a workflow without organization")
+		assert.EqualValues(t, 10, swag.Int32Value(posted.OrganizationID), "Expected the default organization to be sent")
+		assert.Nil(t, workflow.OrganizationID, "Expected the workflow of the caller to be left unchanged")
+	})
+
+	t.Run("WhenOrganizationSetExpectsDefaultNotApplied", func(t *testing.T) {
+		// arrange
+		var posted models.PostWorkflow
+		testServer := newServer(&posted)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithDefaultOrganization(10))
+
+		// act
+		_, err := client.StartWorkflow(&models.PostWorkflow{
+			EntityID:       swag.Int32(7),
+			OrganizationID: swag.Int32(11),
+			WorkflowType:   swag.String(models.PostWorkflowWorkflowTypeAssumedStrain),
+		})
+
+		// assert
+		assert.Nil(t, err, "Expected no error starting a workflow of another organization")
+		assert.EqualValues(t, 11, swag.Int32Value(posted.OrganizationID), "Expected the organization of the caller to be sent")
+	})
+}
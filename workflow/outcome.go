@@ -0,0 +1,144 @@
+package workflow
+
+import (
+	"fmt"
+
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/swag"
+)
+
+// Outcome classifies how a workflow ended.  Use DescribeOutcome to compute it.
+type Outcome int
+
+const (
+	// OutcomeUnknown is used for workflows that have not reached a terminal state (or have a state this client
+	// does not recognize)
+	OutcomeUnknown Outcome = iota
+	// OutcomeSucceeded means the workflow completed successfully
+	OutcomeSucceeded
+	// OutcomeFailed means the workflow ended because of an application failure
+	OutcomeFailed
+	// OutcomeCancelled means the workflow was cancelled before it could complete
+	OutcomeCancelled
+	// OutcomeTimedOut means the workflow ran out of time before it could complete
+	OutcomeTimedOut
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case OutcomeSucceeded:
+		return "Succeeded"
+	case OutcomeFailed:
+		return "Failed"
+	case OutcomeCancelled:
+		return "Cancelled"
+	case OutcomeTimedOut:
+		return "TimedOut"
+	default:
+		return "Unknown"
+	}
+}
+
+// DescribeOutcome classifies the terminal state of a workflow and returns a human readable reason for it.  When the
+// workflow did not succeed, the reason is taken from the last activity that ended with the same status (e.g. the last
+// failed activity for a failed workflow).  Workflows that are still running are reported as OutcomeUnknown.
+func DescribeOutcome(workflow *models.Workflow) (Outcome, string) {
+	if workflow == nil {
+		return OutcomeUnknown, "No workflow given"
+	}
+	switch workflow.State {
+	case models.WorkflowStateCompleted:
+		return OutcomeSucceeded, "Workflow completed successfully"
+	case models.WorkflowStateFailed:
+		if reason := lastActivityReason(workflow, models.ActivityStatusFailed); reason != "" {
+			return OutcomeFailed, reason
+		}
+		return OutcomeFailed, "Workflow failed"
+	case models.WorkflowStateCancelled:
+		if reason := lastActivityReason(workflow, models.ActivityStatusCancelled); reason != "" {
+			return OutcomeCancelled, reason
+		}
+		return OutcomeCancelled, "Workflow was cancelled"
+	case models.WorkflowStateTimedOut:
+		if activity := lastActivityWithStatus(workflow, models.ActivityStatusRunning); activity != nil {
+			return OutcomeTimedOut, fmt.Sprintf("Workflow timed out while running activity %v", *activity.ID)
+		}
+		return OutcomeTimedOut, "Workflow timed out"
+	default:
+		return OutcomeUnknown, fmt.Sprintf("Workflow has not finished, current state is %q", workflow.State)
+	}
+}
+
+// lastActivityReason returns the error reason (and details when present) of the last activity with the given status
+func lastActivityReason(workflow *models.Workflow, status string) string {
+	activity := lastActivityWithStatus(workflow, status)
+	if activity == nil || activity.Error == nil || activity.Error.Reason == nil {
+		return ""
+	}
+	if activity.Error.Details == "" {
+		return *activity.Error.Reason
+	}
+	return *activity.Error.Reason + ": " + activity.Error.Details
+}
+
+func lastActivityWithStatus(workflow *models.Workflow, status string) *models.Activity {
+	for i := len(workflow.Activities) - 1; i >= 0; i-- {
+		activity := workflow.Activities[i]
+		if activity != nil && activity.ID != nil && activity.Status != nil && *activity.Status == status {
+			return activity
+		}
+	}
+	return nil
+}
+
+// ActivityOutcome is the terminal transition of an activity sent by CompleteActivity, for the transitions the
+// Complete...Activity methods do not cover, e.g. a completion carrying a warning
+type ActivityOutcome struct {
+	// Status is models.ActivityStatusCompleted, models.ActivityStatusFailed or models.ActivityStatusCancelled
+	Status string
+	// Result is serialized to JSON like the result given to CompleteSuccessfulActivity.  A failed activity has no
+	// result, a cancelled one may have a partial result.
+	Result interface{}
+	// Error is required with a Reason for a failed or cancelled activity.  A completed activity only has one when
+	// Warning is set.
+	Error *models.ActivityError
+	// Warning tells that the Error of a completed activity is a warning about the result rather than a failure
+	Warning bool
+	// PercentComplete is sent as is, except for a completed activity where 0 is sent as 100
+	PercentComplete int
+}
+
+// Validate returns an *ActivityOutcomeError when the fields of the outcome do not make a sensible terminal transition
+func (o ActivityOutcome) Validate() error {
+	invalid := func(reason string) error {
+		return &ActivityOutcomeError{Status: o.Status, Reason: reason}
+	}
+	if o.PercentComplete < 0 || o.PercentComplete > 100 {
+		return invalid(fmt.Sprintf("percent complete %v is not between 0 and 100", o.PercentComplete))
+	}
+	if o.Error != nil && swag.StringValue(o.Error.Reason) == "" {
+		return invalid("the error has no reason")
+	}
+	switch o.Status {
+	case models.ActivityStatusCompleted:
+		if o.Error != nil && !o.Warning {
+			return invalid("a completed activity only has an error when it is a warning")
+		}
+		if o.PercentComplete != 0 && o.PercentComplete != 100 {
+			return invalid("a completed activity is 100 percent complete")
+		}
+	case models.ActivityStatusFailed, models.ActivityStatusCancelled:
+		if o.Error == nil {
+			return invalid("the error is required")
+		}
+		if o.Warning {
+			return invalid("only the error of a completed activity can be a warning")
+		}
+		if o.Status == models.ActivityStatusFailed && o.Result != nil {
+			return invalid("a failed activity has no result")
+		}
+	default:
+		return invalid("the status is not a terminal status of an activity")
+	}
+	return nil
+}
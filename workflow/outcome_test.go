@@ -0,0 +1,170 @@
+package workflow
+
+import (
+	"testing"
+
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribeOutcome(t *testing.T) {
+	// arrange
+	completedActivity := &models.Activity{
+		ID:     swag.String("activity-1"),
+		Status: swag.String(models.ActivityStatusCompleted),
+	}
+
+	t.Run("WhenCompletedExpectsSucceeded", func(t *testing.T) {
+		// arrange
+		workflow := &models.Workflow{State: models.WorkflowStateCompleted, Activities: []*models.Activity{completedActivity}}
+
+		// act
+		outcome, reason := DescribeOutcome(workflow)
+
+		// assert
+		assert.Equal(t, OutcomeSucceeded, outcome, "Expected a completed workflow to have succeeded")
+		assert.NotEmpty(t, reason, "Expected a reason to be given")
+	})
+
+	t.Run("WhenFailedExpectsReasonFromLastFailedActivity", func(t *testing.T) {
+		// arrange
+		workflow := &models.Workflow{
+			State: models.WorkflowStateFailed,
+			Activities: []*models.Activity{
+				completedActivity,
+				{
+					ID:     swag.String("activity-2"),
+					Status: swag.String(models.ActivityStatusFailed),
+					Error:  &models.ActivityError{Reason: swag.String("first failure")},
+				},
+				{
+					ID:     swag.String("activity-3"),
+					Status: swag.String(models.ActivityStatusFailed),
+					Error:  &models.ActivityError{Reason: swag.String("Out of memory"), Details: "needed 64GB"},
+				},
+			},
+		}
+
+		// act
+		outcome, reason := DescribeOutcome(workflow)
+
+		// assert
+		assert.Equal(t, OutcomeFailed, outcome, "Expected a failed workflow to have failed")
+		assert.Equal(t, "Out of memory: needed 64GB", reason, "Expected reason from the last failed activity")
+	})
+
+	t.Run("WhenFailedWithoutFailedActivityExpectsGenericReason", func(t *testing.T) {
+		// arrange
+		workflow := &models.Workflow{State: models.WorkflowStateFailed, Activities: []*models.Activity{completedActivity}}
+
+		// act
+		outcome, reason := DescribeOutcome(workflow)
+
+		// assert
+		assert.Equal(t, OutcomeFailed, outcome, "Expected a failed workflow to have failed")
+		assert.Equal(t, "Workflow failed", reason, "Expected a generic reason")
+	})
+
+	t.Run("WhenCancelledExpectsReasonFromLastCancelledActivity", func(t *testing.T) {
+		// arrange
+		workflow := &models.Workflow{
+			State: models.WorkflowStateCancelled,
+			Activities: []*models.Activity{
+				{
+					ID:     swag.String("activity-1"),
+					Status: swag.String(models.ActivityStatusCancelled),
+					Error:  &models.ActivityError{Reason: swag.String("Cancel requested")},
+				},
+			},
+		}
+
+		// act
+		outcome, reason := DescribeOutcome(workflow)
+
+		// assert
+		assert.Equal(t, OutcomeCancelled, outcome, "Expected a cancelled workflow to have been cancelled")
+		assert.Equal(t, "Cancel requested", reason, "Expected reason from the cancelled activity")
+	})
+
+	t.Run("WhenTimedOutExpectsTimedOut", func(t *testing.T) {
+		// arrange
+		workflow := &models.Workflow{
+			State: models.WorkflowStateTimedOut,
+			Activities: []*models.Activity{
+				completedActivity,
+				{ID: swag.String("activity-2"), Status: swag.String(models.ActivityStatusRunning)},
+			},
+		}
+
+		// act
+		outcome, reason := DescribeOutcome(workflow)
+
+		// assert
+		assert.Equal(t, OutcomeTimedOut, outcome, "Expected a timed out workflow to have timed out")
+		assert.Contains(t, reason, "activity-2", "Expected reason to mention the activity that was running")
+	})
+
+	t.Run("WhenRunningExpectsUnknown", func(t *testing.T) {
+		// arrange
+		workflow := &models.Workflow{State: models.WorkflowStateRunning}
+
+		// act
+		outcome, _ := DescribeOutcome(workflow)
+
+		// assert
+		assert.Equal(t, OutcomeUnknown, outcome, "Expected a running workflow to not have an outcome yet")
+	})
+
+	t.Run("WhenNilExpectsUnknown", func(t *testing.T) {
+		// act
+		outcome, _ := DescribeOutcome(nil)
+
+		// assert
+		assert.Equal(t, OutcomeUnknown, outcome, "Expected a nil workflow to not have an outcome")
+	})
+}
+
+func TestActivityOutcomeValidate(t *testing.T) {
+	// arrange
+	activityError := models.NewActivityError("some reason", "some details")
+	validOutcomes := map[string]ActivityOutcome{
+		"Completed":                  {Status: models.ActivityStatusCompleted, Result: "result"},
+		"CompletedWithWarning":       {Status: models.ActivityStatusCompleted, Error: activityError, Warning: true},
+		"Failed":                     {Status: models.ActivityStatusFailed, Error: activityError, PercentComplete: 30},
+		"CancelledWithPartialResult": {Status: models.ActivityStatusCancelled, Error: activityError, Result: "partial"},
+	}
+	invalidOutcomes := map[string]ActivityOutcome{
+		"CompletedWithError":        {Status: models.ActivityStatusCompleted, Error: activityError},
+		"CompletedPartially":        {Status: models.ActivityStatusCompleted, PercentComplete: 50},
+		"FailedWithoutError":        {Status: models.ActivityStatusFailed},
+		"FailedWithResult":          {Status: models.ActivityStatusFailed, Error: activityError, Result: "result"},
+		"FailedWithWarning":         {Status: models.ActivityStatusFailed, Error: activityError, Warning: true},
+		"CancelledWithEmptyReason":  {Status: models.ActivityStatusCancelled, Error: &models.ActivityError{}},
+		"Running":                   {Status: models.ActivityStatusRunning},
+		"CustomStatus":              {Status: "Skipped"},
+		"PercentCompleteOutOfRange": {Status: models.ActivityStatusCancelled, Error: activityError, PercentComplete: 101},
+	}
+
+	t.Run("WhenSensibleTransitionExpectsNoError", func(t *testing.T) {
+		for name, outcome := range validOutcomes {
+			// act
+			err := outcome.Validate()
+
+			// assert
+			assert.Nil(t, err, "Expected %v to be valid", name)
+		}
+	})
+
+	t.Run("WhenNotSensibleTransitionExpectsActivityOutcomeError", func(t *testing.T) {
+		for name, outcome := range invalidOutcomes {
+			// act
+			err := outcome.Validate()
+
+			// assert
+			if assert.IsType(t, &ActivityOutcomeError{}, err, "Expected %v to be rejected", name) {
+				assert.Equal(t, outcome.Status, err.(*ActivityOutcomeError).Status, "Expected the status of the outcome in the error")
+			}
+		}
+	})
+}
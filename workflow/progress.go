@@ -0,0 +1,36 @@
+package workflow
+
+import "github.com/3dsim/workflow-goclient/models"
+
+// activitiesProgress returns the average percent complete of the activities, counting the activities that ended as 100.
+// It is rounded down so that it only reaches 100 once every activity ended.  No activities means no progress.
+func activitiesProgress(activities []*models.Activity) int {
+	if len(activities) == 0 {
+		return 0
+	}
+	total := 0
+	for _, activity := range activities {
+		total += activityProgress(activity)
+	}
+	return total / len(activities)
+}
+
+func activityProgress(activity *models.Activity) int {
+	if activity == nil {
+		return 0
+	}
+	if activity.Status != nil {
+		switch *activity.Status {
+		case models.ActivityStatusCompleted, models.ActivityStatusFailed, models.ActivityStatusCancelled:
+			return 100
+		}
+	}
+	switch {
+	case activity.PercentComplete < 0:
+		return 0
+	case activity.PercentComplete > 100:
+		return 100
+	default:
+		return int(activity.PercentComplete)
+	}
+}
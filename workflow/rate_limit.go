@@ -0,0 +1,94 @@
+package workflow
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	log "github.com/inconshreveable/log15"
+	"golang.org/x/time/rate"
+)
+
+// WithRateLimit limits the requests of an operation of the workflow API to limit per second with bursts of up to burst
+// requests, e.g. to start workflows more slowly than heartbeats are sent:
+//
+//	NewClient(..., WithRateLimit("StartWorkflow", rate.Every(time.Second), 1))
+//
+// operation is the name of the method of genclient/operations.Client sending the request, e.g. StartWorkflow or
+// Heartbeat, it is matched regardless of case.  Every Client method sending a request of the operation shares the
+// limit, e.g. HeartbeatActivity, HeartbeatActivityWithToken and AbandonActivity all send Heartbeat requests.  The limit
+// is shared by the copies returned by WithContext but not between clients.  Operations without a limit are not limited,
+// giving the same operation again replaces its limit.
+//
+// A request exceeding the rate waits for its turn, or until the context given to WithContext is done: ErrTimeout is
+// returned if its deadline would pass first, the error of the context if it is cancelled.  Give WithRateLimitFailFast
+// to return a *RateLimitError right away instead.  Retries of a request are not limited.
+func WithRateLimit(operation string, limit rate.Limit, burst int) Option {
+	return func(o *options) {
+		if o.rateLimits == nil {
+			o.rateLimits = make(map[string]rateLimit)
+		}
+		o.rateLimits[strings.ToLower(operation)] = rateLimit{limit: limit, burst: burst}
+	}
+}
+
+// WithRateLimitFailFast makes the requests exceeding a rate set by WithRateLimit fail with a *RateLimitError instead of
+// waiting for their turn
+func WithRateLimitFailFast() Option {
+	return func(o *options) {
+		o.rateLimitFailFast = true
+	}
+}
+
+type rateLimit struct {
+	limit rate.Limit
+	burst int
+}
+
+// rateLimitTransport is a runtime.ClientTransport that limits the rate of the operations given to WithRateLimit
+type rateLimitTransport struct {
+	next runtime.ClientTransport
+	// limiters holds the limiter of each limited operation by lower case operation ID
+	limiters map[string]*rate.Limiter
+	failFast bool
+	logger   log.Logger
+}
+
+// newRateLimitTransport returns next wrapped in a rateLimitTransport, or next when no operation is limited
+func newRateLimitTransport(next runtime.ClientTransport, o *options) runtime.ClientTransport {
+	if len(o.rateLimits) == 0 {
+		return next
+	}
+	limiters := make(map[string]*rate.Limiter, len(o.rateLimits))
+	for operation, limit := range o.rateLimits {
+		limiters[operation] = rate.NewLimiter(limit.limit, limit.burst)
+	}
+	return &rateLimitTransport{next: next, limiters: limiters, failFast: o.rateLimitFailFast, logger: o.logger}
+}
+
+func (t *rateLimitTransport) Submit(operation *runtime.ClientOperation) (interface{}, error) {
+	limiter, ok := t.limiters[strings.ToLower(operation.ID)]
+	if !ok {
+		return t.next.Submit(operation)
+	}
+	if t.failFast {
+		if !limiter.Allow() {
+			t.logger.Warn("Request exceeds the rate limit of the operation", "operation", operation.ID, "limit", limiter.Limit())
+			return nil, &RateLimitError{Operation: operation.ID, Limit: limiter.Limit()}
+		}
+		return t.next.Submit(operation)
+	}
+	ctx := operation.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if err := limiter.Wait(ctx); err != nil {
+		t.logger.Error("Problem waiting for the rate limit of the operation", "operation", operation.ID, "error", err)
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		// the deadline of the context would pass before the turn of the request, which times out like any other
+		return nil, context.DeadlineExceeded
+	}
+	return t.next.Submit(operation)
+}
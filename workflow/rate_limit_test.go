@@ -0,0 +1,111 @@
+package workflow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/time/rate"
+)
+
+func TestWithRateLimit(t *testing.T) {
+	// arrange
+	workflow := &models.PostWorkflow{
+		EntityID:       swag.Int32(7),
+		OrganizationID: swag.Int32(10),
+		WorkflowType:   swag.String(models.PostWorkflowWorkflowTypeAssumedStrain),
+	}
+	// newServer starts a workflow or heartbeats for every request and counts the workflows started
+	newServer := func(starts *int32) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc("/"+workflowAPIBasePath+"/workflows", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(starts, 1)
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`"sim-200"`))
+		}).Methods("POST")
+		r.HandleFunc("/"+workflowAPIBasePath+"/heartbeats", func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"activityId":"my-activity","taskToken":"token"}`))
+		}).Methods("PUT")
+		return httptest.NewServer(r)
+	}
+	interval := 100 * time.Millisecond
+
+	t.Run("WhenRateExceededExpectsStartWorkflowThrottledAndHeartbeatsNot", func(t *testing.T) {
+		// arrange
+		var starts int32
+		testServer := newServer(&starts)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithRateLimit("StartWorkflow", rate.Every(interval), 1))
+
+		// act
+		heartbeatStart := time.Now()
+		for i := 0; i < 10; i++ {
+			if _, err := client.HeartbeatActivityWithToken("token", "my-activity", ""); err != nil {
+				t.Fatal(err)
+			}
+		}
+		heartbeatElapsed := time.Since(heartbeatStart)
+		start := time.Now()
+		for i := 0; i < 4; i++ {
+			if _, err := client.StartWorkflow(workflow); err != nil {
+				t.Fatal(err)
+			}
+		}
+		elapsed := time.Since(start)
+
+		// assert
+		assert.True(t, elapsed >= 3*interval-10*time.Millisecond, "Expected the workflows to be started at the configured rate but took %v", elapsed)
+		assert.True(t, heartbeatElapsed < 3*interval, "Expected the heartbeats to not be throttled but took %v", heartbeatElapsed)
+		assert.EqualValues(t, 4, atomic.LoadInt32(&starts), "Expected every workflow to be started")
+	})
+
+	t.Run("WhenFailFastExpectsRateLimitErrorWithoutRequest", func(t *testing.T) {
+		// arrange
+		var starts int32
+		testServer := newServer(&starts)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithRateLimit("startworkflow", rate.Every(time.Minute), 1), WithRateLimitFailFast())
+
+		// act
+		_, firstErr := client.StartWorkflow(workflow)
+		_, secondErr := client.StartWorkflow(workflow)
+
+		// assert
+		assert.Nil(t, firstErr, "Expected the burst to be allowed")
+		assert.Equal(t, &RateLimitError{Operation: "startWorkflow", Limit: rate.Every(time.Minute)}, secondErr, "Expected the request exceeding the rate to fail")
+		assert.EqualValues(t, 1, atomic.LoadInt32(&starts), "Expected no request exceeding the rate to be sent")
+	})
+
+	t.Run("WhenContextCancelledWhileWaitingExpectsContextError", func(t *testing.T) {
+		// arrange
+		var starts int32
+		testServer := newServer(&starts)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithRateLimit("StartWorkflow", rate.Every(time.Minute), 1))
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(interval, cancel)
+		client.StartWorkflow(workflow)
+
+		// act
+		_, err := client.WithContext(ctx).StartWorkflow(workflow)
+
+		// assert
+		assert.Equal(t, context.Canceled, err, "Expected the wait to stop when the context is cancelled")
+		assert.EqualValues(t, 1, atomic.LoadInt32(&starts), "Expected the request waiting its turn to not be sent")
+	})
+}
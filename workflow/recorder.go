@@ -0,0 +1,115 @@
+package workflow
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxRecordedBodySize is how much of a request or response body is kept by the request recorder
+const maxRecordedBodySize = 4 * 1024
+
+// RecordedRequest is a request/response pair kept by a client created with WithRequestRecorder.  Bodies are truncated
+// to the first 4KB, headers are not recorded so credentials are never kept.
+type RecordedRequest struct {
+	Method string
+	URL    string
+	// StatusCode is 0 when no response was received
+	StatusCode   int
+	RequestBody  string
+	ResponseBody string
+	// Err is the error returned by the transport, if any
+	Err      error
+	Start    time.Time
+	Duration time.Duration
+}
+
+// requestRecorder is a http.RoundTripper that keeps the most recent requests in a ring buffer
+type requestRecorder struct {
+	next http.RoundTripper
+
+	mu      sync.Mutex
+	entries []RecordedRequest
+	// oldest is the index of the oldest entry once the buffer is full
+	oldest int
+}
+
+func newRequestRecorder(size int, next http.RoundTripper) *requestRecorder {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &requestRecorder{next: next, entries: make([]RecordedRequest, 0, size)}
+}
+
+func (r *requestRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	entry := RecordedRequest{Method: req.Method, URL: req.URL.String(), Start: time.Now()}
+	if req.Body != nil {
+		// don't modify the caller's request, see http.RoundTripper
+		clone := new(http.Request)
+		*clone = *req
+		entry.RequestBody, clone.Body = peekBody(req.Body)
+		req = clone
+	}
+	resp, err := r.next.RoundTrip(req)
+	entry.Duration = time.Since(entry.Start)
+	entry.Err = err
+	if resp != nil {
+		entry.StatusCode = resp.StatusCode
+		if resp.Body != nil {
+			entry.ResponseBody, resp.Body = peekBody(resp.Body)
+		}
+	}
+	r.add(entry)
+	return resp, err
+}
+
+func (r *requestRecorder) add(entry RecordedRequest) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.entries) < cap(r.entries) {
+		r.entries = append(r.entries, entry)
+		return
+	}
+	if len(r.entries) == 0 {
+		return
+	}
+	r.entries[r.oldest] = entry
+	r.oldest = (r.oldest + 1) % len(r.entries)
+}
+
+// recent returns the recorded requests from oldest to newest
+func (r *requestRecorder) recent() []RecordedRequest {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	recent := make([]RecordedRequest, 0, len(r.entries))
+	recent = append(recent, r.entries[r.oldest:]...)
+	return append(recent, r.entries[:r.oldest]...)
+}
+
+// peekBody reads the start of body and returns it along with a body that still produces all of the original content
+func peekBody(body io.ReadCloser) (string, io.ReadCloser) {
+	prefix := make([]byte, maxRecordedBodySize)
+	n, err := io.ReadFull(body, prefix)
+	prefix = prefix[:n]
+	var rest io.Reader = body
+	if err != nil && err != io.ErrUnexpectedEOF && err != io.EOF {
+		// hand the error to whoever reads the body next
+		rest = &errReader{err: err}
+	}
+	return string(prefix), &readCloser{Reader: io.MultiReader(bytes.NewReader(prefix), rest), Closer: body}
+}
+
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+type errReader struct {
+	err error
+}
+
+func (r *errReader) Read([]byte) (int, error) {
+	return 0, r.err
+}
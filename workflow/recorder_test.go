@@ -0,0 +1,82 @@
+package workflow
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithRequestRecorder(t *testing.T) {
+	// arrange
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}/cancel"
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if mux.Vars(r)["workflowID"] == "failing-workflow" {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"code":500,"message":"boom"}`))
+		}
+	})
+	r := mux.NewRouter()
+	r.HandleFunc(endpoint, handler)
+	testServer := httptest.NewServer(r)
+	defer testServer.Close()
+
+	t.Run("WhenMoreRequestsThanSizeExpectsMostRecentKept", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithRequestRecorder(2))
+
+		// act
+		client.CancelWorkflow("workflow-1")
+		client.CancelWorkflow("workflow-2")
+		err := client.CancelWorkflow("failing-workflow")
+		recent := client.RecentRequests()
+
+		// assert
+		assert.NotNil(t, err, "Expected the last request to fail")
+		if assert.Len(t, recent, 2, "Expected only the two most recent requests to be kept") {
+			assert.True(t, strings.HasSuffix(recent[0].URL, "/workflows/workflow-2/cancel"), "Expected the older request first")
+			assert.Equal(t, http.MethodPut, recent[0].Method, "Expected the method to be recorded")
+			assert.Equal(t, http.StatusOK, recent[0].StatusCode, "Expected the status to be recorded")
+			assert.True(t, strings.HasSuffix(recent[1].URL, "/workflows/failing-workflow/cancel"), "Expected the newest request last")
+			assert.Equal(t, http.StatusInternalServerError, recent[1].StatusCode, "Expected the status to be recorded")
+			assert.Equal(t, `{"code":500,"message":"boom"}`, recent[1].ResponseBody, "Expected the response body to be recorded")
+			assert.False(t, recent[1].Start.IsZero(), "Expected the start time to be recorded")
+		}
+	})
+
+	t.Run("WhenNotSetExpectsNothingRecorded", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		client.CancelWorkflow("workflow-1")
+
+		// assert
+		assert.Nil(t, client.RecentRequests(), "Expected nothing to be recorded")
+	})
+}
+
+func TestPeekBodyWhenBodyLargerThanLimitExpectsTruncatedCopyAndFullBody(t *testing.T) {
+	// arrange
+	content := strings.Repeat("a", maxRecordedBodySize+10)
+	body := &readCloser{Reader: strings.NewReader(content), Closer: http.NoBody}
+
+	// act
+	peeked, restored := peekBody(body)
+	all, err := ioutil.ReadAll(restored)
+
+	// assert
+	assert.Nil(t, err, "Expected no error reading the restored body")
+	assert.Len(t, peeked, maxRecordedBodySize, "Expected the recorded body to be truncated")
+	assert.Equal(t, content, string(all), "Expected the restored body to be complete")
+}
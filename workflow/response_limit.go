@@ -0,0 +1,64 @@
+package workflow
+
+import (
+	"io"
+	"net/http"
+)
+
+// defaultMaxResponseSize is how much of a response body is read unless WithMaxResponseSize is used
+const defaultMaxResponseSize = 64 << 20
+
+// responseLimiter is a http.RoundTripper that makes reading a response body fail with a *ResponseTooLargeError once
+// more than limit bytes are read, so a huge response cannot make the client run out of memory.  The error comes from
+// the body rather than from RoundTrip so that http.Client does not wrap it in a *url.Error.
+type responseLimiter struct {
+	next  http.RoundTripper
+	limit int64
+}
+
+func newResponseLimiter(limit int64, next http.RoundTripper) *responseLimiter {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &responseLimiter{next: next, limit: limit}
+}
+
+func (l *responseLimiter) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := l.next.RoundTrip(req)
+	if resp != nil && resp.Body != nil {
+		resp.Body = &limitedBody{body: resp.Body, remaining: l.limit, limit: l.limit, tooLarge: resp.ContentLength > l.limit}
+	}
+	return resp, err
+}
+
+// limitedBody reads at most limit bytes of body
+type limitedBody struct {
+	body      io.ReadCloser
+	remaining int64
+	limit     int64
+	// tooLarge is set when the Content-Length already tells that the body is over the limit, nothing is read then
+	tooLarge bool
+}
+
+func (b *limitedBody) Read(p []byte) (int, error) {
+	if b.tooLarge {
+		return 0, &ResponseTooLargeError{Limit: b.limit}
+	}
+	// read one byte more than remaining to tell a body of exactly limit bytes from a larger one
+	if int64(len(p)) > b.remaining+1 {
+		p = p[:b.remaining+1]
+	}
+	n, err := b.body.Read(p)
+	if int64(n) > b.remaining {
+		n = int(b.remaining)
+		b.remaining = 0
+		b.tooLarge = true
+		return n, &ResponseTooLargeError{Limit: b.limit}
+	}
+	b.remaining -= int64(n)
+	return n, err
+}
+
+func (b *limitedBody) Close() error {
+	return b.body.Close()
+}
@@ -0,0 +1,28 @@
+package workflow
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/3dsim/workflow-goclient/models"
+)
+
+// ErrNoResult is returned when unmarshalling the result of a workflow or activity that has none, e.g. because it has
+// not completed yet
+var ErrNoResult = errors.New("No result to unmarshal")
+
+// UnmarshalWorkflowResult decodes the result of a completed workflow (as returned by GetWorkflow) into v
+func UnmarshalWorkflowResult(workflow *models.Workflow, v interface{}) error {
+	if workflow == nil || workflow.Result == "" {
+		return ErrNoResult
+	}
+	return json.Unmarshal([]byte(workflow.Result), v)
+}
+
+// UnmarshalActivityResult decodes the result of a completed activity into v
+func UnmarshalActivityResult(activity *models.Activity, v interface{}) error {
+	if activity == nil || activity.Result == "" {
+		return ErrNoResult
+	}
+	return json.Unmarshal([]byte(activity.Result), v)
+}
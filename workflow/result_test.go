@@ -0,0 +1,97 @@
+package workflow
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/swag"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+type simulationOutput struct {
+	MaxDistortion float64  `json:"maxDistortion"`
+	Files         []string `json:"files"`
+}
+
+func TestUnmarshalWorkflowResult(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+
+	t.Run("WhenWorkflowFromGetWorkflowHasResultExpectsResultDecoded", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"` + workflowID + `","state":"Completed","result":"{\"maxDistortion\":0.25,\"files\":[\"distortion.vtk\"]}"}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		workflow, err := client.GetWorkflow(workflowID)
+		assert.Nil(t, err, "Expected no error getting workflow")
+		output := &simulationOutput{}
+
+		// act
+		err = UnmarshalWorkflowResult(workflow, output)
+
+		// assert
+		assert.Nil(t, err, "Expected no error unmarshalling the result")
+		assert.Equal(t, &simulationOutput{MaxDistortion: 0.25, Files: []string{"distortion.vtk"}}, output, "Expected the result sent by the server")
+	})
+
+	t.Run("WhenNoResultExpectsErrNoResult", func(t *testing.T) {
+		// arrange
+		workflow := &models.Workflow{ID: workflowID, State: models.WorkflowStateRunning}
+
+		// act
+		err := UnmarshalWorkflowResult(workflow, &simulationOutput{})
+
+		// assert
+		assert.Equal(t, ErrNoResult, err, "Expected ErrNoResult for a workflow without result")
+	})
+
+	t.Run("WhenResultNotValidJSONExpectsError", func(t *testing.T) {
+		// arrange
+		workflow := &models.Workflow{ID: workflowID, Result: "{"}
+
+		// act
+		err := UnmarshalWorkflowResult(workflow, &simulationOutput{})
+
+		// assert
+		assert.NotNil(t, err, "Expected an error for a result that is not JSON")
+	})
+}
+
+func TestUnmarshalActivityResult(t *testing.T) {
+	t.Run("WhenResultFromCompleteSuccessfulActivityExpectsRoundTrip", func(t *testing.T) {
+		// arrange
+		sent := &simulationOutput{MaxDistortion: 1.5, Files: []string{"a.stl", "b.stl"}}
+		resultJSON, err := marshalResult(sent)
+		assert.Nil(t, err, "Expected no error marshalling the result")
+		activity := &models.Activity{ID: swag.String("activity-1"), Result: resultJSON}
+		received := &simulationOutput{}
+
+		// act
+		err = UnmarshalActivityResult(activity, received)
+
+		// assert
+		assert.Nil(t, err, "Expected no error unmarshalling the result")
+		assert.Equal(t, sent, received, "Expected the result to round trip")
+	})
+
+	t.Run("WhenNilExpectsErrNoResult", func(t *testing.T) {
+		// act
+		err := UnmarshalActivityResult(nil, &simulationOutput{})
+
+		// assert
+		assert.Equal(t, ErrNoResult, err, "Expected ErrNoResult for a nil activity")
+	})
+}
@@ -0,0 +1,121 @@
+package workflow
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/PuerkitoBio/rehttp"
+)
+
+// hasTimeFor tells if the context of req leaves more than wait before its deadline.  Requests without a deadline
+// always have time.
+func hasTimeFor(req *http.Request, wait time.Duration) bool {
+	deadline, ok := req.Context().Deadline()
+	return !ok || time.Until(deadline) > wait
+}
+
+// retryBudget is a http.RoundTripper that times the attempts made by a rehttp.Transport so that its retries stop when
+// the context deadline of the request would pass before the next attempt completes.  Without it the retries go on
+// until the deadline interrupts a delay or an attempt and the caller gets a cancellation error instead of the outcome of
+// the last attempt.
+type retryBudget struct {
+	next http.RoundTripper
+	// exhausted is called when a retry is wanted but the deadline leaves no time for it
+	exhausted retriesExhaustedFunc
+
+	mu sync.Mutex
+	// attempts holds the timing of the attempts of the requests being sent through the transport given to scoped
+	attempts map[*http.Request]*attemptTiming
+}
+
+type attemptTiming struct {
+	// start is when the first attempt started
+	start time.Time
+	// took is how long the last attempt took
+	took time.Duration
+}
+
+func newRetryBudget(next http.RoundTripper, exhausted retriesExhaustedFunc) *retryBudget {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &retryBudget{next: next, exhausted: exhausted, attempts: make(map[*http.Request]*attemptTiming)}
+}
+
+func (b *retryBudget) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := b.next.RoundTrip(req)
+	b.mu.Lock()
+	timing, ok := b.attempts[req]
+	if !ok {
+		timing = &attemptTiming{start: start}
+		b.attempts[req] = timing
+	}
+	timing.took = time.Since(start)
+	b.mu.Unlock()
+	return resp, err
+}
+
+// retryFn wraps retry so that a retry is only made when the deadline leaves time for the longest delay maxDelay
+// returns for the attempt plus another attempt as long as the last one
+func (b *retryBudget) retryFn(retry rehttp.RetryFn, maxDelay func(attempt int) time.Duration) rehttp.RetryFn {
+	return func(attempt rehttp.Attempt) bool {
+		b.mu.Lock()
+		timing := b.attempts[attempt.Request]
+		if timing == nil {
+			// the attempt did not go through RoundTrip, e.g. when retry is called directly
+			timing = &attemptTiming{start: time.Now()}
+		}
+		b.mu.Unlock()
+		if !retry(attempt) {
+			return false
+		}
+		if !hasTimeFor(attempt.Request, maxDelay(attempt.Index)+timing.took) {
+			if b.exhausted != nil {
+				b.exhausted(attempt.Request, attempt.Index+1, time.Since(timing.start), attempt.Response, attempt.Error)
+			}
+			return false
+		}
+		return true
+	}
+}
+
+// scoped returns retrying, the rehttp.Transport sending its attempts through b, wrapped so that the timing of a request
+// is forgotten once retrying returns, however it returns: after the last retry decision but also when the context of
+// the request is done during a delay or when the body can't be rewound for a retry
+func (b *retryBudget) scoped(retrying http.RoundTripper) http.RoundTripper {
+	return &retryScope{budget: b, next: retrying}
+}
+
+// done forgets the timing of req once no more attempts will be made for it
+func (b *retryBudget) done(req *http.Request) {
+	b.mu.Lock()
+	delete(b.attempts, req)
+	b.mu.Unlock()
+}
+
+// retryScope is the http.RoundTripper returned by retryBudget.scoped
+type retryScope struct {
+	budget *retryBudget
+	next   http.RoundTripper
+}
+
+func (s *retryScope) RoundTrip(req *http.Request) (*http.Response, error) {
+	// rehttp sends every attempt with req itself, which is the key of its timing
+	defer s.budget.done(req)
+	return s.next.RoundTrip(req)
+}
+
+// expJitterMaxDelay returns the longest delay rehttp.ExpJitterDelay(base, max) can return for the attempt
+func expJitterMaxDelay(base, max time.Duration) func(attempt int) time.Duration {
+	return func(attempt int) time.Duration {
+		if attempt >= 62 {
+			return max
+		}
+		if top := base << uint(attempt); top > 0 && top < max {
+			return top
+		}
+		return max
+	}
+}
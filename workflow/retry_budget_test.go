@@ -0,0 +1,259 @@
+package workflow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/workflow-goclient/genclient/operations"
+	"github.com/PuerkitoBio/rehttp"
+	"github.com/gorilla/mux"
+	log "github.com/inconshreveable/log15"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryBudget(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	// newFailingServer always answers with status and counts the requests
+	newFailingServer := func(status int, requests *int32) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(requests, 1)
+			w.WriteHeader(status)
+		})
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenRetryingPastContextDeadlineExpectsLastResponseReturnedBeforeDeadline", func(t *testing.T) {
+		// arrange
+		var requests int32
+		testServer := newFailingServer(http.StatusInternalServerError, &requests)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, 5*time.Second, logger)
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		// act
+		_, err := client.WithContext(ctx).GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, ctx.Err(), "Expected the retries to stop before the deadline")
+		_, ok := err.(*operations.GetWorkflowDefault)
+		assert.True(t, ok, "Expected the error response of the last attempt but got %v", err)
+		assert.True(t, atomic.LoadInt32(&requests) > 1, "Expected the request to be retried")
+	})
+
+	t.Run("WhenGatewayRetryingPastContextDeadlineExpectsLastResponseReturnedBeforeDeadline", func(t *testing.T) {
+		// arrange
+		defer func(delay time.Duration) { gatewayRetryDelay = delay }(gatewayRetryDelay)
+		gatewayRetryDelay = 20 * time.Millisecond
+		var requests int32
+		testServer := newFailingServer(http.StatusServiceUnavailable, &requests)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		// the delays of 10 retries add up to 20 sec
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithGatewayRetries(10))
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		// act
+		_, err := client.WithContext(ctx).GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, ctx.Err(), "Expected the retries to stop before the deadline")
+		_, ok := err.(*operations.GetWorkflowDefault)
+		assert.True(t, ok, "Expected the error response of the last attempt but got %v", err)
+		assert.True(t, atomic.LoadInt32(&requests) > 1, "Expected the request to be retried")
+	})
+
+	t.Run("WhenNoDeadlineExpectsRetriesNotLimited", func(t *testing.T) {
+		// arrange
+		var requests int32
+		testServer := newFailingServer(http.StatusServiceUnavailable, &requests)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger, WithGatewayRetries(3))
+
+		// act
+		client.GetWorkflow(workflowID)
+
+		// assert
+		assert.EqualValues(t, 4, atomic.LoadInt32(&requests), "Expected the first attempt and every retry")
+	})
+}
+
+func TestRetryBudgetScoped(t *testing.T) {
+	// arrange
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer testServer.Close()
+	// newTransport retries every error response after delay while the deadline leaves time for it, like
+	// NewClientWithRetry does
+	newTransport := func(delay time.Duration) (*retryBudget, http.RoundTripper) {
+		budget := newRetryBudget(nil, nil)
+		retry := budget.retryFn(rehttp.RetryStatusInterval(500, 600), func(int) time.Duration { return delay })
+		return budget, budget.scoped(rehttp.NewTransport(budget, retry, rehttp.ConstDelay(delay)))
+	}
+	// attempts returns how many requests the budget keeps the timing of
+	attempts := func(budget *retryBudget) int {
+		budget.mu.Lock()
+		defer budget.mu.Unlock()
+		return len(budget.attempts)
+	}
+
+	t.Run("WhenCancelledDuringDelayExpectsTimingForgotten", func(t *testing.T) {
+		// arrange
+		budget, transport := newTransport(time.Minute)
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+		req, _ := http.NewRequest("GET", testServer.URL, nil)
+
+		// act
+		_, err := transport.RoundTrip(req.WithContext(ctx))
+
+		// assert
+		assert.NotNil(t, err, "Expected the request to be cancelled")
+		assert.Equal(t, 0, attempts(budget), "Expected no timing left once the request returned")
+	})
+
+	t.Run("WhenDeadlineLeavesNoTimeToRetryExpectsTimingForgotten", func(t *testing.T) {
+		// arrange
+		budget, transport := newTransport(time.Minute)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		req, _ := http.NewRequest("GET", testServer.URL, nil)
+
+		// act
+		resp, err := transport.RoundTrip(req.WithContext(ctx))
+
+		// assert
+		assert.Nil(t, err, "Expected the response of the last attempt")
+		if resp != nil {
+			resp.Body.Close()
+		}
+		assert.Equal(t, 0, attempts(budget), "Expected no timing left once the request returned")
+	})
+}
+
+func TestExpJitterMaxDelay(t *testing.T) {
+	// arrange
+	maxDelay := expJitterMaxDelay(100*time.Millisecond, time.Second)
+
+	// act
+	first, third, fifth, hundredth := maxDelay(0), maxDelay(2), maxDelay(4), maxDelay(100)
+
+	// assert
+	assert.Equal(t, 100*time.Millisecond, first, "Expected the base for the first retry")
+	assert.Equal(t, 400*time.Millisecond, third, "Expected the base doubled per retry")
+	assert.Equal(t, time.Second, fifth, "Expected the delay capped at max")
+	assert.Equal(t, time.Second, hundredth, "Expected no overflow for large attempts")
+}
+
+func TestRetriesExhaustedLogged(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	newFailingServer := func(status int) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		})
+		return httptest.NewServer(r)
+	}
+	// newRecordingLogger keeps the context of every "Retries exhausted" record, keyed by name
+	newRecordingLogger := func() (log.Logger, func() []map[string]interface{}) {
+		var mu sync.Mutex
+		var events []map[string]interface{}
+		recordingLogger := log.New()
+		recordingLogger.SetHandler(log.FuncHandler(func(r *log.Record) error {
+			if r.Msg != "Retries exhausted" {
+				return nil
+			}
+			event := map[string]interface{}{"level": r.Lvl}
+			for i := 0; i+1 < len(r.Ctx); i += 2 {
+				event[r.Ctx[i].(string)] = r.Ctx[i+1]
+			}
+			mu.Lock()
+			events = append(events, event)
+			mu.Unlock()
+			return nil
+		}))
+		return recordingLogger, func() []map[string]interface{} {
+			mu.Lock()
+			defer mu.Unlock()
+			return events
+		}
+	}
+
+	t.Run("WhenGatewayRetriesExhaustedExpectsEventWithAttemptsAndElapsedTime", func(t *testing.T) {
+		// arrange
+		testServer := newFailingServer(http.StatusServiceUnavailable)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		recordingLogger, events := newRecordingLogger()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, recordingLogger, WithGatewayRetries(3))
+
+		// act
+		client.GetWorkflow(workflowID)
+
+		// assert
+		if assert.Len(t, events(), 1, "Expected one retries exhausted event") {
+			event := events()[0]
+			assert.Equal(t, log.LvlError, event["level"], "Expected the event to be logged as an error")
+			assert.Equal(t, 4, event["attempts"], "Expected the first attempt and every retry to be counted")
+			assert.Equal(t, http.StatusServiceUnavailable, event["status"], "Expected the status of the last attempt")
+			elapsed, _ := event["elapsed"].(time.Duration)
+			assert.True(t, elapsed > 0, "Expected the elapsed time of all attempts")
+		}
+	})
+
+	t.Run("WhenRetriesStoppedByDeadlineExpectsEvent", func(t *testing.T) {
+		// arrange
+		testServer := newFailingServer(http.StatusInternalServerError)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		recordingLogger, events := newRecordingLogger()
+		client := NewClientWithRetry(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, 5*time.Second, recordingLogger)
+		ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+		defer cancel()
+
+		// act
+		client.WithContext(ctx).GetWorkflow(workflowID)
+
+		// assert
+		if assert.Len(t, events(), 1, "Expected one retries exhausted event") {
+			attempts, _ := events()[0]["attempts"].(int)
+			assert.True(t, attempts > 1, "Expected several attempts but got %v", attempts)
+		}
+	})
+
+	t.Run("WhenFirstAttemptSucceedsExpectsNoEvent", func(t *testing.T) {
+		// arrange
+		testServer := newFailingServer(http.StatusOK)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		recordingLogger, events := newRecordingLogger()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, recordingLogger)
+
+		// act
+		client.GetWorkflow(workflowID)
+
+		// assert
+		assert.Empty(t, events(), "Expected no retries exhausted event")
+	})
+}
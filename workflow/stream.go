@@ -0,0 +1,106 @@
+package workflow
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/go-openapi/runtime"
+	openapiclient "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// streamChunkSize is how much of a streamed result is escaped and written at a time
+const streamChunkSize = 32 * 1024
+
+// streamedActivityParams writes an update activity request whose body is an already encoded activity
+type streamedActivityParams struct {
+	workflowID string
+	activityID string
+	body       io.Reader
+}
+
+func (p *streamedActivityParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+	if err := r.SetTimeout(openapiclient.DefaultTimeout); err != nil {
+		return err
+	}
+	if err := r.SetBodyParam(p.body); err != nil {
+		return err
+	}
+	if err := r.SetPathParam("activityId", p.activityID); err != nil {
+		return err
+	}
+	return r.SetPathParam("id", p.workflowID)
+}
+
+// newCompletedActivityBody returns the JSON encoding of a completed activity whose result is read from result while the
+// body is being read.  attempt is left out when 0.  Closing the returned reader stops the encoding.
+func newCompletedActivityBody(activityID string, attempt int32, result io.Reader) io.ReadCloser {
+	pr, pw := io.Pipe()
+	go func() {
+		pw.CloseWithError(writeCompletedActivity(pw, activityID, attempt, result))
+	}()
+	return pr
+}
+
+func writeCompletedActivity(w io.Writer, activityID string, attempt int32, result io.Reader) error {
+	id, err := json.Marshal(activityID)
+	if err != nil {
+		return err
+	}
+	status, err := json.Marshal(models.ActivityStatusCompleted)
+	if err != nil {
+		return err
+	}
+	header := `{"id":` + string(id) + `,"percentComplete":100,"status":` + string(status) + `,"result":"`
+	if attempt > 0 {
+		header = `{"attempt":` + strconv.FormatInt(int64(attempt), 10) + `,` + header[1:]
+	}
+	if _, err := io.WriteString(w, header); err != nil {
+		return err
+	}
+	if err := writeJSONStringContents(w, result); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, `"}`)
+	return err
+}
+
+// writeJSONStringContents writes r to w escaped as the contents of a JSON string (without the surrounding quotes).
+// Invalid UTF-8 is replaced the same way json.Marshal replaces it.
+func writeJSONStringContents(w io.Writer, r io.Reader) error {
+	br := bufio.NewReaderSize(r, streamChunkSize)
+	chunk := make([]byte, 0, streamChunkSize+utf8.UTFMax)
+	encoded := make([]byte, utf8.UTFMax)
+	flush := func() error {
+		if len(chunk) == 0 {
+			return nil
+		}
+		escaped, err := json.Marshal(string(chunk))
+		if err != nil {
+			return err
+		}
+		chunk = chunk[:0]
+		_, err = w.Write(escaped[1 : len(escaped)-1])
+		return err
+	}
+	for {
+		ru, _, err := br.ReadRune()
+		if err == io.EOF {
+			return flush()
+		}
+		if err != nil {
+			return err
+		}
+		n := utf8.EncodeRune(encoded, ru)
+		chunk = append(chunk, encoded[:n]...)
+		if len(chunk) >= streamChunkSize {
+			if err := flush(); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package workflow
+
+import (
+	"context"
+	"net"
+
+	"github.com/go-openapi/runtime"
+)
+
+// timeoutTransport is a runtime.ClientTransport that replaces the errors of requests that timed out with ErrTimeout
+type timeoutTransport struct {
+	next runtime.ClientTransport
+}
+
+func (t *timeoutTransport) Submit(operation *runtime.ClientOperation) (interface{}, error) {
+	result, err := t.next.Submit(operation)
+	if err != nil && isTimeout(err) {
+		return nil, ErrTimeout
+	}
+	return result, err
+}
+
+// isTimeout tells if err comes from a request that exceeded its timeout or the deadline of its context.  Depending on
+// where the deadline was hit, the error is context.DeadlineExceeded or a net.Error (e.g. the *url.Error returned by
+// http.Client) that reports a timeout.
+func isTimeout(err error) bool {
+	if err == context.DeadlineExceeded {
+		return true
+	}
+	netErr, ok := err.(net.Error)
+	return ok && netErr.Timeout()
+}
@@ -0,0 +1,88 @@
+package workflow
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeout(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	// handler sleeps past the deadlines given by the subtests
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(2 * time.Second):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"id":"` + workflowID + `"}`))
+	})
+
+	t.Run("WhenContextDeadlineExceededExpectsErrTimeout", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// act
+		workflow, err := client.WithContext(ctx).GetWorkflow(workflowID)
+
+		// assert
+		assert.Nil(t, workflow, "Expected no workflow to be returned")
+		assert.Equal(t, ErrTimeout, err, "Expected ErrTimeout because the handler is slower than the deadline")
+	})
+
+	t.Run("WhenContextCancelledExpectsOtherError", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		testServer := httptest.NewServer(r)
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(50*time.Millisecond, cancel)
+
+		// act
+		_, err := client.WithContext(ctx).GetWorkflow(workflowID)
+
+		// assert
+		assert.NotNil(t, err, "Expected an error because the context was cancelled")
+		assert.NotEqual(t, ErrTimeout, err, "Expected a cancellation to not be reported as a timeout")
+	})
+}
+
+func TestIsTimeout(t *testing.T) {
+	t.Run("WhenDeadlineExceededExpectsTrue", func(t *testing.T) {
+		// act
+		timeout := isTimeout(context.DeadlineExceeded)
+
+		// assert
+		assert.True(t, timeout, "Expected context.DeadlineExceeded to be a timeout")
+	})
+
+	t.Run("WhenOtherErrorExpectsFalse", func(t *testing.T) {
+		// act
+		timeout := isTimeout(errors.New("connection refused"))
+
+		// assert
+		assert.False(t, timeout, "Expected other errors to not be timeouts")
+	})
+}
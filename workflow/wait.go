@@ -0,0 +1,39 @@
+package workflow
+
+import (
+	"context"
+	"time"
+
+	"github.com/3dsim/workflow-goclient/models"
+)
+
+// defaultWaitPollInterval is how often WaitForState gets the workflow when no poll interval is given
+const defaultWaitPollInterval = 10 * time.Second
+
+func (c *client) WaitForState(ctx context.Context, workflowID, target string, pollInterval time.Duration) (*models.Workflow, error) {
+	if pollInterval <= 0 {
+		pollInterval = defaultWaitPollInterval
+	}
+	c.logger.Info("Waiting for workflow state", "workflowID", workflowID, "target", target, "pollInterval", pollInterval)
+	client := c.WithContext(ctx)
+	for {
+		workflow, err := client.GetWorkflow(workflowID)
+		if ctx.Err() != nil {
+			// the request was aborted
+			return nil, ctx.Err()
+		}
+		if err != nil {
+			return nil, err
+		}
+		if workflow.State == target {
+			return workflow, nil
+		}
+		if isTerminalState(workflow.State) {
+			c.logger.Info("Workflow ended without reaching the state", "workflowID", workflowID, "target", target, "state", workflow.State)
+			return workflow, &StateNotReachedError{WorkflowID: workflowID, TargetState: target, State: workflow.State}
+		}
+		if !sleep(ctx, pollInterval) {
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package workflow
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWaitForState(t *testing.T) {
+	// arrange
+	workflowID := "my-workflow"
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	// newServer answers with the states in turn, the last one from then on, and counts the polls
+	newServer := func(polls *int32, states ...string) *httptest.Server {
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, func(w http.ResponseWriter, r *http.Request) {
+			poll := int(atomic.AddInt32(polls, 1))
+			if poll > len(states) {
+				poll = len(states)
+			}
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintf(w, `{"id":%q,"state":%q}`, workflowID, states[poll-1])
+		}).Methods("GET")
+		return httptest.NewServer(r)
+	}
+
+	t.Run("WhenTargetReachedAfterSeveralPollsExpectsWorkflowReturned", func(t *testing.T) {
+		// arrange
+		var polls int32
+		testServer := newServer(&polls, models.WorkflowStateRunning, models.WorkflowStateRunning, models.WorkflowStateCompleted)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflow, err := client.WaitForState(context.Background(), workflowID, models.WorkflowStateCompleted, 10*time.Millisecond)
+
+		// assert
+		assert.Nil(t, err, "Expected no error once the state is reached")
+		if assert.NotNil(t, workflow, "Expected the workflow to be returned") {
+			assert.Equal(t, models.WorkflowStateCompleted, workflow.State, "Expected the workflow in the target state")
+		}
+		assert.EqualValues(t, 3, atomic.LoadInt32(&polls), "Expected a poll per state")
+	})
+
+	t.Run("WhenWorkflowEndsInAnotherStateExpectsStateNotReachedError", func(t *testing.T) {
+		// arrange
+		var polls int32
+		testServer := newServer(&polls, models.WorkflowStateRunning, models.WorkflowStateFailed)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+
+		// act
+		workflow, err := client.WaitForState(context.Background(), workflowID, models.WorkflowStateCompleted, 10*time.Millisecond)
+
+		// assert
+		assert.Equal(t, &StateNotReachedError{WorkflowID: workflowID, TargetState: models.WorkflowStateCompleted, State: models.WorkflowStateFailed}, err, "Expected a *StateNotReachedError")
+		if assert.NotNil(t, workflow, "Expected the ended workflow to be returned") {
+			assert.Equal(t, models.WorkflowStateFailed, workflow.State, "Expected the state the workflow ended in")
+		}
+	})
+
+	t.Run("WhenContextClosedExpectsContextError", func(t *testing.T) {
+		// arrange
+		var polls int32
+		testServer := newServer(&polls, models.WorkflowStateRunning)
+		defer testServer.Close()
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// act
+		workflow, err := client.WaitForState(ctx, workflowID, models.WorkflowStateCompleted, 10*time.Millisecond)
+
+		// assert
+		assert.Nil(t, workflow, "Expected no workflow")
+		assert.Equal(t, context.DeadlineExceeded, err, "Expected the error of the context")
+	})
+}
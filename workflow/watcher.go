@@ -0,0 +1,161 @@
+package workflow
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/3dsim/workflow-goclient/models"
+)
+
+// maxWatchBackoffRounds bounds how many polling rounds a workflow is skipped after repeated errors getting it
+const maxWatchBackoffRounds = 16
+
+// WorkflowUpdate is sent by a BatchWatcher when a watched workflow is seen for the first time, when its state changes
+// and when getting it fails (Err set, Workflow nil).
+type WorkflowUpdate struct {
+	WorkflowID string
+	Workflow   *models.Workflow
+	Err        error
+}
+
+// BatchWatcher polls many workflows from a single goroutine and sends their state changes on one channel.  The
+// requests of a polling round are spread evenly over the interval instead of being made all at once, workflows that
+// reached a terminal state stop being polled and workflows that fail to be fetched are polled less and less often
+// (backing off up to 16 rounds) until a request succeeds again.
+//
+//	watcher := workflow.NewBatchWatcher(client, 30*time.Second)
+//	watcher.Watch(workflowIDs...)
+//	go watcher.Run(ctx)
+//	for update := range watcher.Updates() {
+//		...
+//	}
+type BatchWatcher struct {
+	client   Client
+	interval time.Duration
+	updates  chan WorkflowUpdate
+
+	mu    sync.Mutex
+	added []string
+}
+
+// watchedWorkflow is the polling state of one workflow, only used by the goroutine running BatchWatcher.Run
+type watchedWorkflow struct {
+	id    string
+	state string
+	// errors counts the consecutive errors, skip the rounds left before polling again
+	errors int
+	skip   int
+}
+
+// NewBatchWatcher returns a BatchWatcher that polls every watched workflow once per interval with client
+func NewBatchWatcher(client Client, interval time.Duration) *BatchWatcher {
+	return &BatchWatcher{
+		client:   client,
+		interval: interval,
+		updates:  make(chan WorkflowUpdate),
+	}
+}
+
+// Watch adds workflows to poll.  It can be called before and while Run is running, added workflows are polled from the
+// next round on.
+func (b *BatchWatcher) Watch(workflowIDs ...string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.added = append(b.added, workflowIDs...)
+}
+
+// Updates returns the channel updates are sent on.  It is closed when Run returns.
+func (b *BatchWatcher) Updates() <-chan WorkflowUpdate {
+	return b.updates
+}
+
+// Run polls the watched workflows until ctx is closed.  Requests are made with ctx so closing it also aborts the
+// request in flight.
+func (b *BatchWatcher) Run(ctx context.Context) {
+	defer close(b.updates)
+	client := b.client.WithContext(ctx)
+	var watched []*watchedWorkflow
+	ids := map[string]bool{}
+	for {
+		b.mu.Lock()
+		for _, id := range b.added {
+			if !ids[id] {
+				ids[id] = true
+				watched = append(watched, &watchedWorkflow{id: id})
+			}
+		}
+		b.added = nil
+		b.mu.Unlock()
+
+		if len(watched) == 0 {
+			// nothing to poll, wait a round for workflows to be added
+			if !sleep(ctx, b.interval) {
+				return
+			}
+			continue
+		}
+		stagger := b.interval / time.Duration(len(watched))
+		remaining := watched[:0]
+		for _, w := range watched {
+			if !sleep(ctx, stagger) {
+				return
+			}
+			update, ok := b.poll(client, w)
+			if ok {
+				select {
+				case b.updates <- update:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if !isTerminalState(w.state) {
+				remaining = append(remaining, w)
+			}
+		}
+		watched = remaining
+	}
+}
+
+// poll gets the workflow unless it is backing off and tells if there is an update to send
+func (b *BatchWatcher) poll(client Client, w *watchedWorkflow) (WorkflowUpdate, bool) {
+	if w.skip > 0 {
+		w.skip--
+		return WorkflowUpdate{}, false
+	}
+	workflow, err := client.GetWorkflow(w.id)
+	if err != nil {
+		w.errors++
+		w.skip = 1 << uint(w.errors-1)
+		if w.skip > maxWatchBackoffRounds {
+			w.skip = maxWatchBackoffRounds
+		}
+		return WorkflowUpdate{WorkflowID: w.id, Err: err}, true
+	}
+	w.errors = 0
+	if workflow.State == w.state {
+		return WorkflowUpdate{}, false
+	}
+	w.state = workflow.State
+	return WorkflowUpdate{WorkflowID: w.id, Workflow: workflow}, true
+}
+
+func isTerminalState(state string) bool {
+	switch state {
+	case models.WorkflowStateCompleted, models.WorkflowStateFailed, models.WorkflowStateCancelled, models.WorkflowStateTimedOut:
+		return true
+	}
+	return false
+}
+
+// sleep waits for d and tells if ctx is still open
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
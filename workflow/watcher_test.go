@@ -0,0 +1,118 @@
+package workflow
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/3dsim/auth0/auth0fakes"
+	"github.com/3dsim/workflow-goclient/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchWatcher(t *testing.T) {
+	// arrange
+	endpoint := "/" + workflowAPIBasePath + "/workflows/{workflowID}"
+	// newServer answers with the next state of the workflow for every request, "" sends a 500 error
+	newServer := func(states map[string][]string) (*httptest.Server, func(string) int) {
+		var mu sync.Mutex
+		requests := map[string]int{}
+		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			workflowID := mux.Vars(r)["workflowID"]
+			mu.Lock()
+			workflowStates := states[workflowID]
+			state := workflowStates[len(workflowStates)-1]
+			if requests[workflowID] < len(workflowStates) {
+				state = workflowStates[requests[workflowID]]
+			}
+			requests[workflowID]++
+			mu.Unlock()
+			if state == "" {
+				w.WriteHeader(500)
+				return
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"id":"` + workflowID + `","state":"` + state + `"}`))
+		})
+		r := mux.NewRouter()
+		r.HandleFunc(endpoint, handler)
+		return httptest.NewServer(r), func(workflowID string) int {
+			mu.Lock()
+			defer mu.Unlock()
+			return requests[workflowID]
+		}
+	}
+
+	t.Run("WhenWatchingSeveralWorkflowsExpectsStateChangesOnOneChannel", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		testServer, requests := newServer(map[string][]string{
+			"wf-1": {models.WorkflowStateRunning, models.WorkflowStateCompleted},
+			"wf-2": {models.WorkflowStateRunning, models.WorkflowStateRunning, models.WorkflowStateFailed},
+			"wf-3": {"", models.WorkflowStateCancelled},
+		})
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		watcher := NewBatchWatcher(client, 30*time.Millisecond)
+		watcher.Watch("wf-1", "wf-2", "wf-3")
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		// act
+		go watcher.Run(ctx)
+		received := map[string][]string{}
+		for ended := 0; ended < 3; {
+			update, ok := <-watcher.Updates()
+			if !ok {
+				assert.Fail(t, "Expected every workflow to end before the timeout")
+				return
+			}
+			if update.Err != nil {
+				received[update.WorkflowID] = append(received[update.WorkflowID], "error")
+				continue
+			}
+			received[update.WorkflowID] = append(received[update.WorkflowID], update.Workflow.State)
+			if isTerminalState(update.Workflow.State) {
+				ended++
+			}
+		}
+		time.Sleep(100 * time.Millisecond)
+
+		// assert
+		assert.Equal(t, []string{models.WorkflowStateRunning, models.WorkflowStateCompleted}, received["wf-1"], "Expected one update per state of wf-1")
+		assert.Equal(t, []string{models.WorkflowStateRunning, models.WorkflowStateFailed}, received["wf-2"], "Expected no update while wf-2 stayed running")
+		assert.Equal(t, []string{"error", models.WorkflowStateCancelled}, received["wf-3"], "Expected the error of wf-3 to be sent")
+		assert.Equal(t, 2, requests("wf-1"), "Expected wf-1 to not be polled after it completed")
+		assert.Equal(t, 3, requests("wf-2"), "Expected wf-2 to not be polled after it failed")
+	})
+
+	t.Run("WhenContextClosedExpectsUpdatesClosed", func(t *testing.T) {
+		// arrange
+		fakeTokenFetcher := &auth0fakes.FakeTokenFetcher{}
+		fakeTokenFetcher.TokenReturns("token", nil)
+		testServer, _ := newServer(map[string][]string{"wf-1": {models.WorkflowStateRunning}})
+		defer testServer.Close()
+		client := NewClient(fakeTokenFetcher, testServer.URL, workflowAPIBasePath, audience, logger)
+		watcher := NewBatchWatcher(client, 10*time.Millisecond)
+		watcher.Watch("wf-1")
+		ctx, cancel := context.WithCancel(context.Background())
+
+		// act
+		go watcher.Run(ctx)
+		<-watcher.Updates()
+		cancel()
+
+		// assert
+		select {
+		case _, ok := <-watcher.Updates():
+			assert.False(t, ok, "Expected no update after the context was closed")
+		case <-time.After(5 * time.Second):
+			assert.Fail(t, "Expected the updates channel to be closed")
+		}
+	})
+}
@@ -2,7 +2,11 @@
 package workflowfakes
 
 import (
+	"context"
+	"encoding/json"
+	"io"
 	"sync"
+	"time"
 
 	"github.com/3dsim/workflow-goclient/models"
 	"github.com/3dsim/workflow-goclient/workflow"
@@ -22,6 +26,72 @@ type FakeClient struct {
 		result1 string
 		result2 error
 	}
+	StartWorkflowNoQueueStub        func(*models.PostWorkflow) (string, error)
+	startWorkflowNoQueueMutex       sync.RWMutex
+	startWorkflowNoQueueArgsForCall []struct {
+		arg1 *models.PostWorkflow
+	}
+	startWorkflowNoQueueReturns struct {
+		result1 string
+		result2 error
+	}
+	startWorkflowNoQueueReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	StartWorkflowIfNotExistsStub        func(*models.PostWorkflow) (workflowID string, created bool, err error)
+	startWorkflowIfNotExistsMutex       sync.RWMutex
+	startWorkflowIfNotExistsArgsForCall []struct {
+		arg1 *models.PostWorkflow
+	}
+	startWorkflowIfNotExistsReturns struct {
+		result1 string
+		result2 bool
+		result3 error
+	}
+	startWorkflowIfNotExistsReturnsOnCall map[int]struct {
+		result1 string
+		result2 bool
+		result3 error
+	}
+	CloneWorkflowStub        func(sourceWorkflowID string, overrides *models.PostWorkflow) (string, error)
+	cloneWorkflowMutex       sync.RWMutex
+	cloneWorkflowArgsForCall []struct {
+		sourceWorkflowID string
+		overrides        *models.PostWorkflow
+	}
+	cloneWorkflowReturns struct {
+		result1 string
+		result2 error
+	}
+	cloneWorkflowReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	GetWorkflowParametersStub        func(workflowID string) (*models.PostWorkflow, error)
+	getWorkflowParametersMutex       sync.RWMutex
+	getWorkflowParametersArgsForCall []struct {
+		workflowID string
+	}
+	getWorkflowParametersReturns struct {
+		result1 *models.PostWorkflow
+		result2 error
+	}
+	getWorkflowParametersReturnsOnCall map[int]struct {
+		result1 *models.PostWorkflow
+		result2 error
+	}
+	ValidateWorkflowStub        func(*models.PostWorkflow) error
+	validateWorkflowMutex       sync.RWMutex
+	validateWorkflowArgsForCall []struct {
+		arg1 *models.PostWorkflow
+	}
+	validateWorkflowReturns struct {
+		result1 error
+	}
+	validateWorkflowReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CancelWorkflowStub        func(workflowID string) error
 	cancelWorkflowMutex       sync.RWMutex
 	cancelWorkflowArgsForCall []struct {
@@ -33,6 +103,204 @@ type FakeClient struct {
 	cancelWorkflowReturnsOnCall map[int]struct {
 		result1 error
 	}
+	TransferWorkflowStub        func(workflowID string, newOrganizationID int32) error
+	transferWorkflowMutex       sync.RWMutex
+	transferWorkflowArgsForCall []struct {
+		workflowID        string
+		newOrganizationID int32
+	}
+	transferWorkflowReturns struct {
+		result1 error
+	}
+	transferWorkflowReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateWorkflowMetadataStub        func(workflowID string, description string, metadata map[string]string) error
+	updateWorkflowMetadataMutex       sync.RWMutex
+	updateWorkflowMetadataArgsForCall []struct {
+		workflowID  string
+		description string
+		metadata    map[string]string
+	}
+	updateWorkflowMetadataReturns struct {
+		result1 error
+	}
+	updateWorkflowMetadataReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetWorkflowStub        func(workflowID string) (*models.Workflow, error)
+	getWorkflowMutex       sync.RWMutex
+	getWorkflowArgsForCall []struct {
+		workflowID string
+	}
+	getWorkflowReturns struct {
+		result1 *models.Workflow
+		result2 error
+	}
+	getWorkflowReturnsOnCall map[int]struct {
+		result1 *models.Workflow
+		result2 error
+	}
+	GetWorkflowByExternalIDStub        func(externalID string, organizationID int32) (*models.Workflow, error)
+	getWorkflowByExternalIDMutex       sync.RWMutex
+	getWorkflowByExternalIDArgsForCall []struct {
+		externalID     string
+		organizationID int32
+	}
+	getWorkflowByExternalIDReturns struct {
+		result1 *models.Workflow
+		result2 error
+	}
+	getWorkflowByExternalIDReturnsOnCall map[int]struct {
+		result1 *models.Workflow
+		result2 error
+	}
+	GetChildWorkflowsStub        func(parentWorkflowID string) ([]*models.Workflow, error)
+	getChildWorkflowsMutex       sync.RWMutex
+	getChildWorkflowsArgsForCall []struct {
+		parentWorkflowID string
+	}
+	getChildWorkflowsReturns struct {
+		result1 []*models.Workflow
+		result2 error
+	}
+	getChildWorkflowsReturnsOnCall map[int]struct {
+		result1 []*models.Workflow
+		result2 error
+	}
+	GetWorkflowHistoryStub        func(workflowID string) ([]*models.HistoryEvent, error)
+	getWorkflowHistoryMutex       sync.RWMutex
+	getWorkflowHistoryArgsForCall []struct {
+		workflowID string
+	}
+	getWorkflowHistoryReturns struct {
+		result1 []*models.HistoryEvent
+		result2 error
+	}
+	getWorkflowHistoryReturnsOnCall map[int]struct {
+		result1 []*models.HistoryEvent
+		result2 error
+	}
+	ListWorkflowHistoryPageStub        func(workflowID, cursor string, limit int) (events []*models.HistoryEvent, nextCursor string, err error)
+	listWorkflowHistoryPageMutex       sync.RWMutex
+	listWorkflowHistoryPageArgsForCall []struct {
+		workflowID string
+		cursor     string
+		limit      int
+	}
+	listWorkflowHistoryPageReturns struct {
+		result1 []*models.HistoryEvent
+		result2 string
+		result3 error
+	}
+	listWorkflowHistoryPageReturnsOnCall map[int]struct {
+		result1 []*models.HistoryEvent
+		result2 string
+		result3 error
+	}
+	ForEachHistoryEventStub        func(workflowID string, filter workflow.HistoryFilter, fn func(*models.HistoryEvent) error) error
+	forEachHistoryEventMutex       sync.RWMutex
+	forEachHistoryEventArgsForCall []struct {
+		workflowID string
+		filter     workflow.HistoryFilter
+		fn         func(*models.HistoryEvent) error
+	}
+	forEachHistoryEventReturns struct {
+		result1 error
+	}
+	forEachHistoryEventReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExportWorkflowStub        func(workflowID string) (*models.WorkflowBundle, error)
+	exportWorkflowMutex       sync.RWMutex
+	exportWorkflowArgsForCall []struct {
+		workflowID string
+	}
+	exportWorkflowReturns struct {
+		result1 *models.WorkflowBundle
+		result2 error
+	}
+	exportWorkflowReturnsOnCall map[int]struct {
+		result1 *models.WorkflowBundle
+		result2 error
+	}
+	SignalWorkflowStub        func(workflowID string, signal *models.Signal) error
+	signalWorkflowMutex       sync.RWMutex
+	signalWorkflowArgsForCall []struct {
+		workflowID string
+		signal     *models.Signal
+	}
+	signalWorkflowReturns struct {
+		result1 error
+	}
+	signalWorkflowReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SignalWorkflowIfRunningStub        func(workflowID string, signal *models.Signal) error
+	signalWorkflowIfRunningMutex       sync.RWMutex
+	signalWorkflowIfRunningArgsForCall []struct {
+		workflowID string
+		signal     *models.Signal
+	}
+	signalWorkflowIfRunningReturns struct {
+		result1 error
+	}
+	signalWorkflowIfRunningReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetSignalStatusStub        func(workflowID, signalName string) (*models.SignalStatus, error)
+	getSignalStatusMutex       sync.RWMutex
+	getSignalStatusArgsForCall []struct {
+		workflowID string
+		signalName string
+	}
+	getSignalStatusReturns struct {
+		result1 *models.SignalStatus
+		result2 error
+	}
+	getSignalStatusReturnsOnCall map[int]struct {
+		result1 *models.SignalStatus
+		result2 error
+	}
+	RegisterWebhookStub        func(workflowID, callbackURL string, events []string) (webhookID string, err error)
+	registerWebhookMutex       sync.RWMutex
+	registerWebhookArgsForCall []struct {
+		workflowID  string
+		callbackURL string
+		events      []string
+	}
+	registerWebhookReturns struct {
+		result1 string
+		result2 error
+	}
+	registerWebhookReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	UnregisterWebhookStub        func(webhookID string) error
+	unregisterWebhookMutex       sync.RWMutex
+	unregisterWebhookArgsForCall []struct {
+		webhookID string
+	}
+	unregisterWebhookReturns struct {
+		result1 error
+	}
+	unregisterWebhookReturnsOnCall map[int]struct {
+		result1 error
+	}
+	WorkflowRawStub        func(workflowID string) (json.RawMessage, error)
+	workflowRawMutex       sync.RWMutex
+	workflowRawArgsForCall []struct {
+		workflowID string
+	}
+	workflowRawReturns struct {
+		result1 json.RawMessage
+		result2 error
+	}
+	workflowRawReturnsOnCall map[int]struct {
+		result1 json.RawMessage
+		result2 error
+	}
 	UpdateActivityStub        func(workflowID string, activity *models.Activity) (*models.Activity, error)
 	updateActivityMutex       sync.RWMutex
 	updateActivityArgsForCall []struct {
@@ -62,6 +330,35 @@ type FakeClient struct {
 		result1 *models.Activity
 		result2 error
 	}
+	UpdateActivitiesPercentCompleteStub        func(workflowID string, progress map[string]int) ([]*models.Activity, []error)
+	updateActivitiesPercentCompleteMutex       sync.RWMutex
+	updateActivitiesPercentCompleteArgsForCall []struct {
+		workflowID string
+		progress   map[string]int
+	}
+	updateActivitiesPercentCompleteReturns struct {
+		result1 []*models.Activity
+		result2 []error
+	}
+	updateActivitiesPercentCompleteReturnsOnCall map[int]struct {
+		result1 []*models.Activity
+		result2 []error
+	}
+	PatchActivityStub        func(workflowID, activityID string, fields map[string]interface{}) (*models.Activity, error)
+	patchActivityMutex       sync.RWMutex
+	patchActivityArgsForCall []struct {
+		workflowID string
+		activityID string
+		fields     map[string]interface{}
+	}
+	patchActivityReturns struct {
+		result1 *models.Activity
+		result2 error
+	}
+	patchActivityReturnsOnCall map[int]struct {
+		result1 *models.Activity
+		result2 error
+	}
 	CompleteSuccessfulActivityStub        func(workflowID, activityID string, result interface{}) (*models.Activity, error)
 	completeSuccessfulActivityMutex       sync.RWMutex
 	completeSuccessfulActivityArgsForCall []struct {
@@ -77,6 +374,21 @@ type FakeClient struct {
 		result1 *models.Activity
 		result2 error
 	}
+	CompleteSuccessfulActivityStreamStub        func(workflowID, activityID string, r io.Reader) (*models.Activity, error)
+	completeSuccessfulActivityStreamMutex       sync.RWMutex
+	completeSuccessfulActivityStreamArgsForCall []struct {
+		workflowID string
+		activityID string
+		r          io.Reader
+	}
+	completeSuccessfulActivityStreamReturns struct {
+		result1 *models.Activity
+		result2 error
+	}
+	completeSuccessfulActivityStreamReturnsOnCall map[int]struct {
+		result1 *models.Activity
+		result2 error
+	}
 	CompleteCancelledActivityStub        func(workflowID, activityID, reason, details string) (*models.Activity, error)
 	completeCancelledActivityMutex       sync.RWMutex
 	completeCancelledActivityArgsForCall []struct {
@@ -93,6 +405,23 @@ type FakeClient struct {
 		result1 *models.Activity
 		result2 error
 	}
+	CompleteCancelledActivityWithResultStub        func(workflowID, activityID, reason, details string, partialResult interface{}) (*models.Activity, error)
+	completeCancelledActivityWithResultMutex       sync.RWMutex
+	completeCancelledActivityWithResultArgsForCall []struct {
+		workflowID    string
+		activityID    string
+		reason        string
+		details       string
+		partialResult interface{}
+	}
+	completeCancelledActivityWithResultReturns struct {
+		result1 *models.Activity
+		result2 error
+	}
+	completeCancelledActivityWithResultReturnsOnCall map[int]struct {
+		result1 *models.Activity
+		result2 error
+	}
 	CompleteFailedActivityStub        func(workflowID, activityID, reason, details string) (*models.Activity, error)
 	completeFailedActivityMutex       sync.RWMutex
 	completeFailedActivityArgsForCall []struct {
@@ -109,6 +438,51 @@ type FakeClient struct {
 		result1 *models.Activity
 		result2 error
 	}
+	CompleteFailedActivityNonBlockingStub        func(workflowID, activityID, reason, details string) (*models.Activity, error)
+	completeFailedActivityNonBlockingMutex       sync.RWMutex
+	completeFailedActivityNonBlockingArgsForCall []struct {
+		workflowID string
+		activityID string
+		reason     string
+		details    string
+	}
+	completeFailedActivityNonBlockingReturns struct {
+		result1 *models.Activity
+		result2 error
+	}
+	completeFailedActivityNonBlockingReturnsOnCall map[int]struct {
+		result1 *models.Activity
+		result2 error
+	}
+	CompleteActivityStub        func(workflowID, activityID string, outcome workflow.ActivityOutcome) (*models.Activity, error)
+	completeActivityMutex       sync.RWMutex
+	completeActivityArgsForCall []struct {
+		workflowID string
+		activityID string
+		outcome    workflow.ActivityOutcome
+	}
+	completeActivityReturns struct {
+		result1 *models.Activity
+		result2 error
+	}
+	completeActivityReturnsOnCall map[int]struct {
+		result1 *models.Activity
+		result2 error
+	}
+	RetryActivityStub        func(workflowID, activityID string) (*models.Activity, error)
+	retryActivityMutex       sync.RWMutex
+	retryActivityArgsForCall []struct {
+		workflowID string
+		activityID string
+	}
+	retryActivityReturns struct {
+		result1 *models.Activity
+		result2 error
+	}
+	retryActivityReturnsOnCall map[int]struct {
+		result1 *models.Activity
+		result2 error
+	}
 	HeartbeatActivityStub        func(workflowID, activityID string) (*models.Heartbeat, error)
 	heartbeatActivityMutex       sync.RWMutex
 	heartbeatActivityArgsForCall []struct {
@@ -123,6 +497,46 @@ type FakeClient struct {
 		result1 *models.Heartbeat
 		result2 error
 	}
+	AppendActivityLogsStub        func(workflowID, activityID string, lines []*models.LogLine) error
+	appendActivityLogsMutex       sync.RWMutex
+	appendActivityLogsArgsForCall []struct {
+		workflowID string
+		activityID string
+		lines      []*models.LogLine
+	}
+	appendActivityLogsReturns struct {
+		result1 error
+	}
+	appendActivityLogsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetActivityLogsStub        func(workflowID, activityID string, since time.Time) ([]*models.LogLine, error)
+	getActivityLogsMutex       sync.RWMutex
+	getActivityLogsArgsForCall []struct {
+		workflowID string
+		activityID string
+		since      time.Time
+	}
+	getActivityLogsReturns struct {
+		result1 []*models.LogLine
+		result2 error
+	}
+	getActivityLogsReturnsOnCall map[int]struct {
+		result1 []*models.LogLine
+		result2 error
+	}
+	DownloadActivityResultStub        func(activity *models.Activity, w io.Writer) error
+	downloadActivityResultMutex       sync.RWMutex
+	downloadActivityResultArgsForCall []struct {
+		activity *models.Activity
+		w        io.Writer
+	}
+	downloadActivityResultReturns struct {
+		result1 error
+	}
+	downloadActivityResultReturnsOnCall map[int]struct {
+		result1 error
+	}
 	HeartbeatActivityWithTokenStub        func(taskToken, activityID, details string) (*models.Heartbeat, error)
 	heartbeatActivityWithTokenMutex       sync.RWMutex
 	heartbeatActivityWithTokenArgsForCall []struct {
@@ -138,478 +552,3592 @@ type FakeClient struct {
 		result1 *models.Heartbeat
 		result2 error
 	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
-}
-
-func (fake *FakeClient) StartWorkflow(arg1 *models.PostWorkflow) (string, error) {
-	fake.startWorkflowMutex.Lock()
-	ret, specificReturn := fake.startWorkflowReturnsOnCall[len(fake.startWorkflowArgsForCall)]
-	fake.startWorkflowArgsForCall = append(fake.startWorkflowArgsForCall, struct {
-		arg1 *models.PostWorkflow
-	}{arg1})
-	fake.recordInvocation("StartWorkflow", []interface{}{arg1})
-	fake.startWorkflowMutex.Unlock()
-	if fake.StartWorkflowStub != nil {
-		return fake.StartWorkflowStub(arg1)
+	AbandonActivityStub        func(taskToken, activityID, details string) error
+	abandonActivityMutex       sync.RWMutex
+	abandonActivityArgsForCall []struct {
+		taskToken  string
+		activityID string
+		details    string
 	}
-	if specificReturn {
-		return ret.result1, ret.result2
+	abandonActivityReturns struct {
+		result1 error
 	}
-	return fake.startWorkflowReturns.result1, fake.startWorkflowReturns.result2
-}
-
-func (fake *FakeClient) StartWorkflowCallCount() int {
-	fake.startWorkflowMutex.RLock()
-	defer fake.startWorkflowMutex.RUnlock()
-	return len(fake.startWorkflowArgsForCall)
-}
-
-func (fake *FakeClient) StartWorkflowArgsForCall(i int) *models.PostWorkflow {
-	fake.startWorkflowMutex.RLock()
-	defer fake.startWorkflowMutex.RUnlock()
-	return fake.startWorkflowArgsForCall[i].arg1
-}
-
-func (fake *FakeClient) StartWorkflowReturns(result1 string, result2 error) {
-	fake.StartWorkflowStub = nil
-	fake.startWorkflowReturns = struct {
-		result1 string
+	abandonActivityReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ExtendHeartbeatTimeoutStub        func(taskToken, activityID string, extension time.Duration) (*models.Heartbeat, error)
+	extendHeartbeatTimeoutMutex       sync.RWMutex
+	extendHeartbeatTimeoutArgsForCall []struct {
+		taskToken  string
+		activityID string
+		extension  time.Duration
+	}
+	extendHeartbeatTimeoutReturns struct {
+		result1 *models.Heartbeat
 		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakeClient) StartWorkflowReturnsOnCall(i int, result1 string, result2 error) {
-	fake.StartWorkflowStub = nil
-	if fake.startWorkflowReturnsOnCall == nil {
-		fake.startWorkflowReturnsOnCall = make(map[int]struct {
-			result1 string
-			result2 error
-		})
 	}
-	fake.startWorkflowReturnsOnCall[i] = struct {
-		result1 string
+	extendHeartbeatTimeoutReturnsOnCall map[int]struct {
+		result1 *models.Heartbeat
 		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakeClient) CancelWorkflow(workflowID string) error {
+	}
+	IsCancellationRequestedStub        func(workflowID, activityID string) (bool, error)
+	isCancellationRequestedMutex       sync.RWMutex
+	isCancellationRequestedArgsForCall []struct {
+		workflowID string
+		activityID string
+	}
+	isCancellationRequestedReturns struct {
+		result1 bool
+		result2 error
+	}
+	isCancellationRequestedReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	GetActivityTaskTokenStub        func(workflowID, activityID string) (string, error)
+	getActivityTaskTokenMutex       sync.RWMutex
+	getActivityTaskTokenArgsForCall []struct {
+		workflowID string
+		activityID string
+	}
+	getActivityTaskTokenReturns struct {
+		result1 string
+		result2 error
+	}
+	getActivityTaskTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	LookupByTaskTokenStub        func(taskToken string) (workflowID, activityID string, err error)
+	lookupByTaskTokenMutex       sync.RWMutex
+	lookupByTaskTokenArgsForCall []struct {
+		taskToken string
+	}
+	lookupByTaskTokenReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	lookupByTaskTokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	HeartbeatActivitiesStub        func(taskTokens map[string]string) (map[string]*models.Heartbeat, error)
+	heartbeatActivitiesMutex       sync.RWMutex
+	heartbeatActivitiesArgsForCall []struct {
+		taskTokens map[string]string
+	}
+	heartbeatActivitiesReturns struct {
+		result1 map[string]*models.Heartbeat
+		result2 error
+	}
+	heartbeatActivitiesReturnsOnCall map[int]struct {
+		result1 map[string]*models.Heartbeat
+		result2 error
+	}
+	GetActivityStub        func(workflowID, activityID string) (*models.Activity, error)
+	getActivityMutex       sync.RWMutex
+	getActivityArgsForCall []struct {
+		workflowID string
+		activityID string
+	}
+	getActivityReturns struct {
+		result1 *models.Activity
+		result2 error
+	}
+	getActivityReturnsOnCall map[int]struct {
+		result1 *models.Activity
+		result2 error
+	}
+	ListActivitiesStub        func(workflowID string) ([]*models.Activity, error)
+	listActivitiesMutex       sync.RWMutex
+	listActivitiesArgsForCall []struct {
+		workflowID string
+	}
+	listActivitiesReturns struct {
+		result1 []*models.Activity
+		result2 error
+	}
+	listActivitiesReturnsOnCall map[int]struct {
+		result1 []*models.Activity
+		result2 error
+	}
+	WorkflowProgressStub        func(workflowID string) (int, error)
+	workflowProgressMutex       sync.RWMutex
+	workflowProgressArgsForCall []struct {
+		workflowID string
+	}
+	workflowProgressReturns struct {
+		result1 int
+		result2 error
+	}
+	workflowProgressReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	CurrentActivityStub        func(workflowID string) (*models.Activity, error)
+	currentActivityMutex       sync.RWMutex
+	currentActivityArgsForCall []struct {
+		workflowID string
+	}
+	currentActivityReturns struct {
+		result1 *models.Activity
+		result2 error
+	}
+	currentActivityReturnsOnCall map[int]struct {
+		result1 *models.Activity
+		result2 error
+	}
+	ListActivitiesPageStub        func(workflowID, cursor string, limit int) (activities []*models.Activity, nextCursor string, err error)
+	listActivitiesPageMutex       sync.RWMutex
+	listActivitiesPageArgsForCall []struct {
+		workflowID string
+		cursor     string
+		limit      int
+	}
+	listActivitiesPageReturns struct {
+		result1 []*models.Activity
+		result2 string
+		result3 error
+	}
+	listActivitiesPageReturnsOnCall map[int]struct {
+		result1 []*models.Activity
+		result2 string
+		result3 error
+	}
+	ListWorkflowsStub        func(filter workflow.WorkflowFilter) ([]*models.Workflow, error)
+	listWorkflowsMutex       sync.RWMutex
+	listWorkflowsArgsForCall []struct {
+		filter workflow.WorkflowFilter
+	}
+	listWorkflowsReturns struct {
+		result1 []*models.Workflow
+		result2 error
+	}
+	listWorkflowsReturnsOnCall map[int]struct {
+		result1 []*models.Workflow
+		result2 error
+	}
+	SearchWorkflowsStub        func(query string, organizationID int32) ([]*models.Workflow, error)
+	searchWorkflowsMutex       sync.RWMutex
+	searchWorkflowsArgsForCall []struct {
+		query          string
+		organizationID int32
+	}
+	searchWorkflowsReturns struct {
+		result1 []*models.Workflow
+		result2 error
+	}
+	searchWorkflowsReturnsOnCall map[int]struct {
+		result1 []*models.Workflow
+		result2 error
+	}
+	ListWorkflowsPageStub        func(filter workflow.WorkflowFilter, cursor string, limit int) (workflows []*models.Workflow, nextCursor string, err error)
+	listWorkflowsPageMutex       sync.RWMutex
+	listWorkflowsPageArgsForCall []struct {
+		filter workflow.WorkflowFilter
+		cursor string
+		limit  int
+	}
+	listWorkflowsPageReturns struct {
+		result1 []*models.Workflow
+		result2 string
+		result3 error
+	}
+	listWorkflowsPageReturnsOnCall map[int]struct {
+		result1 []*models.Workflow
+		result2 string
+		result3 error
+	}
+	ForEachWorkflowStub        func(filter workflow.WorkflowFilter, fn func(*models.Workflow) error) error
+	forEachWorkflowMutex       sync.RWMutex
+	forEachWorkflowArgsForCall []struct {
+		filter workflow.WorkflowFilter
+		fn     func(*models.Workflow) error
+	}
+	forEachWorkflowReturns struct {
+		result1 error
+	}
+	forEachWorkflowReturnsOnCall map[int]struct {
+		result1 error
+	}
+	CancelWorkflowsForEntityStub        func(entityID, organizationID int32) ([]string, error)
+	cancelWorkflowsForEntityMutex       sync.RWMutex
+	cancelWorkflowsForEntityArgsForCall []struct {
+		entityID       int32
+		organizationID int32
+	}
+	cancelWorkflowsForEntityReturns struct {
+		result1 []string
+		result2 error
+	}
+	cancelWorkflowsForEntityReturnsOnCall map[int]struct {
+		result1 []string
+		result2 error
+	}
+	RecentRequestsStub        func() []workflow.RecordedRequest
+	recentRequestsMutex       sync.RWMutex
+	recentRequestsArgsForCall []struct {
+	}
+	recentRequestsReturns struct {
+		result1 []workflow.RecordedRequest
+	}
+	recentRequestsReturnsOnCall map[int]struct {
+		result1 []workflow.RecordedRequest
+	}
+	GetWorkflowTypeStub        func(workflowType string) (*models.WorkflowTypeInfo, error)
+	getWorkflowTypeMutex       sync.RWMutex
+	getWorkflowTypeArgsForCall []struct {
+		workflowType string
+	}
+	getWorkflowTypeReturns struct {
+		result1 *models.WorkflowTypeInfo
+		result2 error
+	}
+	getWorkflowTypeReturnsOnCall map[int]struct {
+		result1 *models.WorkflowTypeInfo
+		result2 error
+	}
+	WatchCapacityStub        func(ctx context.Context, organizationID int32) (<-chan *models.CapacityEvent, error)
+	watchCapacityMutex       sync.RWMutex
+	watchCapacityArgsForCall []struct {
+		ctx            context.Context
+		organizationID int32
+	}
+	watchCapacityReturns struct {
+		result1 <-chan *models.CapacityEvent
+		result2 error
+	}
+	watchCapacityReturnsOnCall map[int]struct {
+		result1 <-chan *models.CapacityEvent
+		result2 error
+	}
+	WaitForStateStub        func(ctx context.Context, workflowID, target string, pollInterval time.Duration) (*models.Workflow, error)
+	waitForStateMutex       sync.RWMutex
+	waitForStateArgsForCall []struct {
+		ctx          context.Context
+		workflowID   string
+		target       string
+		pollInterval time.Duration
+	}
+	waitForStateReturns struct {
+		result1 *models.Workflow
+		result2 error
+	}
+	waitForStateReturnsOnCall map[int]struct {
+		result1 *models.Workflow
+		result2 error
+	}
+	APIGatewayURLStub        func() string
+	aPIGatewayURLMutex       sync.RWMutex
+	aPIGatewayURLArgsForCall []struct {
+	}
+	aPIGatewayURLReturns struct {
+		result1 string
+	}
+	aPIGatewayURLReturnsOnCall map[int]struct {
+		result1 string
+	}
+	APIBasePathStub        func() string
+	aPIBasePathMutex       sync.RWMutex
+	aPIBasePathArgsForCall []struct {
+	}
+	aPIBasePathReturns struct {
+		result1 string
+	}
+	aPIBasePathReturnsOnCall map[int]struct {
+		result1 string
+	}
+	AudienceStub        func() string
+	audienceMutex       sync.RWMutex
+	audienceArgsForCall []struct {
+	}
+	audienceReturns struct {
+		result1 string
+	}
+	audienceReturnsOnCall map[int]struct {
+		result1 string
+	}
+	WithContextStub        func(ctx context.Context) workflow.Client
+	withContextMutex       sync.RWMutex
+	withContextArgsForCall []struct {
+		ctx context.Context
+	}
+	withContextReturns struct {
+		result1 workflow.Client
+	}
+	withContextReturnsOnCall map[int]struct {
+		result1 workflow.Client
+	}
+	CloseStub        func() error
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	closeReturns struct {
+		result1 error
+	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClient) StartWorkflow(arg1 *models.PostWorkflow) (string, error) {
+	fake.startWorkflowMutex.Lock()
+	ret, specificReturn := fake.startWorkflowReturnsOnCall[len(fake.startWorkflowArgsForCall)]
+	fake.startWorkflowArgsForCall = append(fake.startWorkflowArgsForCall, struct {
+		arg1 *models.PostWorkflow
+	}{arg1})
+	fake.recordInvocation("StartWorkflow", []interface{}{arg1})
+	fake.startWorkflowMutex.Unlock()
+	if fake.StartWorkflowStub != nil {
+		return fake.StartWorkflowStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.startWorkflowReturns.result1, fake.startWorkflowReturns.result2
+}
+
+func (fake *FakeClient) StartWorkflowCallCount() int {
+	fake.startWorkflowMutex.RLock()
+	defer fake.startWorkflowMutex.RUnlock()
+	return len(fake.startWorkflowArgsForCall)
+}
+
+func (fake *FakeClient) StartWorkflowArgsForCall(i int) *models.PostWorkflow {
+	fake.startWorkflowMutex.RLock()
+	defer fake.startWorkflowMutex.RUnlock()
+	return fake.startWorkflowArgsForCall[i].arg1
+}
+
+func (fake *FakeClient) StartWorkflowReturns(result1 string, result2 error) {
+	fake.StartWorkflowStub = nil
+	fake.startWorkflowReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StartWorkflowReturnsOnCall(i int, result1 string, result2 error) {
+	fake.StartWorkflowStub = nil
+	if fake.startWorkflowReturnsOnCall == nil {
+		fake.startWorkflowReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.startWorkflowReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StartWorkflowNoQueue(arg1 *models.PostWorkflow) (string, error) {
+	fake.startWorkflowNoQueueMutex.Lock()
+	ret, specificReturn := fake.startWorkflowNoQueueReturnsOnCall[len(fake.startWorkflowNoQueueArgsForCall)]
+	fake.startWorkflowNoQueueArgsForCall = append(fake.startWorkflowNoQueueArgsForCall, struct {
+		arg1 *models.PostWorkflow
+	}{arg1})
+	fake.recordInvocation("StartWorkflowNoQueue", []interface{}{arg1})
+	fake.startWorkflowNoQueueMutex.Unlock()
+	if fake.StartWorkflowNoQueueStub != nil {
+		return fake.StartWorkflowNoQueueStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.startWorkflowNoQueueReturns.result1, fake.startWorkflowNoQueueReturns.result2
+}
+
+func (fake *FakeClient) StartWorkflowNoQueueCallCount() int {
+	fake.startWorkflowNoQueueMutex.RLock()
+	defer fake.startWorkflowNoQueueMutex.RUnlock()
+	return len(fake.startWorkflowNoQueueArgsForCall)
+}
+
+func (fake *FakeClient) StartWorkflowNoQueueArgsForCall(i int) *models.PostWorkflow {
+	fake.startWorkflowNoQueueMutex.RLock()
+	defer fake.startWorkflowNoQueueMutex.RUnlock()
+	return fake.startWorkflowNoQueueArgsForCall[i].arg1
+}
+
+func (fake *FakeClient) StartWorkflowNoQueueReturns(result1 string, result2 error) {
+	fake.StartWorkflowNoQueueStub = nil
+	fake.startWorkflowNoQueueReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StartWorkflowNoQueueReturnsOnCall(i int, result1 string, result2 error) {
+	fake.StartWorkflowNoQueueStub = nil
+	if fake.startWorkflowNoQueueReturnsOnCall == nil {
+		fake.startWorkflowNoQueueReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.startWorkflowNoQueueReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StartWorkflowIfNotExists(arg1 *models.PostWorkflow) (string, bool, error) {
+	fake.startWorkflowIfNotExistsMutex.Lock()
+	ret, specificReturn := fake.startWorkflowIfNotExistsReturnsOnCall[len(fake.startWorkflowIfNotExistsArgsForCall)]
+	fake.startWorkflowIfNotExistsArgsForCall = append(fake.startWorkflowIfNotExistsArgsForCall, struct {
+		arg1 *models.PostWorkflow
+	}{arg1})
+	fake.recordInvocation("StartWorkflowIfNotExists", []interface{}{arg1})
+	fake.startWorkflowIfNotExistsMutex.Unlock()
+	if fake.StartWorkflowIfNotExistsStub != nil {
+		return fake.StartWorkflowIfNotExistsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.startWorkflowIfNotExistsReturns.result1, fake.startWorkflowIfNotExistsReturns.result2, fake.startWorkflowIfNotExistsReturns.result3
+}
+
+func (fake *FakeClient) StartWorkflowIfNotExistsCallCount() int {
+	fake.startWorkflowIfNotExistsMutex.RLock()
+	defer fake.startWorkflowIfNotExistsMutex.RUnlock()
+	return len(fake.startWorkflowIfNotExistsArgsForCall)
+}
+
+func (fake *FakeClient) StartWorkflowIfNotExistsArgsForCall(i int) *models.PostWorkflow {
+	fake.startWorkflowIfNotExistsMutex.RLock()
+	defer fake.startWorkflowIfNotExistsMutex.RUnlock()
+	return fake.startWorkflowIfNotExistsArgsForCall[i].arg1
+}
+
+func (fake *FakeClient) StartWorkflowIfNotExistsReturns(result1 string, result2 bool, result3 error) {
+	fake.StartWorkflowIfNotExistsStub = nil
+	fake.startWorkflowIfNotExistsReturns = struct {
+		result1 string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) StartWorkflowIfNotExistsReturnsOnCall(i int, result1 string, result2 bool, result3 error) {
+	fake.StartWorkflowIfNotExistsStub = nil
+	if fake.startWorkflowIfNotExistsReturnsOnCall == nil {
+		fake.startWorkflowIfNotExistsReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 bool
+			result3 error
+		})
+	}
+	fake.startWorkflowIfNotExistsReturnsOnCall[i] = struct {
+		result1 string
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) CloneWorkflow(sourceWorkflowID string, overrides *models.PostWorkflow) (string, error) {
+	fake.cloneWorkflowMutex.Lock()
+	ret, specificReturn := fake.cloneWorkflowReturnsOnCall[len(fake.cloneWorkflowArgsForCall)]
+	fake.cloneWorkflowArgsForCall = append(fake.cloneWorkflowArgsForCall, struct {
+		sourceWorkflowID string
+		overrides        *models.PostWorkflow
+	}{sourceWorkflowID, overrides})
+	fake.recordInvocation("CloneWorkflow", []interface{}{sourceWorkflowID, overrides})
+	fake.cloneWorkflowMutex.Unlock()
+	if fake.CloneWorkflowStub != nil {
+		return fake.CloneWorkflowStub(sourceWorkflowID, overrides)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.cloneWorkflowReturns.result1, fake.cloneWorkflowReturns.result2
+}
+
+func (fake *FakeClient) CloneWorkflowCallCount() int {
+	fake.cloneWorkflowMutex.RLock()
+	defer fake.cloneWorkflowMutex.RUnlock()
+	return len(fake.cloneWorkflowArgsForCall)
+}
+
+func (fake *FakeClient) CloneWorkflowArgsForCall(i int) (string, *models.PostWorkflow) {
+	fake.cloneWorkflowMutex.RLock()
+	defer fake.cloneWorkflowMutex.RUnlock()
+	return fake.cloneWorkflowArgsForCall[i].sourceWorkflowID, fake.cloneWorkflowArgsForCall[i].overrides
+}
+
+func (fake *FakeClient) CloneWorkflowReturns(result1 string, result2 error) {
+	fake.CloneWorkflowStub = nil
+	fake.cloneWorkflowReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CloneWorkflowReturnsOnCall(i int, result1 string, result2 error) {
+	fake.CloneWorkflowStub = nil
+	if fake.cloneWorkflowReturnsOnCall == nil {
+		fake.cloneWorkflowReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.cloneWorkflowReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetWorkflowParameters(workflowID string) (*models.PostWorkflow, error) {
+	fake.getWorkflowParametersMutex.Lock()
+	ret, specificReturn := fake.getWorkflowParametersReturnsOnCall[len(fake.getWorkflowParametersArgsForCall)]
+	fake.getWorkflowParametersArgsForCall = append(fake.getWorkflowParametersArgsForCall, struct {
+		workflowID string
+	}{workflowID})
+	fake.recordInvocation("GetWorkflowParameters", []interface{}{workflowID})
+	fake.getWorkflowParametersMutex.Unlock()
+	if fake.GetWorkflowParametersStub != nil {
+		return fake.GetWorkflowParametersStub(workflowID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getWorkflowParametersReturns.result1, fake.getWorkflowParametersReturns.result2
+}
+
+func (fake *FakeClient) GetWorkflowParametersCallCount() int {
+	fake.getWorkflowParametersMutex.RLock()
+	defer fake.getWorkflowParametersMutex.RUnlock()
+	return len(fake.getWorkflowParametersArgsForCall)
+}
+
+func (fake *FakeClient) GetWorkflowParametersArgsForCall(i int) string {
+	fake.getWorkflowParametersMutex.RLock()
+	defer fake.getWorkflowParametersMutex.RUnlock()
+	return fake.getWorkflowParametersArgsForCall[i].workflowID
+}
+
+func (fake *FakeClient) GetWorkflowParametersReturns(result1 *models.PostWorkflow, result2 error) {
+	fake.GetWorkflowParametersStub = nil
+	fake.getWorkflowParametersReturns = struct {
+		result1 *models.PostWorkflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetWorkflowParametersReturnsOnCall(i int, result1 *models.PostWorkflow, result2 error) {
+	fake.GetWorkflowParametersStub = nil
+	if fake.getWorkflowParametersReturnsOnCall == nil {
+		fake.getWorkflowParametersReturnsOnCall = make(map[int]struct {
+			result1 *models.PostWorkflow
+			result2 error
+		})
+	}
+	fake.getWorkflowParametersReturnsOnCall[i] = struct {
+		result1 *models.PostWorkflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ValidateWorkflow(arg1 *models.PostWorkflow) error {
+	fake.validateWorkflowMutex.Lock()
+	ret, specificReturn := fake.validateWorkflowReturnsOnCall[len(fake.validateWorkflowArgsForCall)]
+	fake.validateWorkflowArgsForCall = append(fake.validateWorkflowArgsForCall, struct {
+		arg1 *models.PostWorkflow
+	}{arg1})
+	fake.recordInvocation("ValidateWorkflow", []interface{}{arg1})
+	fake.validateWorkflowMutex.Unlock()
+	if fake.ValidateWorkflowStub != nil {
+		return fake.ValidateWorkflowStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.validateWorkflowReturns.result1
+}
+
+func (fake *FakeClient) ValidateWorkflowCallCount() int {
+	fake.validateWorkflowMutex.RLock()
+	defer fake.validateWorkflowMutex.RUnlock()
+	return len(fake.validateWorkflowArgsForCall)
+}
+
+func (fake *FakeClient) ValidateWorkflowArgsForCall(i int) *models.PostWorkflow {
+	fake.validateWorkflowMutex.RLock()
+	defer fake.validateWorkflowMutex.RUnlock()
+	return fake.validateWorkflowArgsForCall[i].arg1
+}
+
+func (fake *FakeClient) ValidateWorkflowReturns(result1 error) {
+	fake.ValidateWorkflowStub = nil
+	fake.validateWorkflowReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ValidateWorkflowReturnsOnCall(i int, result1 error) {
+	fake.ValidateWorkflowStub = nil
+	if fake.validateWorkflowReturnsOnCall == nil {
+		fake.validateWorkflowReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateWorkflowReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CancelWorkflow(workflowID string) error {
 	fake.cancelWorkflowMutex.Lock()
 	ret, specificReturn := fake.cancelWorkflowReturnsOnCall[len(fake.cancelWorkflowArgsForCall)]
 	fake.cancelWorkflowArgsForCall = append(fake.cancelWorkflowArgsForCall, struct {
 		workflowID string
 	}{workflowID})
-	fake.recordInvocation("CancelWorkflow", []interface{}{workflowID})
-	fake.cancelWorkflowMutex.Unlock()
-	if fake.CancelWorkflowStub != nil {
-		return fake.CancelWorkflowStub(workflowID)
+	fake.recordInvocation("CancelWorkflow", []interface{}{workflowID})
+	fake.cancelWorkflowMutex.Unlock()
+	if fake.CancelWorkflowStub != nil {
+		return fake.CancelWorkflowStub(workflowID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.cancelWorkflowReturns.result1
+}
+
+func (fake *FakeClient) CancelWorkflowCallCount() int {
+	fake.cancelWorkflowMutex.RLock()
+	defer fake.cancelWorkflowMutex.RUnlock()
+	return len(fake.cancelWorkflowArgsForCall)
+}
+
+func (fake *FakeClient) CancelWorkflowArgsForCall(i int) string {
+	fake.cancelWorkflowMutex.RLock()
+	defer fake.cancelWorkflowMutex.RUnlock()
+	return fake.cancelWorkflowArgsForCall[i].workflowID
+}
+
+func (fake *FakeClient) CancelWorkflowReturns(result1 error) {
+	fake.CancelWorkflowStub = nil
+	fake.cancelWorkflowReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CancelWorkflowReturnsOnCall(i int, result1 error) {
+	fake.CancelWorkflowStub = nil
+	if fake.cancelWorkflowReturnsOnCall == nil {
+		fake.cancelWorkflowReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.cancelWorkflowReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) TransferWorkflow(workflowID string, newOrganizationID int32) error {
+	fake.transferWorkflowMutex.Lock()
+	ret, specificReturn := fake.transferWorkflowReturnsOnCall[len(fake.transferWorkflowArgsForCall)]
+	fake.transferWorkflowArgsForCall = append(fake.transferWorkflowArgsForCall, struct {
+		workflowID        string
+		newOrganizationID int32
+	}{workflowID, newOrganizationID})
+	fake.recordInvocation("TransferWorkflow", []interface{}{workflowID, newOrganizationID})
+	fake.transferWorkflowMutex.Unlock()
+	if fake.TransferWorkflowStub != nil {
+		return fake.TransferWorkflowStub(workflowID, newOrganizationID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.transferWorkflowReturns.result1
+}
+
+func (fake *FakeClient) TransferWorkflowCallCount() int {
+	fake.transferWorkflowMutex.RLock()
+	defer fake.transferWorkflowMutex.RUnlock()
+	return len(fake.transferWorkflowArgsForCall)
+}
+
+func (fake *FakeClient) TransferWorkflowArgsForCall(i int) (string, int32) {
+	fake.transferWorkflowMutex.RLock()
+	defer fake.transferWorkflowMutex.RUnlock()
+	return fake.transferWorkflowArgsForCall[i].workflowID, fake.transferWorkflowArgsForCall[i].newOrganizationID
+}
+
+func (fake *FakeClient) TransferWorkflowReturns(result1 error) {
+	fake.TransferWorkflowStub = nil
+	fake.transferWorkflowReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) TransferWorkflowReturnsOnCall(i int, result1 error) {
+	fake.TransferWorkflowStub = nil
+	if fake.transferWorkflowReturnsOnCall == nil {
+		fake.transferWorkflowReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.transferWorkflowReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpdateWorkflowMetadata(workflowID string, description string, metadata map[string]string) error {
+	fake.updateWorkflowMetadataMutex.Lock()
+	ret, specificReturn := fake.updateWorkflowMetadataReturnsOnCall[len(fake.updateWorkflowMetadataArgsForCall)]
+	fake.updateWorkflowMetadataArgsForCall = append(fake.updateWorkflowMetadataArgsForCall, struct {
+		workflowID  string
+		description string
+		metadata    map[string]string
+	}{workflowID, description, metadata})
+	fake.recordInvocation("UpdateWorkflowMetadata", []interface{}{workflowID, description, metadata})
+	fake.updateWorkflowMetadataMutex.Unlock()
+	if fake.UpdateWorkflowMetadataStub != nil {
+		return fake.UpdateWorkflowMetadataStub(workflowID, description, metadata)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateWorkflowMetadataReturns.result1
+}
+
+func (fake *FakeClient) UpdateWorkflowMetadataCallCount() int {
+	fake.updateWorkflowMetadataMutex.RLock()
+	defer fake.updateWorkflowMetadataMutex.RUnlock()
+	return len(fake.updateWorkflowMetadataArgsForCall)
+}
+
+func (fake *FakeClient) UpdateWorkflowMetadataArgsForCall(i int) (string, string, map[string]string) {
+	fake.updateWorkflowMetadataMutex.RLock()
+	defer fake.updateWorkflowMetadataMutex.RUnlock()
+	return fake.updateWorkflowMetadataArgsForCall[i].workflowID, fake.updateWorkflowMetadataArgsForCall[i].description, fake.updateWorkflowMetadataArgsForCall[i].metadata
+}
+
+func (fake *FakeClient) UpdateWorkflowMetadataReturns(result1 error) {
+	fake.UpdateWorkflowMetadataStub = nil
+	fake.updateWorkflowMetadataReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UpdateWorkflowMetadataReturnsOnCall(i int, result1 error) {
+	fake.UpdateWorkflowMetadataStub = nil
+	if fake.updateWorkflowMetadataReturnsOnCall == nil {
+		fake.updateWorkflowMetadataReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateWorkflowMetadataReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) GetWorkflow(workflowID string) (*models.Workflow, error) {
+	fake.getWorkflowMutex.Lock()
+	ret, specificReturn := fake.getWorkflowReturnsOnCall[len(fake.getWorkflowArgsForCall)]
+	fake.getWorkflowArgsForCall = append(fake.getWorkflowArgsForCall, struct {
+		workflowID string
+	}{workflowID})
+	fake.recordInvocation("GetWorkflow", []interface{}{workflowID})
+	fake.getWorkflowMutex.Unlock()
+	if fake.GetWorkflowStub != nil {
+		return fake.GetWorkflowStub(workflowID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getWorkflowReturns.result1, fake.getWorkflowReturns.result2
+}
+
+func (fake *FakeClient) GetWorkflowCallCount() int {
+	fake.getWorkflowMutex.RLock()
+	defer fake.getWorkflowMutex.RUnlock()
+	return len(fake.getWorkflowArgsForCall)
+}
+
+func (fake *FakeClient) GetWorkflowArgsForCall(i int) string {
+	fake.getWorkflowMutex.RLock()
+	defer fake.getWorkflowMutex.RUnlock()
+	return fake.getWorkflowArgsForCall[i].workflowID
+}
+
+func (fake *FakeClient) GetWorkflowReturns(result1 *models.Workflow, result2 error) {
+	fake.GetWorkflowStub = nil
+	fake.getWorkflowReturns = struct {
+		result1 *models.Workflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetWorkflowReturnsOnCall(i int, result1 *models.Workflow, result2 error) {
+	fake.GetWorkflowStub = nil
+	if fake.getWorkflowReturnsOnCall == nil {
+		fake.getWorkflowReturnsOnCall = make(map[int]struct {
+			result1 *models.Workflow
+			result2 error
+		})
+	}
+	fake.getWorkflowReturnsOnCall[i] = struct {
+		result1 *models.Workflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetWorkflowByExternalID(externalID string, organizationID int32) (*models.Workflow, error) {
+	fake.getWorkflowByExternalIDMutex.Lock()
+	ret, specificReturn := fake.getWorkflowByExternalIDReturnsOnCall[len(fake.getWorkflowByExternalIDArgsForCall)]
+	fake.getWorkflowByExternalIDArgsForCall = append(fake.getWorkflowByExternalIDArgsForCall, struct {
+		externalID     string
+		organizationID int32
+	}{externalID, organizationID})
+	fake.recordInvocation("GetWorkflowByExternalID", []interface{}{externalID, organizationID})
+	fake.getWorkflowByExternalIDMutex.Unlock()
+	if fake.GetWorkflowByExternalIDStub != nil {
+		return fake.GetWorkflowByExternalIDStub(externalID, organizationID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getWorkflowByExternalIDReturns.result1, fake.getWorkflowByExternalIDReturns.result2
+}
+
+func (fake *FakeClient) GetWorkflowByExternalIDCallCount() int {
+	fake.getWorkflowByExternalIDMutex.RLock()
+	defer fake.getWorkflowByExternalIDMutex.RUnlock()
+	return len(fake.getWorkflowByExternalIDArgsForCall)
+}
+
+func (fake *FakeClient) GetWorkflowByExternalIDArgsForCall(i int) (string, int32) {
+	fake.getWorkflowByExternalIDMutex.RLock()
+	defer fake.getWorkflowByExternalIDMutex.RUnlock()
+	return fake.getWorkflowByExternalIDArgsForCall[i].externalID, fake.getWorkflowByExternalIDArgsForCall[i].organizationID
+}
+
+func (fake *FakeClient) GetWorkflowByExternalIDReturns(result1 *models.Workflow, result2 error) {
+	fake.GetWorkflowByExternalIDStub = nil
+	fake.getWorkflowByExternalIDReturns = struct {
+		result1 *models.Workflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetWorkflowByExternalIDReturnsOnCall(i int, result1 *models.Workflow, result2 error) {
+	fake.GetWorkflowByExternalIDStub = nil
+	if fake.getWorkflowByExternalIDReturnsOnCall == nil {
+		fake.getWorkflowByExternalIDReturnsOnCall = make(map[int]struct {
+			result1 *models.Workflow
+			result2 error
+		})
+	}
+	fake.getWorkflowByExternalIDReturnsOnCall[i] = struct {
+		result1 *models.Workflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetChildWorkflows(parentWorkflowID string) ([]*models.Workflow, error) {
+	fake.getChildWorkflowsMutex.Lock()
+	ret, specificReturn := fake.getChildWorkflowsReturnsOnCall[len(fake.getChildWorkflowsArgsForCall)]
+	fake.getChildWorkflowsArgsForCall = append(fake.getChildWorkflowsArgsForCall, struct {
+		parentWorkflowID string
+	}{parentWorkflowID})
+	fake.recordInvocation("GetChildWorkflows", []interface{}{parentWorkflowID})
+	fake.getChildWorkflowsMutex.Unlock()
+	if fake.GetChildWorkflowsStub != nil {
+		return fake.GetChildWorkflowsStub(parentWorkflowID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getChildWorkflowsReturns.result1, fake.getChildWorkflowsReturns.result2
+}
+
+func (fake *FakeClient) GetChildWorkflowsCallCount() int {
+	fake.getChildWorkflowsMutex.RLock()
+	defer fake.getChildWorkflowsMutex.RUnlock()
+	return len(fake.getChildWorkflowsArgsForCall)
+}
+
+func (fake *FakeClient) GetChildWorkflowsArgsForCall(i int) string {
+	fake.getChildWorkflowsMutex.RLock()
+	defer fake.getChildWorkflowsMutex.RUnlock()
+	return fake.getChildWorkflowsArgsForCall[i].parentWorkflowID
+}
+
+func (fake *FakeClient) GetChildWorkflowsReturns(result1 []*models.Workflow, result2 error) {
+	fake.GetChildWorkflowsStub = nil
+	fake.getChildWorkflowsReturns = struct {
+		result1 []*models.Workflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetChildWorkflowsReturnsOnCall(i int, result1 []*models.Workflow, result2 error) {
+	fake.GetChildWorkflowsStub = nil
+	if fake.getChildWorkflowsReturnsOnCall == nil {
+		fake.getChildWorkflowsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Workflow
+			result2 error
+		})
+	}
+	fake.getChildWorkflowsReturnsOnCall[i] = struct {
+		result1 []*models.Workflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetWorkflowHistory(workflowID string) ([]*models.HistoryEvent, error) {
+	fake.getWorkflowHistoryMutex.Lock()
+	ret, specificReturn := fake.getWorkflowHistoryReturnsOnCall[len(fake.getWorkflowHistoryArgsForCall)]
+	fake.getWorkflowHistoryArgsForCall = append(fake.getWorkflowHistoryArgsForCall, struct {
+		workflowID string
+	}{workflowID})
+	fake.recordInvocation("GetWorkflowHistory", []interface{}{workflowID})
+	fake.getWorkflowHistoryMutex.Unlock()
+	if fake.GetWorkflowHistoryStub != nil {
+		return fake.GetWorkflowHistoryStub(workflowID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getWorkflowHistoryReturns.result1, fake.getWorkflowHistoryReturns.result2
+}
+
+func (fake *FakeClient) GetWorkflowHistoryCallCount() int {
+	fake.getWorkflowHistoryMutex.RLock()
+	defer fake.getWorkflowHistoryMutex.RUnlock()
+	return len(fake.getWorkflowHistoryArgsForCall)
+}
+
+func (fake *FakeClient) GetWorkflowHistoryArgsForCall(i int) string {
+	fake.getWorkflowHistoryMutex.RLock()
+	defer fake.getWorkflowHistoryMutex.RUnlock()
+	return fake.getWorkflowHistoryArgsForCall[i].workflowID
+}
+
+func (fake *FakeClient) GetWorkflowHistoryReturns(result1 []*models.HistoryEvent, result2 error) {
+	fake.GetWorkflowHistoryStub = nil
+	fake.getWorkflowHistoryReturns = struct {
+		result1 []*models.HistoryEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetWorkflowHistoryReturnsOnCall(i int, result1 []*models.HistoryEvent, result2 error) {
+	fake.GetWorkflowHistoryStub = nil
+	if fake.getWorkflowHistoryReturnsOnCall == nil {
+		fake.getWorkflowHistoryReturnsOnCall = make(map[int]struct {
+			result1 []*models.HistoryEvent
+			result2 error
+		})
+	}
+	fake.getWorkflowHistoryReturnsOnCall[i] = struct {
+		result1 []*models.HistoryEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListWorkflowHistoryPage(workflowID string, cursor string, limit int) ([]*models.HistoryEvent, string, error) {
+	fake.listWorkflowHistoryPageMutex.Lock()
+	ret, specificReturn := fake.listWorkflowHistoryPageReturnsOnCall[len(fake.listWorkflowHistoryPageArgsForCall)]
+	fake.listWorkflowHistoryPageArgsForCall = append(fake.listWorkflowHistoryPageArgsForCall, struct {
+		workflowID string
+		cursor     string
+		limit      int
+	}{workflowID, cursor, limit})
+	fake.recordInvocation("ListWorkflowHistoryPage", []interface{}{workflowID, cursor, limit})
+	fake.listWorkflowHistoryPageMutex.Unlock()
+	if fake.ListWorkflowHistoryPageStub != nil {
+		return fake.ListWorkflowHistoryPageStub(workflowID, cursor, limit)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.listWorkflowHistoryPageReturns.result1, fake.listWorkflowHistoryPageReturns.result2, fake.listWorkflowHistoryPageReturns.result3
+}
+
+func (fake *FakeClient) ListWorkflowHistoryPageCallCount() int {
+	fake.listWorkflowHistoryPageMutex.RLock()
+	defer fake.listWorkflowHistoryPageMutex.RUnlock()
+	return len(fake.listWorkflowHistoryPageArgsForCall)
+}
+
+func (fake *FakeClient) ListWorkflowHistoryPageArgsForCall(i int) (string, string, int) {
+	fake.listWorkflowHistoryPageMutex.RLock()
+	defer fake.listWorkflowHistoryPageMutex.RUnlock()
+	return fake.listWorkflowHistoryPageArgsForCall[i].workflowID, fake.listWorkflowHistoryPageArgsForCall[i].cursor, fake.listWorkflowHistoryPageArgsForCall[i].limit
+}
+
+func (fake *FakeClient) ListWorkflowHistoryPageReturns(result1 []*models.HistoryEvent, result2 string, result3 error) {
+	fake.ListWorkflowHistoryPageStub = nil
+	fake.listWorkflowHistoryPageReturns = struct {
+		result1 []*models.HistoryEvent
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ListWorkflowHistoryPageReturnsOnCall(i int, result1 []*models.HistoryEvent, result2 string, result3 error) {
+	fake.ListWorkflowHistoryPageStub = nil
+	if fake.listWorkflowHistoryPageReturnsOnCall == nil {
+		fake.listWorkflowHistoryPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.HistoryEvent
+			result2 string
+			result3 error
+		})
+	}
+	fake.listWorkflowHistoryPageReturnsOnCall[i] = struct {
+		result1 []*models.HistoryEvent
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ForEachHistoryEvent(workflowID string, filter workflow.HistoryFilter, fn func(*models.HistoryEvent) error) error {
+	fake.forEachHistoryEventMutex.Lock()
+	ret, specificReturn := fake.forEachHistoryEventReturnsOnCall[len(fake.forEachHistoryEventArgsForCall)]
+	fake.forEachHistoryEventArgsForCall = append(fake.forEachHistoryEventArgsForCall, struct {
+		workflowID string
+		filter     workflow.HistoryFilter
+		fn         func(*models.HistoryEvent) error
+	}{workflowID, filter, fn})
+	fake.recordInvocation("ForEachHistoryEvent", []interface{}{workflowID, filter, fn})
+	fake.forEachHistoryEventMutex.Unlock()
+	if fake.ForEachHistoryEventStub != nil {
+		return fake.ForEachHistoryEventStub(workflowID, filter, fn)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.forEachHistoryEventReturns.result1
+}
+
+func (fake *FakeClient) ForEachHistoryEventCallCount() int {
+	fake.forEachHistoryEventMutex.RLock()
+	defer fake.forEachHistoryEventMutex.RUnlock()
+	return len(fake.forEachHistoryEventArgsForCall)
+}
+
+func (fake *FakeClient) ForEachHistoryEventArgsForCall(i int) (string, workflow.HistoryFilter, func(*models.HistoryEvent) error) {
+	fake.forEachHistoryEventMutex.RLock()
+	defer fake.forEachHistoryEventMutex.RUnlock()
+	return fake.forEachHistoryEventArgsForCall[i].workflowID, fake.forEachHistoryEventArgsForCall[i].filter, fake.forEachHistoryEventArgsForCall[i].fn
+}
+
+func (fake *FakeClient) ForEachHistoryEventReturns(result1 error) {
+	fake.ForEachHistoryEventStub = nil
+	fake.forEachHistoryEventReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ForEachHistoryEventReturnsOnCall(i int, result1 error) {
+	fake.ForEachHistoryEventStub = nil
+	if fake.forEachHistoryEventReturnsOnCall == nil {
+		fake.forEachHistoryEventReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.forEachHistoryEventReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ExportWorkflow(workflowID string) (*models.WorkflowBundle, error) {
+	fake.exportWorkflowMutex.Lock()
+	ret, specificReturn := fake.exportWorkflowReturnsOnCall[len(fake.exportWorkflowArgsForCall)]
+	fake.exportWorkflowArgsForCall = append(fake.exportWorkflowArgsForCall, struct {
+		workflowID string
+	}{workflowID})
+	fake.recordInvocation("ExportWorkflow", []interface{}{workflowID})
+	fake.exportWorkflowMutex.Unlock()
+	if fake.ExportWorkflowStub != nil {
+		return fake.ExportWorkflowStub(workflowID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.exportWorkflowReturns.result1, fake.exportWorkflowReturns.result2
+}
+
+func (fake *FakeClient) ExportWorkflowCallCount() int {
+	fake.exportWorkflowMutex.RLock()
+	defer fake.exportWorkflowMutex.RUnlock()
+	return len(fake.exportWorkflowArgsForCall)
+}
+
+func (fake *FakeClient) ExportWorkflowArgsForCall(i int) string {
+	fake.exportWorkflowMutex.RLock()
+	defer fake.exportWorkflowMutex.RUnlock()
+	return fake.exportWorkflowArgsForCall[i].workflowID
+}
+
+func (fake *FakeClient) ExportWorkflowReturns(result1 *models.WorkflowBundle, result2 error) {
+	fake.ExportWorkflowStub = nil
+	fake.exportWorkflowReturns = struct {
+		result1 *models.WorkflowBundle
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ExportWorkflowReturnsOnCall(i int, result1 *models.WorkflowBundle, result2 error) {
+	fake.ExportWorkflowStub = nil
+	if fake.exportWorkflowReturnsOnCall == nil {
+		fake.exportWorkflowReturnsOnCall = make(map[int]struct {
+			result1 *models.WorkflowBundle
+			result2 error
+		})
+	}
+	fake.exportWorkflowReturnsOnCall[i] = struct {
+		result1 *models.WorkflowBundle
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SignalWorkflow(workflowID string, signal *models.Signal) error {
+	fake.signalWorkflowMutex.Lock()
+	ret, specificReturn := fake.signalWorkflowReturnsOnCall[len(fake.signalWorkflowArgsForCall)]
+	fake.signalWorkflowArgsForCall = append(fake.signalWorkflowArgsForCall, struct {
+		workflowID string
+		signal     *models.Signal
+	}{workflowID, signal})
+	fake.recordInvocation("SignalWorkflow", []interface{}{workflowID, signal})
+	fake.signalWorkflowMutex.Unlock()
+	if fake.SignalWorkflowStub != nil {
+		return fake.SignalWorkflowStub(workflowID, signal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.signalWorkflowReturns.result1
+}
+
+func (fake *FakeClient) SignalWorkflowCallCount() int {
+	fake.signalWorkflowMutex.RLock()
+	defer fake.signalWorkflowMutex.RUnlock()
+	return len(fake.signalWorkflowArgsForCall)
+}
+
+func (fake *FakeClient) SignalWorkflowArgsForCall(i int) (string, *models.Signal) {
+	fake.signalWorkflowMutex.RLock()
+	defer fake.signalWorkflowMutex.RUnlock()
+	return fake.signalWorkflowArgsForCall[i].workflowID, fake.signalWorkflowArgsForCall[i].signal
+}
+
+func (fake *FakeClient) SignalWorkflowReturns(result1 error) {
+	fake.SignalWorkflowStub = nil
+	fake.signalWorkflowReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) SignalWorkflowReturnsOnCall(i int, result1 error) {
+	fake.SignalWorkflowStub = nil
+	if fake.signalWorkflowReturnsOnCall == nil {
+		fake.signalWorkflowReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.signalWorkflowReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) SignalWorkflowIfRunning(workflowID string, signal *models.Signal) error {
+	fake.signalWorkflowIfRunningMutex.Lock()
+	ret, specificReturn := fake.signalWorkflowIfRunningReturnsOnCall[len(fake.signalWorkflowIfRunningArgsForCall)]
+	fake.signalWorkflowIfRunningArgsForCall = append(fake.signalWorkflowIfRunningArgsForCall, struct {
+		workflowID string
+		signal     *models.Signal
+	}{workflowID, signal})
+	fake.recordInvocation("SignalWorkflowIfRunning", []interface{}{workflowID, signal})
+	fake.signalWorkflowIfRunningMutex.Unlock()
+	if fake.SignalWorkflowIfRunningStub != nil {
+		return fake.SignalWorkflowIfRunningStub(workflowID, signal)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.signalWorkflowIfRunningReturns.result1
+}
+
+func (fake *FakeClient) SignalWorkflowIfRunningCallCount() int {
+	fake.signalWorkflowIfRunningMutex.RLock()
+	defer fake.signalWorkflowIfRunningMutex.RUnlock()
+	return len(fake.signalWorkflowIfRunningArgsForCall)
+}
+
+func (fake *FakeClient) SignalWorkflowIfRunningArgsForCall(i int) (string, *models.Signal) {
+	fake.signalWorkflowIfRunningMutex.RLock()
+	defer fake.signalWorkflowIfRunningMutex.RUnlock()
+	return fake.signalWorkflowIfRunningArgsForCall[i].workflowID, fake.signalWorkflowIfRunningArgsForCall[i].signal
+}
+
+func (fake *FakeClient) SignalWorkflowIfRunningReturns(result1 error) {
+	fake.SignalWorkflowIfRunningStub = nil
+	fake.signalWorkflowIfRunningReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) SignalWorkflowIfRunningReturnsOnCall(i int, result1 error) {
+	fake.SignalWorkflowIfRunningStub = nil
+	if fake.signalWorkflowIfRunningReturnsOnCall == nil {
+		fake.signalWorkflowIfRunningReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.signalWorkflowIfRunningReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) GetSignalStatus(workflowID string, signalName string) (*models.SignalStatus, error) {
+	fake.getSignalStatusMutex.Lock()
+	ret, specificReturn := fake.getSignalStatusReturnsOnCall[len(fake.getSignalStatusArgsForCall)]
+	fake.getSignalStatusArgsForCall = append(fake.getSignalStatusArgsForCall, struct {
+		workflowID string
+		signalName string
+	}{workflowID, signalName})
+	fake.recordInvocation("GetSignalStatus", []interface{}{workflowID, signalName})
+	fake.getSignalStatusMutex.Unlock()
+	if fake.GetSignalStatusStub != nil {
+		return fake.GetSignalStatusStub(workflowID, signalName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSignalStatusReturns.result1, fake.getSignalStatusReturns.result2
+}
+
+func (fake *FakeClient) GetSignalStatusCallCount() int {
+	fake.getSignalStatusMutex.RLock()
+	defer fake.getSignalStatusMutex.RUnlock()
+	return len(fake.getSignalStatusArgsForCall)
+}
+
+func (fake *FakeClient) GetSignalStatusArgsForCall(i int) (string, string) {
+	fake.getSignalStatusMutex.RLock()
+	defer fake.getSignalStatusMutex.RUnlock()
+	return fake.getSignalStatusArgsForCall[i].workflowID, fake.getSignalStatusArgsForCall[i].signalName
+}
+
+func (fake *FakeClient) GetSignalStatusReturns(result1 *models.SignalStatus, result2 error) {
+	fake.GetSignalStatusStub = nil
+	fake.getSignalStatusReturns = struct {
+		result1 *models.SignalStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetSignalStatusReturnsOnCall(i int, result1 *models.SignalStatus, result2 error) {
+	fake.GetSignalStatusStub = nil
+	if fake.getSignalStatusReturnsOnCall == nil {
+		fake.getSignalStatusReturnsOnCall = make(map[int]struct {
+			result1 *models.SignalStatus
+			result2 error
+		})
+	}
+	fake.getSignalStatusReturnsOnCall[i] = struct {
+		result1 *models.SignalStatus
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RegisterWebhook(workflowID string, callbackURL string, events []string) (string, error) {
+	fake.registerWebhookMutex.Lock()
+	ret, specificReturn := fake.registerWebhookReturnsOnCall[len(fake.registerWebhookArgsForCall)]
+	fake.registerWebhookArgsForCall = append(fake.registerWebhookArgsForCall, struct {
+		workflowID  string
+		callbackURL string
+		events      []string
+	}{workflowID, callbackURL, events})
+	fake.recordInvocation("RegisterWebhook", []interface{}{workflowID, callbackURL, events})
+	fake.registerWebhookMutex.Unlock()
+	if fake.RegisterWebhookStub != nil {
+		return fake.RegisterWebhookStub(workflowID, callbackURL, events)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.registerWebhookReturns.result1, fake.registerWebhookReturns.result2
+}
+
+func (fake *FakeClient) RegisterWebhookCallCount() int {
+	fake.registerWebhookMutex.RLock()
+	defer fake.registerWebhookMutex.RUnlock()
+	return len(fake.registerWebhookArgsForCall)
+}
+
+func (fake *FakeClient) RegisterWebhookArgsForCall(i int) (string, string, []string) {
+	fake.registerWebhookMutex.RLock()
+	defer fake.registerWebhookMutex.RUnlock()
+	return fake.registerWebhookArgsForCall[i].workflowID, fake.registerWebhookArgsForCall[i].callbackURL, fake.registerWebhookArgsForCall[i].events
+}
+
+func (fake *FakeClient) RegisterWebhookReturns(result1 string, result2 error) {
+	fake.RegisterWebhookStub = nil
+	fake.registerWebhookReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RegisterWebhookReturnsOnCall(i int, result1 string, result2 error) {
+	fake.RegisterWebhookStub = nil
+	if fake.registerWebhookReturnsOnCall == nil {
+		fake.registerWebhookReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.registerWebhookReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UnregisterWebhook(webhookID string) error {
+	fake.unregisterWebhookMutex.Lock()
+	ret, specificReturn := fake.unregisterWebhookReturnsOnCall[len(fake.unregisterWebhookArgsForCall)]
+	fake.unregisterWebhookArgsForCall = append(fake.unregisterWebhookArgsForCall, struct {
+		webhookID string
+	}{webhookID})
+	fake.recordInvocation("UnregisterWebhook", []interface{}{webhookID})
+	fake.unregisterWebhookMutex.Unlock()
+	if fake.UnregisterWebhookStub != nil {
+		return fake.UnregisterWebhookStub(webhookID)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.unregisterWebhookReturns.result1
+}
+
+func (fake *FakeClient) UnregisterWebhookCallCount() int {
+	fake.unregisterWebhookMutex.RLock()
+	defer fake.unregisterWebhookMutex.RUnlock()
+	return len(fake.unregisterWebhookArgsForCall)
+}
+
+func (fake *FakeClient) UnregisterWebhookArgsForCall(i int) string {
+	fake.unregisterWebhookMutex.RLock()
+	defer fake.unregisterWebhookMutex.RUnlock()
+	return fake.unregisterWebhookArgsForCall[i].webhookID
+}
+
+func (fake *FakeClient) UnregisterWebhookReturns(result1 error) {
+	fake.UnregisterWebhookStub = nil
+	fake.unregisterWebhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) UnregisterWebhookReturnsOnCall(i int, result1 error) {
+	fake.UnregisterWebhookStub = nil
+	if fake.unregisterWebhookReturnsOnCall == nil {
+		fake.unregisterWebhookReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.unregisterWebhookReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) WorkflowRaw(workflowID string) (json.RawMessage, error) {
+	fake.workflowRawMutex.Lock()
+	ret, specificReturn := fake.workflowRawReturnsOnCall[len(fake.workflowRawArgsForCall)]
+	fake.workflowRawArgsForCall = append(fake.workflowRawArgsForCall, struct {
+		workflowID string
+	}{workflowID})
+	fake.recordInvocation("WorkflowRaw", []interface{}{workflowID})
+	fake.workflowRawMutex.Unlock()
+	if fake.WorkflowRawStub != nil {
+		return fake.WorkflowRawStub(workflowID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.workflowRawReturns.result1, fake.workflowRawReturns.result2
+}
+
+func (fake *FakeClient) WorkflowRawCallCount() int {
+	fake.workflowRawMutex.RLock()
+	defer fake.workflowRawMutex.RUnlock()
+	return len(fake.workflowRawArgsForCall)
+}
+
+func (fake *FakeClient) WorkflowRawArgsForCall(i int) string {
+	fake.workflowRawMutex.RLock()
+	defer fake.workflowRawMutex.RUnlock()
+	return fake.workflowRawArgsForCall[i].workflowID
+}
+
+func (fake *FakeClient) WorkflowRawReturns(result1 json.RawMessage, result2 error) {
+	fake.WorkflowRawStub = nil
+	fake.workflowRawReturns = struct {
+		result1 json.RawMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) WorkflowRawReturnsOnCall(i int, result1 json.RawMessage, result2 error) {
+	fake.WorkflowRawStub = nil
+	if fake.workflowRawReturnsOnCall == nil {
+		fake.workflowRawReturnsOnCall = make(map[int]struct {
+			result1 json.RawMessage
+			result2 error
+		})
+	}
+	fake.workflowRawReturnsOnCall[i] = struct {
+		result1 json.RawMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateActivity(workflowID string, activity *models.Activity) (*models.Activity, error) {
+	fake.updateActivityMutex.Lock()
+	ret, specificReturn := fake.updateActivityReturnsOnCall[len(fake.updateActivityArgsForCall)]
+	fake.updateActivityArgsForCall = append(fake.updateActivityArgsForCall, struct {
+		workflowID string
+		activity   *models.Activity
+	}{workflowID, activity})
+	fake.recordInvocation("UpdateActivity", []interface{}{workflowID, activity})
+	fake.updateActivityMutex.Unlock()
+	if fake.UpdateActivityStub != nil {
+		return fake.UpdateActivityStub(workflowID, activity)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateActivityReturns.result1, fake.updateActivityReturns.result2
+}
+
+func (fake *FakeClient) UpdateActivityCallCount() int {
+	fake.updateActivityMutex.RLock()
+	defer fake.updateActivityMutex.RUnlock()
+	return len(fake.updateActivityArgsForCall)
+}
+
+func (fake *FakeClient) UpdateActivityArgsForCall(i int) (string, *models.Activity) {
+	fake.updateActivityMutex.RLock()
+	defer fake.updateActivityMutex.RUnlock()
+	return fake.updateActivityArgsForCall[i].workflowID, fake.updateActivityArgsForCall[i].activity
+}
+
+func (fake *FakeClient) UpdateActivityReturns(result1 *models.Activity, result2 error) {
+	fake.UpdateActivityStub = nil
+	fake.updateActivityReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.UpdateActivityStub = nil
+	if fake.updateActivityReturnsOnCall == nil {
+		fake.updateActivityReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.updateActivityReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateActivityPercentComplete(workflowID string, activityID string, percentComplete int) (*models.Activity, error) {
+	fake.updateActivityPercentCompleteMutex.Lock()
+	ret, specificReturn := fake.updateActivityPercentCompleteReturnsOnCall[len(fake.updateActivityPercentCompleteArgsForCall)]
+	fake.updateActivityPercentCompleteArgsForCall = append(fake.updateActivityPercentCompleteArgsForCall, struct {
+		workflowID      string
+		activityID      string
+		percentComplete int
+	}{workflowID, activityID, percentComplete})
+	fake.recordInvocation("UpdateActivityPercentComplete", []interface{}{workflowID, activityID, percentComplete})
+	fake.updateActivityPercentCompleteMutex.Unlock()
+	if fake.UpdateActivityPercentCompleteStub != nil {
+		return fake.UpdateActivityPercentCompleteStub(workflowID, activityID, percentComplete)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateActivityPercentCompleteReturns.result1, fake.updateActivityPercentCompleteReturns.result2
+}
+
+func (fake *FakeClient) UpdateActivityPercentCompleteCallCount() int {
+	fake.updateActivityPercentCompleteMutex.RLock()
+	defer fake.updateActivityPercentCompleteMutex.RUnlock()
+	return len(fake.updateActivityPercentCompleteArgsForCall)
+}
+
+func (fake *FakeClient) UpdateActivityPercentCompleteArgsForCall(i int) (string, string, int) {
+	fake.updateActivityPercentCompleteMutex.RLock()
+	defer fake.updateActivityPercentCompleteMutex.RUnlock()
+	return fake.updateActivityPercentCompleteArgsForCall[i].workflowID, fake.updateActivityPercentCompleteArgsForCall[i].activityID, fake.updateActivityPercentCompleteArgsForCall[i].percentComplete
+}
+
+func (fake *FakeClient) UpdateActivityPercentCompleteReturns(result1 *models.Activity, result2 error) {
+	fake.UpdateActivityPercentCompleteStub = nil
+	fake.updateActivityPercentCompleteReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateActivityPercentCompleteReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.UpdateActivityPercentCompleteStub = nil
+	if fake.updateActivityPercentCompleteReturnsOnCall == nil {
+		fake.updateActivityPercentCompleteReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.updateActivityPercentCompleteReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateActivitiesPercentComplete(workflowID string, progress map[string]int) ([]*models.Activity, []error) {
+	fake.updateActivitiesPercentCompleteMutex.Lock()
+	ret, specificReturn := fake.updateActivitiesPercentCompleteReturnsOnCall[len(fake.updateActivitiesPercentCompleteArgsForCall)]
+	fake.updateActivitiesPercentCompleteArgsForCall = append(fake.updateActivitiesPercentCompleteArgsForCall, struct {
+		workflowID string
+		progress   map[string]int
+	}{workflowID, progress})
+	fake.recordInvocation("UpdateActivitiesPercentComplete", []interface{}{workflowID, progress})
+	fake.updateActivitiesPercentCompleteMutex.Unlock()
+	if fake.UpdateActivitiesPercentCompleteStub != nil {
+		return fake.UpdateActivitiesPercentCompleteStub(workflowID, progress)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.updateActivitiesPercentCompleteReturns.result1, fake.updateActivitiesPercentCompleteReturns.result2
+}
+
+func (fake *FakeClient) UpdateActivitiesPercentCompleteCallCount() int {
+	fake.updateActivitiesPercentCompleteMutex.RLock()
+	defer fake.updateActivitiesPercentCompleteMutex.RUnlock()
+	return len(fake.updateActivitiesPercentCompleteArgsForCall)
+}
+
+func (fake *FakeClient) UpdateActivitiesPercentCompleteArgsForCall(i int) (string, map[string]int) {
+	fake.updateActivitiesPercentCompleteMutex.RLock()
+	defer fake.updateActivitiesPercentCompleteMutex.RUnlock()
+	return fake.updateActivitiesPercentCompleteArgsForCall[i].workflowID, fake.updateActivitiesPercentCompleteArgsForCall[i].progress
+}
+
+func (fake *FakeClient) UpdateActivitiesPercentCompleteReturns(result1 []*models.Activity, result2 []error) {
+	fake.UpdateActivitiesPercentCompleteStub = nil
+	fake.updateActivitiesPercentCompleteReturns = struct {
+		result1 []*models.Activity
+		result2 []error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) UpdateActivitiesPercentCompleteReturnsOnCall(i int, result1 []*models.Activity, result2 []error) {
+	fake.UpdateActivitiesPercentCompleteStub = nil
+	if fake.updateActivitiesPercentCompleteReturnsOnCall == nil {
+		fake.updateActivitiesPercentCompleteReturnsOnCall = make(map[int]struct {
+			result1 []*models.Activity
+			result2 []error
+		})
+	}
+	fake.updateActivitiesPercentCompleteReturnsOnCall[i] = struct {
+		result1 []*models.Activity
+		result2 []error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PatchActivity(workflowID string, activityID string, fields map[string]interface{}) (*models.Activity, error) {
+	fake.patchActivityMutex.Lock()
+	ret, specificReturn := fake.patchActivityReturnsOnCall[len(fake.patchActivityArgsForCall)]
+	fake.patchActivityArgsForCall = append(fake.patchActivityArgsForCall, struct {
+		workflowID string
+		activityID string
+		fields     map[string]interface{}
+	}{workflowID, activityID, fields})
+	fake.recordInvocation("PatchActivity", []interface{}{workflowID, activityID, fields})
+	fake.patchActivityMutex.Unlock()
+	if fake.PatchActivityStub != nil {
+		return fake.PatchActivityStub(workflowID, activityID, fields)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.patchActivityReturns.result1, fake.patchActivityReturns.result2
+}
+
+func (fake *FakeClient) PatchActivityCallCount() int {
+	fake.patchActivityMutex.RLock()
+	defer fake.patchActivityMutex.RUnlock()
+	return len(fake.patchActivityArgsForCall)
+}
+
+func (fake *FakeClient) PatchActivityArgsForCall(i int) (string, string, map[string]interface{}) {
+	fake.patchActivityMutex.RLock()
+	defer fake.patchActivityMutex.RUnlock()
+	return fake.patchActivityArgsForCall[i].workflowID, fake.patchActivityArgsForCall[i].activityID, fake.patchActivityArgsForCall[i].fields
+}
+
+func (fake *FakeClient) PatchActivityReturns(result1 *models.Activity, result2 error) {
+	fake.PatchActivityStub = nil
+	fake.patchActivityReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) PatchActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.PatchActivityStub = nil
+	if fake.patchActivityReturnsOnCall == nil {
+		fake.patchActivityReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.patchActivityReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteSuccessfulActivity(workflowID string, activityID string, result interface{}) (*models.Activity, error) {
+	fake.completeSuccessfulActivityMutex.Lock()
+	ret, specificReturn := fake.completeSuccessfulActivityReturnsOnCall[len(fake.completeSuccessfulActivityArgsForCall)]
+	fake.completeSuccessfulActivityArgsForCall = append(fake.completeSuccessfulActivityArgsForCall, struct {
+		workflowID string
+		activityID string
+		result     interface{}
+	}{workflowID, activityID, result})
+	fake.recordInvocation("CompleteSuccessfulActivity", []interface{}{workflowID, activityID, result})
+	fake.completeSuccessfulActivityMutex.Unlock()
+	if fake.CompleteSuccessfulActivityStub != nil {
+		return fake.CompleteSuccessfulActivityStub(workflowID, activityID, result)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.completeSuccessfulActivityReturns.result1, fake.completeSuccessfulActivityReturns.result2
+}
+
+func (fake *FakeClient) CompleteSuccessfulActivityCallCount() int {
+	fake.completeSuccessfulActivityMutex.RLock()
+	defer fake.completeSuccessfulActivityMutex.RUnlock()
+	return len(fake.completeSuccessfulActivityArgsForCall)
+}
+
+func (fake *FakeClient) CompleteSuccessfulActivityArgsForCall(i int) (string, string, interface{}) {
+	fake.completeSuccessfulActivityMutex.RLock()
+	defer fake.completeSuccessfulActivityMutex.RUnlock()
+	return fake.completeSuccessfulActivityArgsForCall[i].workflowID, fake.completeSuccessfulActivityArgsForCall[i].activityID, fake.completeSuccessfulActivityArgsForCall[i].result
+}
+
+func (fake *FakeClient) CompleteSuccessfulActivityReturns(result1 *models.Activity, result2 error) {
+	fake.CompleteSuccessfulActivityStub = nil
+	fake.completeSuccessfulActivityReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteSuccessfulActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.CompleteSuccessfulActivityStub = nil
+	if fake.completeSuccessfulActivityReturnsOnCall == nil {
+		fake.completeSuccessfulActivityReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.completeSuccessfulActivityReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteSuccessfulActivityStream(workflowID string, activityID string, r io.Reader) (*models.Activity, error) {
+	fake.completeSuccessfulActivityStreamMutex.Lock()
+	ret, specificReturn := fake.completeSuccessfulActivityStreamReturnsOnCall[len(fake.completeSuccessfulActivityStreamArgsForCall)]
+	fake.completeSuccessfulActivityStreamArgsForCall = append(fake.completeSuccessfulActivityStreamArgsForCall, struct {
+		workflowID string
+		activityID string
+		r          io.Reader
+	}{workflowID, activityID, r})
+	fake.recordInvocation("CompleteSuccessfulActivityStream", []interface{}{workflowID, activityID, r})
+	fake.completeSuccessfulActivityStreamMutex.Unlock()
+	if fake.CompleteSuccessfulActivityStreamStub != nil {
+		return fake.CompleteSuccessfulActivityStreamStub(workflowID, activityID, r)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.completeSuccessfulActivityStreamReturns.result1, fake.completeSuccessfulActivityStreamReturns.result2
+}
+
+func (fake *FakeClient) CompleteSuccessfulActivityStreamCallCount() int {
+	fake.completeSuccessfulActivityStreamMutex.RLock()
+	defer fake.completeSuccessfulActivityStreamMutex.RUnlock()
+	return len(fake.completeSuccessfulActivityStreamArgsForCall)
+}
+
+func (fake *FakeClient) CompleteSuccessfulActivityStreamArgsForCall(i int) (string, string, io.Reader) {
+	fake.completeSuccessfulActivityStreamMutex.RLock()
+	defer fake.completeSuccessfulActivityStreamMutex.RUnlock()
+	return fake.completeSuccessfulActivityStreamArgsForCall[i].workflowID, fake.completeSuccessfulActivityStreamArgsForCall[i].activityID, fake.completeSuccessfulActivityStreamArgsForCall[i].r
+}
+
+func (fake *FakeClient) CompleteSuccessfulActivityStreamReturns(result1 *models.Activity, result2 error) {
+	fake.CompleteSuccessfulActivityStreamStub = nil
+	fake.completeSuccessfulActivityStreamReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteSuccessfulActivityStreamReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.CompleteSuccessfulActivityStreamStub = nil
+	if fake.completeSuccessfulActivityStreamReturnsOnCall == nil {
+		fake.completeSuccessfulActivityStreamReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.completeSuccessfulActivityStreamReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteCancelledActivity(workflowID string, activityID string, reason string, details string) (*models.Activity, error) {
+	fake.completeCancelledActivityMutex.Lock()
+	ret, specificReturn := fake.completeCancelledActivityReturnsOnCall[len(fake.completeCancelledActivityArgsForCall)]
+	fake.completeCancelledActivityArgsForCall = append(fake.completeCancelledActivityArgsForCall, struct {
+		workflowID string
+		activityID string
+		reason     string
+		details    string
+	}{workflowID, activityID, reason, details})
+	fake.recordInvocation("CompleteCancelledActivity", []interface{}{workflowID, activityID, reason, details})
+	fake.completeCancelledActivityMutex.Unlock()
+	if fake.CompleteCancelledActivityStub != nil {
+		return fake.CompleteCancelledActivityStub(workflowID, activityID, reason, details)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.completeCancelledActivityReturns.result1, fake.completeCancelledActivityReturns.result2
+}
+
+func (fake *FakeClient) CompleteCancelledActivityCallCount() int {
+	fake.completeCancelledActivityMutex.RLock()
+	defer fake.completeCancelledActivityMutex.RUnlock()
+	return len(fake.completeCancelledActivityArgsForCall)
+}
+
+func (fake *FakeClient) CompleteCancelledActivityArgsForCall(i int) (string, string, string, string) {
+	fake.completeCancelledActivityMutex.RLock()
+	defer fake.completeCancelledActivityMutex.RUnlock()
+	return fake.completeCancelledActivityArgsForCall[i].workflowID, fake.completeCancelledActivityArgsForCall[i].activityID, fake.completeCancelledActivityArgsForCall[i].reason, fake.completeCancelledActivityArgsForCall[i].details
+}
+
+func (fake *FakeClient) CompleteCancelledActivityReturns(result1 *models.Activity, result2 error) {
+	fake.CompleteCancelledActivityStub = nil
+	fake.completeCancelledActivityReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteCancelledActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.CompleteCancelledActivityStub = nil
+	if fake.completeCancelledActivityReturnsOnCall == nil {
+		fake.completeCancelledActivityReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.completeCancelledActivityReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteCancelledActivityWithResult(workflowID string, activityID string, reason string, details string, partialResult interface{}) (*models.Activity, error) {
+	fake.completeCancelledActivityWithResultMutex.Lock()
+	ret, specificReturn := fake.completeCancelledActivityWithResultReturnsOnCall[len(fake.completeCancelledActivityWithResultArgsForCall)]
+	fake.completeCancelledActivityWithResultArgsForCall = append(fake.completeCancelledActivityWithResultArgsForCall, struct {
+		workflowID    string
+		activityID    string
+		reason        string
+		details       string
+		partialResult interface{}
+	}{workflowID, activityID, reason, details, partialResult})
+	fake.recordInvocation("CompleteCancelledActivityWithResult", []interface{}{workflowID, activityID, reason, details, partialResult})
+	fake.completeCancelledActivityWithResultMutex.Unlock()
+	if fake.CompleteCancelledActivityWithResultStub != nil {
+		return fake.CompleteCancelledActivityWithResultStub(workflowID, activityID, reason, details, partialResult)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.completeCancelledActivityWithResultReturns.result1, fake.completeCancelledActivityWithResultReturns.result2
+}
+
+func (fake *FakeClient) CompleteCancelledActivityWithResultCallCount() int {
+	fake.completeCancelledActivityWithResultMutex.RLock()
+	defer fake.completeCancelledActivityWithResultMutex.RUnlock()
+	return len(fake.completeCancelledActivityWithResultArgsForCall)
+}
+
+func (fake *FakeClient) CompleteCancelledActivityWithResultArgsForCall(i int) (string, string, string, string, interface{}) {
+	fake.completeCancelledActivityWithResultMutex.RLock()
+	defer fake.completeCancelledActivityWithResultMutex.RUnlock()
+	return fake.completeCancelledActivityWithResultArgsForCall[i].workflowID, fake.completeCancelledActivityWithResultArgsForCall[i].activityID, fake.completeCancelledActivityWithResultArgsForCall[i].reason, fake.completeCancelledActivityWithResultArgsForCall[i].details, fake.completeCancelledActivityWithResultArgsForCall[i].partialResult
+}
+
+func (fake *FakeClient) CompleteCancelledActivityWithResultReturns(result1 *models.Activity, result2 error) {
+	fake.CompleteCancelledActivityWithResultStub = nil
+	fake.completeCancelledActivityWithResultReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteCancelledActivityWithResultReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.CompleteCancelledActivityWithResultStub = nil
+	if fake.completeCancelledActivityWithResultReturnsOnCall == nil {
+		fake.completeCancelledActivityWithResultReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.completeCancelledActivityWithResultReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteFailedActivity(workflowID string, activityID string, reason string, details string) (*models.Activity, error) {
+	fake.completeFailedActivityMutex.Lock()
+	ret, specificReturn := fake.completeFailedActivityReturnsOnCall[len(fake.completeFailedActivityArgsForCall)]
+	fake.completeFailedActivityArgsForCall = append(fake.completeFailedActivityArgsForCall, struct {
+		workflowID string
+		activityID string
+		reason     string
+		details    string
+	}{workflowID, activityID, reason, details})
+	fake.recordInvocation("CompleteFailedActivity", []interface{}{workflowID, activityID, reason, details})
+	fake.completeFailedActivityMutex.Unlock()
+	if fake.CompleteFailedActivityStub != nil {
+		return fake.CompleteFailedActivityStub(workflowID, activityID, reason, details)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.completeFailedActivityReturns.result1, fake.completeFailedActivityReturns.result2
+}
+
+func (fake *FakeClient) CompleteFailedActivityCallCount() int {
+	fake.completeFailedActivityMutex.RLock()
+	defer fake.completeFailedActivityMutex.RUnlock()
+	return len(fake.completeFailedActivityArgsForCall)
+}
+
+func (fake *FakeClient) CompleteFailedActivityArgsForCall(i int) (string, string, string, string) {
+	fake.completeFailedActivityMutex.RLock()
+	defer fake.completeFailedActivityMutex.RUnlock()
+	return fake.completeFailedActivityArgsForCall[i].workflowID, fake.completeFailedActivityArgsForCall[i].activityID, fake.completeFailedActivityArgsForCall[i].reason, fake.completeFailedActivityArgsForCall[i].details
+}
+
+func (fake *FakeClient) CompleteFailedActivityReturns(result1 *models.Activity, result2 error) {
+	fake.CompleteFailedActivityStub = nil
+	fake.completeFailedActivityReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteFailedActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.CompleteFailedActivityStub = nil
+	if fake.completeFailedActivityReturnsOnCall == nil {
+		fake.completeFailedActivityReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.completeFailedActivityReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteFailedActivityNonBlocking(workflowID string, activityID string, reason string, details string) (*models.Activity, error) {
+	fake.completeFailedActivityNonBlockingMutex.Lock()
+	ret, specificReturn := fake.completeFailedActivityNonBlockingReturnsOnCall[len(fake.completeFailedActivityNonBlockingArgsForCall)]
+	fake.completeFailedActivityNonBlockingArgsForCall = append(fake.completeFailedActivityNonBlockingArgsForCall, struct {
+		workflowID string
+		activityID string
+		reason     string
+		details    string
+	}{workflowID, activityID, reason, details})
+	fake.recordInvocation("CompleteFailedActivityNonBlocking", []interface{}{workflowID, activityID, reason, details})
+	fake.completeFailedActivityNonBlockingMutex.Unlock()
+	if fake.CompleteFailedActivityNonBlockingStub != nil {
+		return fake.CompleteFailedActivityNonBlockingStub(workflowID, activityID, reason, details)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.completeFailedActivityNonBlockingReturns.result1, fake.completeFailedActivityNonBlockingReturns.result2
+}
+
+func (fake *FakeClient) CompleteFailedActivityNonBlockingCallCount() int {
+	fake.completeFailedActivityNonBlockingMutex.RLock()
+	defer fake.completeFailedActivityNonBlockingMutex.RUnlock()
+	return len(fake.completeFailedActivityNonBlockingArgsForCall)
+}
+
+func (fake *FakeClient) CompleteFailedActivityNonBlockingArgsForCall(i int) (string, string, string, string) {
+	fake.completeFailedActivityNonBlockingMutex.RLock()
+	defer fake.completeFailedActivityNonBlockingMutex.RUnlock()
+	return fake.completeFailedActivityNonBlockingArgsForCall[i].workflowID, fake.completeFailedActivityNonBlockingArgsForCall[i].activityID, fake.completeFailedActivityNonBlockingArgsForCall[i].reason, fake.completeFailedActivityNonBlockingArgsForCall[i].details
+}
+
+func (fake *FakeClient) CompleteFailedActivityNonBlockingReturns(result1 *models.Activity, result2 error) {
+	fake.CompleteFailedActivityNonBlockingStub = nil
+	fake.completeFailedActivityNonBlockingReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteFailedActivityNonBlockingReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.CompleteFailedActivityNonBlockingStub = nil
+	if fake.completeFailedActivityNonBlockingReturnsOnCall == nil {
+		fake.completeFailedActivityNonBlockingReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.completeFailedActivityNonBlockingReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteActivity(workflowID string, activityID string, outcome workflow.ActivityOutcome) (*models.Activity, error) {
+	fake.completeActivityMutex.Lock()
+	ret, specificReturn := fake.completeActivityReturnsOnCall[len(fake.completeActivityArgsForCall)]
+	fake.completeActivityArgsForCall = append(fake.completeActivityArgsForCall, struct {
+		workflowID string
+		activityID string
+		outcome    workflow.ActivityOutcome
+	}{workflowID, activityID, outcome})
+	fake.recordInvocation("CompleteActivity", []interface{}{workflowID, activityID, outcome})
+	fake.completeActivityMutex.Unlock()
+	if fake.CompleteActivityStub != nil {
+		return fake.CompleteActivityStub(workflowID, activityID, outcome)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.completeActivityReturns.result1, fake.completeActivityReturns.result2
+}
+
+func (fake *FakeClient) CompleteActivityCallCount() int {
+	fake.completeActivityMutex.RLock()
+	defer fake.completeActivityMutex.RUnlock()
+	return len(fake.completeActivityArgsForCall)
+}
+
+func (fake *FakeClient) CompleteActivityArgsForCall(i int) (string, string, workflow.ActivityOutcome) {
+	fake.completeActivityMutex.RLock()
+	defer fake.completeActivityMutex.RUnlock()
+	return fake.completeActivityArgsForCall[i].workflowID, fake.completeActivityArgsForCall[i].activityID, fake.completeActivityArgsForCall[i].outcome
+}
+
+func (fake *FakeClient) CompleteActivityReturns(result1 *models.Activity, result2 error) {
+	fake.CompleteActivityStub = nil
+	fake.completeActivityReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CompleteActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.CompleteActivityStub = nil
+	if fake.completeActivityReturnsOnCall == nil {
+		fake.completeActivityReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.completeActivityReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RetryActivity(workflowID string, activityID string) (*models.Activity, error) {
+	fake.retryActivityMutex.Lock()
+	ret, specificReturn := fake.retryActivityReturnsOnCall[len(fake.retryActivityArgsForCall)]
+	fake.retryActivityArgsForCall = append(fake.retryActivityArgsForCall, struct {
+		workflowID string
+		activityID string
+	}{workflowID, activityID})
+	fake.recordInvocation("RetryActivity", []interface{}{workflowID, activityID})
+	fake.retryActivityMutex.Unlock()
+	if fake.RetryActivityStub != nil {
+		return fake.RetryActivityStub(workflowID, activityID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.retryActivityReturns.result1, fake.retryActivityReturns.result2
+}
+
+func (fake *FakeClient) RetryActivityCallCount() int {
+	fake.retryActivityMutex.RLock()
+	defer fake.retryActivityMutex.RUnlock()
+	return len(fake.retryActivityArgsForCall)
+}
+
+func (fake *FakeClient) RetryActivityArgsForCall(i int) (string, string) {
+	fake.retryActivityMutex.RLock()
+	defer fake.retryActivityMutex.RUnlock()
+	return fake.retryActivityArgsForCall[i].workflowID, fake.retryActivityArgsForCall[i].activityID
+}
+
+func (fake *FakeClient) RetryActivityReturns(result1 *models.Activity, result2 error) {
+	fake.RetryActivityStub = nil
+	fake.retryActivityReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RetryActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.RetryActivityStub = nil
+	if fake.retryActivityReturnsOnCall == nil {
+		fake.retryActivityReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.retryActivityReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) HeartbeatActivity(workflowID string, activityID string) (*models.Heartbeat, error) {
+	fake.heartbeatActivityMutex.Lock()
+	ret, specificReturn := fake.heartbeatActivityReturnsOnCall[len(fake.heartbeatActivityArgsForCall)]
+	fake.heartbeatActivityArgsForCall = append(fake.heartbeatActivityArgsForCall, struct {
+		workflowID string
+		activityID string
+	}{workflowID, activityID})
+	fake.recordInvocation("HeartbeatActivity", []interface{}{workflowID, activityID})
+	fake.heartbeatActivityMutex.Unlock()
+	if fake.HeartbeatActivityStub != nil {
+		return fake.HeartbeatActivityStub(workflowID, activityID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.heartbeatActivityReturns.result1, fake.heartbeatActivityReturns.result2
+}
+
+func (fake *FakeClient) HeartbeatActivityCallCount() int {
+	fake.heartbeatActivityMutex.RLock()
+	defer fake.heartbeatActivityMutex.RUnlock()
+	return len(fake.heartbeatActivityArgsForCall)
+}
+
+func (fake *FakeClient) HeartbeatActivityArgsForCall(i int) (string, string) {
+	fake.heartbeatActivityMutex.RLock()
+	defer fake.heartbeatActivityMutex.RUnlock()
+	return fake.heartbeatActivityArgsForCall[i].workflowID, fake.heartbeatActivityArgsForCall[i].activityID
+}
+
+func (fake *FakeClient) HeartbeatActivityReturns(result1 *models.Heartbeat, result2 error) {
+	fake.HeartbeatActivityStub = nil
+	fake.heartbeatActivityReturns = struct {
+		result1 *models.Heartbeat
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) HeartbeatActivityReturnsOnCall(i int, result1 *models.Heartbeat, result2 error) {
+	fake.HeartbeatActivityStub = nil
+	if fake.heartbeatActivityReturnsOnCall == nil {
+		fake.heartbeatActivityReturnsOnCall = make(map[int]struct {
+			result1 *models.Heartbeat
+			result2 error
+		})
+	}
+	fake.heartbeatActivityReturnsOnCall[i] = struct {
+		result1 *models.Heartbeat
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) AppendActivityLogs(workflowID string, activityID string, lines []*models.LogLine) error {
+	fake.appendActivityLogsMutex.Lock()
+	ret, specificReturn := fake.appendActivityLogsReturnsOnCall[len(fake.appendActivityLogsArgsForCall)]
+	fake.appendActivityLogsArgsForCall = append(fake.appendActivityLogsArgsForCall, struct {
+		workflowID string
+		activityID string
+		lines      []*models.LogLine
+	}{workflowID, activityID, lines})
+	fake.recordInvocation("AppendActivityLogs", []interface{}{workflowID, activityID, lines})
+	fake.appendActivityLogsMutex.Unlock()
+	if fake.AppendActivityLogsStub != nil {
+		return fake.AppendActivityLogsStub(workflowID, activityID, lines)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.appendActivityLogsReturns.result1
+}
+
+func (fake *FakeClient) AppendActivityLogsCallCount() int {
+	fake.appendActivityLogsMutex.RLock()
+	defer fake.appendActivityLogsMutex.RUnlock()
+	return len(fake.appendActivityLogsArgsForCall)
+}
+
+func (fake *FakeClient) AppendActivityLogsArgsForCall(i int) (string, string, []*models.LogLine) {
+	fake.appendActivityLogsMutex.RLock()
+	defer fake.appendActivityLogsMutex.RUnlock()
+	return fake.appendActivityLogsArgsForCall[i].workflowID, fake.appendActivityLogsArgsForCall[i].activityID, fake.appendActivityLogsArgsForCall[i].lines
+}
+
+func (fake *FakeClient) AppendActivityLogsReturns(result1 error) {
+	fake.AppendActivityLogsStub = nil
+	fake.appendActivityLogsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) AppendActivityLogsReturnsOnCall(i int, result1 error) {
+	fake.AppendActivityLogsStub = nil
+	if fake.appendActivityLogsReturnsOnCall == nil {
+		fake.appendActivityLogsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.appendActivityLogsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) GetActivityLogs(workflowID string, activityID string, since time.Time) ([]*models.LogLine, error) {
+	fake.getActivityLogsMutex.Lock()
+	ret, specificReturn := fake.getActivityLogsReturnsOnCall[len(fake.getActivityLogsArgsForCall)]
+	fake.getActivityLogsArgsForCall = append(fake.getActivityLogsArgsForCall, struct {
+		workflowID string
+		activityID string
+		since      time.Time
+	}{workflowID, activityID, since})
+	fake.recordInvocation("GetActivityLogs", []interface{}{workflowID, activityID, since})
+	fake.getActivityLogsMutex.Unlock()
+	if fake.GetActivityLogsStub != nil {
+		return fake.GetActivityLogsStub(workflowID, activityID, since)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getActivityLogsReturns.result1, fake.getActivityLogsReturns.result2
+}
+
+func (fake *FakeClient) GetActivityLogsCallCount() int {
+	fake.getActivityLogsMutex.RLock()
+	defer fake.getActivityLogsMutex.RUnlock()
+	return len(fake.getActivityLogsArgsForCall)
+}
+
+func (fake *FakeClient) GetActivityLogsArgsForCall(i int) (string, string, time.Time) {
+	fake.getActivityLogsMutex.RLock()
+	defer fake.getActivityLogsMutex.RUnlock()
+	return fake.getActivityLogsArgsForCall[i].workflowID, fake.getActivityLogsArgsForCall[i].activityID, fake.getActivityLogsArgsForCall[i].since
+}
+
+func (fake *FakeClient) GetActivityLogsReturns(result1 []*models.LogLine, result2 error) {
+	fake.GetActivityLogsStub = nil
+	fake.getActivityLogsReturns = struct {
+		result1 []*models.LogLine
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetActivityLogsReturnsOnCall(i int, result1 []*models.LogLine, result2 error) {
+	fake.GetActivityLogsStub = nil
+	if fake.getActivityLogsReturnsOnCall == nil {
+		fake.getActivityLogsReturnsOnCall = make(map[int]struct {
+			result1 []*models.LogLine
+			result2 error
+		})
+	}
+	fake.getActivityLogsReturnsOnCall[i] = struct {
+		result1 []*models.LogLine
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) DownloadActivityResult(activity *models.Activity, w io.Writer) error {
+	fake.downloadActivityResultMutex.Lock()
+	ret, specificReturn := fake.downloadActivityResultReturnsOnCall[len(fake.downloadActivityResultArgsForCall)]
+	fake.downloadActivityResultArgsForCall = append(fake.downloadActivityResultArgsForCall, struct {
+		activity *models.Activity
+		w        io.Writer
+	}{activity, w})
+	fake.recordInvocation("DownloadActivityResult", []interface{}{activity, w})
+	fake.downloadActivityResultMutex.Unlock()
+	if fake.DownloadActivityResultStub != nil {
+		return fake.DownloadActivityResultStub(activity, w)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.downloadActivityResultReturns.result1
+}
+
+func (fake *FakeClient) DownloadActivityResultCallCount() int {
+	fake.downloadActivityResultMutex.RLock()
+	defer fake.downloadActivityResultMutex.RUnlock()
+	return len(fake.downloadActivityResultArgsForCall)
+}
+
+func (fake *FakeClient) DownloadActivityResultArgsForCall(i int) (*models.Activity, io.Writer) {
+	fake.downloadActivityResultMutex.RLock()
+	defer fake.downloadActivityResultMutex.RUnlock()
+	return fake.downloadActivityResultArgsForCall[i].activity, fake.downloadActivityResultArgsForCall[i].w
+}
+
+func (fake *FakeClient) DownloadActivityResultReturns(result1 error) {
+	fake.DownloadActivityResultStub = nil
+	fake.downloadActivityResultReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) DownloadActivityResultReturnsOnCall(i int, result1 error) {
+	fake.DownloadActivityResultStub = nil
+	if fake.downloadActivityResultReturnsOnCall == nil {
+		fake.downloadActivityResultReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.downloadActivityResultReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) HeartbeatActivityWithToken(taskToken string, activityID string, details string) (*models.Heartbeat, error) {
+	fake.heartbeatActivityWithTokenMutex.Lock()
+	ret, specificReturn := fake.heartbeatActivityWithTokenReturnsOnCall[len(fake.heartbeatActivityWithTokenArgsForCall)]
+	fake.heartbeatActivityWithTokenArgsForCall = append(fake.heartbeatActivityWithTokenArgsForCall, struct {
+		taskToken  string
+		activityID string
+		details    string
+	}{taskToken, activityID, details})
+	fake.recordInvocation("HeartbeatActivityWithToken", []interface{}{taskToken, activityID, details})
+	fake.heartbeatActivityWithTokenMutex.Unlock()
+	if fake.HeartbeatActivityWithTokenStub != nil {
+		return fake.HeartbeatActivityWithTokenStub(taskToken, activityID, details)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.heartbeatActivityWithTokenReturns.result1, fake.heartbeatActivityWithTokenReturns.result2
+}
+
+func (fake *FakeClient) HeartbeatActivityWithTokenCallCount() int {
+	fake.heartbeatActivityWithTokenMutex.RLock()
+	defer fake.heartbeatActivityWithTokenMutex.RUnlock()
+	return len(fake.heartbeatActivityWithTokenArgsForCall)
+}
+
+func (fake *FakeClient) HeartbeatActivityWithTokenArgsForCall(i int) (string, string, string) {
+	fake.heartbeatActivityWithTokenMutex.RLock()
+	defer fake.heartbeatActivityWithTokenMutex.RUnlock()
+	return fake.heartbeatActivityWithTokenArgsForCall[i].taskToken, fake.heartbeatActivityWithTokenArgsForCall[i].activityID, fake.heartbeatActivityWithTokenArgsForCall[i].details
+}
+
+func (fake *FakeClient) HeartbeatActivityWithTokenReturns(result1 *models.Heartbeat, result2 error) {
+	fake.HeartbeatActivityWithTokenStub = nil
+	fake.heartbeatActivityWithTokenReturns = struct {
+		result1 *models.Heartbeat
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) HeartbeatActivityWithTokenReturnsOnCall(i int, result1 *models.Heartbeat, result2 error) {
+	fake.HeartbeatActivityWithTokenStub = nil
+	if fake.heartbeatActivityWithTokenReturnsOnCall == nil {
+		fake.heartbeatActivityWithTokenReturnsOnCall = make(map[int]struct {
+			result1 *models.Heartbeat
+			result2 error
+		})
+	}
+	fake.heartbeatActivityWithTokenReturnsOnCall[i] = struct {
+		result1 *models.Heartbeat
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) AbandonActivity(taskToken string, activityID string, details string) error {
+	fake.abandonActivityMutex.Lock()
+	ret, specificReturn := fake.abandonActivityReturnsOnCall[len(fake.abandonActivityArgsForCall)]
+	fake.abandonActivityArgsForCall = append(fake.abandonActivityArgsForCall, struct {
+		taskToken  string
+		activityID string
+		details    string
+	}{taskToken, activityID, details})
+	fake.recordInvocation("AbandonActivity", []interface{}{taskToken, activityID, details})
+	fake.abandonActivityMutex.Unlock()
+	if fake.AbandonActivityStub != nil {
+		return fake.AbandonActivityStub(taskToken, activityID, details)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.abandonActivityReturns.result1
+}
+
+func (fake *FakeClient) AbandonActivityCallCount() int {
+	fake.abandonActivityMutex.RLock()
+	defer fake.abandonActivityMutex.RUnlock()
+	return len(fake.abandonActivityArgsForCall)
+}
+
+func (fake *FakeClient) AbandonActivityArgsForCall(i int) (string, string, string) {
+	fake.abandonActivityMutex.RLock()
+	defer fake.abandonActivityMutex.RUnlock()
+	return fake.abandonActivityArgsForCall[i].taskToken, fake.abandonActivityArgsForCall[i].activityID, fake.abandonActivityArgsForCall[i].details
+}
+
+func (fake *FakeClient) AbandonActivityReturns(result1 error) {
+	fake.AbandonActivityStub = nil
+	fake.abandonActivityReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) AbandonActivityReturnsOnCall(i int, result1 error) {
+	fake.AbandonActivityStub = nil
+	if fake.abandonActivityReturnsOnCall == nil {
+		fake.abandonActivityReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.abandonActivityReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) ExtendHeartbeatTimeout(taskToken string, activityID string, extension time.Duration) (*models.Heartbeat, error) {
+	fake.extendHeartbeatTimeoutMutex.Lock()
+	ret, specificReturn := fake.extendHeartbeatTimeoutReturnsOnCall[len(fake.extendHeartbeatTimeoutArgsForCall)]
+	fake.extendHeartbeatTimeoutArgsForCall = append(fake.extendHeartbeatTimeoutArgsForCall, struct {
+		taskToken  string
+		activityID string
+		extension  time.Duration
+	}{taskToken, activityID, extension})
+	fake.recordInvocation("ExtendHeartbeatTimeout", []interface{}{taskToken, activityID, extension})
+	fake.extendHeartbeatTimeoutMutex.Unlock()
+	if fake.ExtendHeartbeatTimeoutStub != nil {
+		return fake.ExtendHeartbeatTimeoutStub(taskToken, activityID, extension)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.extendHeartbeatTimeoutReturns.result1, fake.extendHeartbeatTimeoutReturns.result2
+}
+
+func (fake *FakeClient) ExtendHeartbeatTimeoutCallCount() int {
+	fake.extendHeartbeatTimeoutMutex.RLock()
+	defer fake.extendHeartbeatTimeoutMutex.RUnlock()
+	return len(fake.extendHeartbeatTimeoutArgsForCall)
+}
+
+func (fake *FakeClient) ExtendHeartbeatTimeoutArgsForCall(i int) (string, string, time.Duration) {
+	fake.extendHeartbeatTimeoutMutex.RLock()
+	defer fake.extendHeartbeatTimeoutMutex.RUnlock()
+	return fake.extendHeartbeatTimeoutArgsForCall[i].taskToken, fake.extendHeartbeatTimeoutArgsForCall[i].activityID, fake.extendHeartbeatTimeoutArgsForCall[i].extension
+}
+
+func (fake *FakeClient) ExtendHeartbeatTimeoutReturns(result1 *models.Heartbeat, result2 error) {
+	fake.ExtendHeartbeatTimeoutStub = nil
+	fake.extendHeartbeatTimeoutReturns = struct {
+		result1 *models.Heartbeat
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ExtendHeartbeatTimeoutReturnsOnCall(i int, result1 *models.Heartbeat, result2 error) {
+	fake.ExtendHeartbeatTimeoutStub = nil
+	if fake.extendHeartbeatTimeoutReturnsOnCall == nil {
+		fake.extendHeartbeatTimeoutReturnsOnCall = make(map[int]struct {
+			result1 *models.Heartbeat
+			result2 error
+		})
+	}
+	fake.extendHeartbeatTimeoutReturnsOnCall[i] = struct {
+		result1 *models.Heartbeat
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) IsCancellationRequested(workflowID string, activityID string) (bool, error) {
+	fake.isCancellationRequestedMutex.Lock()
+	ret, specificReturn := fake.isCancellationRequestedReturnsOnCall[len(fake.isCancellationRequestedArgsForCall)]
+	fake.isCancellationRequestedArgsForCall = append(fake.isCancellationRequestedArgsForCall, struct {
+		workflowID string
+		activityID string
+	}{workflowID, activityID})
+	fake.recordInvocation("IsCancellationRequested", []interface{}{workflowID, activityID})
+	fake.isCancellationRequestedMutex.Unlock()
+	if fake.IsCancellationRequestedStub != nil {
+		return fake.IsCancellationRequestedStub(workflowID, activityID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.isCancellationRequestedReturns.result1, fake.isCancellationRequestedReturns.result2
+}
+
+func (fake *FakeClient) IsCancellationRequestedCallCount() int {
+	fake.isCancellationRequestedMutex.RLock()
+	defer fake.isCancellationRequestedMutex.RUnlock()
+	return len(fake.isCancellationRequestedArgsForCall)
+}
+
+func (fake *FakeClient) IsCancellationRequestedArgsForCall(i int) (string, string) {
+	fake.isCancellationRequestedMutex.RLock()
+	defer fake.isCancellationRequestedMutex.RUnlock()
+	return fake.isCancellationRequestedArgsForCall[i].workflowID, fake.isCancellationRequestedArgsForCall[i].activityID
+}
+
+func (fake *FakeClient) IsCancellationRequestedReturns(result1 bool, result2 error) {
+	fake.IsCancellationRequestedStub = nil
+	fake.isCancellationRequestedReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) IsCancellationRequestedReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.IsCancellationRequestedStub = nil
+	if fake.isCancellationRequestedReturnsOnCall == nil {
+		fake.isCancellationRequestedReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.isCancellationRequestedReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetActivityTaskToken(workflowID string, activityID string) (string, error) {
+	fake.getActivityTaskTokenMutex.Lock()
+	ret, specificReturn := fake.getActivityTaskTokenReturnsOnCall[len(fake.getActivityTaskTokenArgsForCall)]
+	fake.getActivityTaskTokenArgsForCall = append(fake.getActivityTaskTokenArgsForCall, struct {
+		workflowID string
+		activityID string
+	}{workflowID, activityID})
+	fake.recordInvocation("GetActivityTaskToken", []interface{}{workflowID, activityID})
+	fake.getActivityTaskTokenMutex.Unlock()
+	if fake.GetActivityTaskTokenStub != nil {
+		return fake.GetActivityTaskTokenStub(workflowID, activityID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getActivityTaskTokenReturns.result1, fake.getActivityTaskTokenReturns.result2
+}
+
+func (fake *FakeClient) GetActivityTaskTokenCallCount() int {
+	fake.getActivityTaskTokenMutex.RLock()
+	defer fake.getActivityTaskTokenMutex.RUnlock()
+	return len(fake.getActivityTaskTokenArgsForCall)
+}
+
+func (fake *FakeClient) GetActivityTaskTokenArgsForCall(i int) (string, string) {
+	fake.getActivityTaskTokenMutex.RLock()
+	defer fake.getActivityTaskTokenMutex.RUnlock()
+	return fake.getActivityTaskTokenArgsForCall[i].workflowID, fake.getActivityTaskTokenArgsForCall[i].activityID
+}
+
+func (fake *FakeClient) GetActivityTaskTokenReturns(result1 string, result2 error) {
+	fake.GetActivityTaskTokenStub = nil
+	fake.getActivityTaskTokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetActivityTaskTokenReturnsOnCall(i int, result1 string, result2 error) {
+	fake.GetActivityTaskTokenStub = nil
+	if fake.getActivityTaskTokenReturnsOnCall == nil {
+		fake.getActivityTaskTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.getActivityTaskTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) LookupByTaskToken(taskToken string) (string, string, error) {
+	fake.lookupByTaskTokenMutex.Lock()
+	ret, specificReturn := fake.lookupByTaskTokenReturnsOnCall[len(fake.lookupByTaskTokenArgsForCall)]
+	fake.lookupByTaskTokenArgsForCall = append(fake.lookupByTaskTokenArgsForCall, struct {
+		taskToken string
+	}{taskToken})
+	fake.recordInvocation("LookupByTaskToken", []interface{}{taskToken})
+	fake.lookupByTaskTokenMutex.Unlock()
+	if fake.LookupByTaskTokenStub != nil {
+		return fake.LookupByTaskTokenStub(taskToken)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.lookupByTaskTokenReturns.result1, fake.lookupByTaskTokenReturns.result2, fake.lookupByTaskTokenReturns.result3
+}
+
+func (fake *FakeClient) LookupByTaskTokenCallCount() int {
+	fake.lookupByTaskTokenMutex.RLock()
+	defer fake.lookupByTaskTokenMutex.RUnlock()
+	return len(fake.lookupByTaskTokenArgsForCall)
+}
+
+func (fake *FakeClient) LookupByTaskTokenArgsForCall(i int) string {
+	fake.lookupByTaskTokenMutex.RLock()
+	defer fake.lookupByTaskTokenMutex.RUnlock()
+	return fake.lookupByTaskTokenArgsForCall[i].taskToken
+}
+
+func (fake *FakeClient) LookupByTaskTokenReturns(result1 string, result2 string, result3 error) {
+	fake.LookupByTaskTokenStub = nil
+	fake.lookupByTaskTokenReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) LookupByTaskTokenReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.LookupByTaskTokenStub = nil
+	if fake.lookupByTaskTokenReturnsOnCall == nil {
+		fake.lookupByTaskTokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.lookupByTaskTokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) HeartbeatActivities(taskTokens map[string]string) (map[string]*models.Heartbeat, error) {
+	fake.heartbeatActivitiesMutex.Lock()
+	ret, specificReturn := fake.heartbeatActivitiesReturnsOnCall[len(fake.heartbeatActivitiesArgsForCall)]
+	fake.heartbeatActivitiesArgsForCall = append(fake.heartbeatActivitiesArgsForCall, struct {
+		taskTokens map[string]string
+	}{taskTokens})
+	fake.recordInvocation("HeartbeatActivities", []interface{}{taskTokens})
+	fake.heartbeatActivitiesMutex.Unlock()
+	if fake.HeartbeatActivitiesStub != nil {
+		return fake.HeartbeatActivitiesStub(taskTokens)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.heartbeatActivitiesReturns.result1, fake.heartbeatActivitiesReturns.result2
+}
+
+func (fake *FakeClient) HeartbeatActivitiesCallCount() int {
+	fake.heartbeatActivitiesMutex.RLock()
+	defer fake.heartbeatActivitiesMutex.RUnlock()
+	return len(fake.heartbeatActivitiesArgsForCall)
+}
+
+func (fake *FakeClient) HeartbeatActivitiesArgsForCall(i int) map[string]string {
+	fake.heartbeatActivitiesMutex.RLock()
+	defer fake.heartbeatActivitiesMutex.RUnlock()
+	return fake.heartbeatActivitiesArgsForCall[i].taskTokens
+}
+
+func (fake *FakeClient) HeartbeatActivitiesReturns(result1 map[string]*models.Heartbeat, result2 error) {
+	fake.HeartbeatActivitiesStub = nil
+	fake.heartbeatActivitiesReturns = struct {
+		result1 map[string]*models.Heartbeat
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) HeartbeatActivitiesReturnsOnCall(i int, result1 map[string]*models.Heartbeat, result2 error) {
+	fake.HeartbeatActivitiesStub = nil
+	if fake.heartbeatActivitiesReturnsOnCall == nil {
+		fake.heartbeatActivitiesReturnsOnCall = make(map[int]struct {
+			result1 map[string]*models.Heartbeat
+			result2 error
+		})
+	}
+	fake.heartbeatActivitiesReturnsOnCall[i] = struct {
+		result1 map[string]*models.Heartbeat
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetActivity(workflowID string, activityID string) (*models.Activity, error) {
+	fake.getActivityMutex.Lock()
+	ret, specificReturn := fake.getActivityReturnsOnCall[len(fake.getActivityArgsForCall)]
+	fake.getActivityArgsForCall = append(fake.getActivityArgsForCall, struct {
+		workflowID string
+		activityID string
+	}{workflowID, activityID})
+	fake.recordInvocation("GetActivity", []interface{}{workflowID, activityID})
+	fake.getActivityMutex.Unlock()
+	if fake.GetActivityStub != nil {
+		return fake.GetActivityStub(workflowID, activityID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getActivityReturns.result1, fake.getActivityReturns.result2
+}
+
+func (fake *FakeClient) GetActivityCallCount() int {
+	fake.getActivityMutex.RLock()
+	defer fake.getActivityMutex.RUnlock()
+	return len(fake.getActivityArgsForCall)
+}
+
+func (fake *FakeClient) GetActivityArgsForCall(i int) (string, string) {
+	fake.getActivityMutex.RLock()
+	defer fake.getActivityMutex.RUnlock()
+	return fake.getActivityArgsForCall[i].workflowID, fake.getActivityArgsForCall[i].activityID
+}
+
+func (fake *FakeClient) GetActivityReturns(result1 *models.Activity, result2 error) {
+	fake.GetActivityStub = nil
+	fake.getActivityReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) GetActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.GetActivityStub = nil
+	if fake.getActivityReturnsOnCall == nil {
+		fake.getActivityReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.getActivityReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListActivities(workflowID string) ([]*models.Activity, error) {
+	fake.listActivitiesMutex.Lock()
+	ret, specificReturn := fake.listActivitiesReturnsOnCall[len(fake.listActivitiesArgsForCall)]
+	fake.listActivitiesArgsForCall = append(fake.listActivitiesArgsForCall, struct {
+		workflowID string
+	}{workflowID})
+	fake.recordInvocation("ListActivities", []interface{}{workflowID})
+	fake.listActivitiesMutex.Unlock()
+	if fake.ListActivitiesStub != nil {
+		return fake.ListActivitiesStub(workflowID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listActivitiesReturns.result1, fake.listActivitiesReturns.result2
+}
+
+func (fake *FakeClient) ListActivitiesCallCount() int {
+	fake.listActivitiesMutex.RLock()
+	defer fake.listActivitiesMutex.RUnlock()
+	return len(fake.listActivitiesArgsForCall)
+}
+
+func (fake *FakeClient) ListActivitiesArgsForCall(i int) string {
+	fake.listActivitiesMutex.RLock()
+	defer fake.listActivitiesMutex.RUnlock()
+	return fake.listActivitiesArgsForCall[i].workflowID
+}
+
+func (fake *FakeClient) ListActivitiesReturns(result1 []*models.Activity, result2 error) {
+	fake.ListActivitiesStub = nil
+	fake.listActivitiesReturns = struct {
+		result1 []*models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListActivitiesReturnsOnCall(i int, result1 []*models.Activity, result2 error) {
+	fake.ListActivitiesStub = nil
+	if fake.listActivitiesReturnsOnCall == nil {
+		fake.listActivitiesReturnsOnCall = make(map[int]struct {
+			result1 []*models.Activity
+			result2 error
+		})
+	}
+	fake.listActivitiesReturnsOnCall[i] = struct {
+		result1 []*models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) WorkflowProgress(workflowID string) (int, error) {
+	fake.workflowProgressMutex.Lock()
+	ret, specificReturn := fake.workflowProgressReturnsOnCall[len(fake.workflowProgressArgsForCall)]
+	fake.workflowProgressArgsForCall = append(fake.workflowProgressArgsForCall, struct {
+		workflowID string
+	}{workflowID})
+	fake.recordInvocation("WorkflowProgress", []interface{}{workflowID})
+	fake.workflowProgressMutex.Unlock()
+	if fake.WorkflowProgressStub != nil {
+		return fake.WorkflowProgressStub(workflowID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.workflowProgressReturns.result1, fake.workflowProgressReturns.result2
+}
+
+func (fake *FakeClient) WorkflowProgressCallCount() int {
+	fake.workflowProgressMutex.RLock()
+	defer fake.workflowProgressMutex.RUnlock()
+	return len(fake.workflowProgressArgsForCall)
+}
+
+func (fake *FakeClient) WorkflowProgressArgsForCall(i int) string {
+	fake.workflowProgressMutex.RLock()
+	defer fake.workflowProgressMutex.RUnlock()
+	return fake.workflowProgressArgsForCall[i].workflowID
+}
+
+func (fake *FakeClient) WorkflowProgressReturns(result1 int, result2 error) {
+	fake.WorkflowProgressStub = nil
+	fake.workflowProgressReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) WorkflowProgressReturnsOnCall(i int, result1 int, result2 error) {
+	fake.WorkflowProgressStub = nil
+	if fake.workflowProgressReturnsOnCall == nil {
+		fake.workflowProgressReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.workflowProgressReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CurrentActivity(workflowID string) (*models.Activity, error) {
+	fake.currentActivityMutex.Lock()
+	ret, specificReturn := fake.currentActivityReturnsOnCall[len(fake.currentActivityArgsForCall)]
+	fake.currentActivityArgsForCall = append(fake.currentActivityArgsForCall, struct {
+		workflowID string
+	}{workflowID})
+	fake.recordInvocation("CurrentActivity", []interface{}{workflowID})
+	fake.currentActivityMutex.Unlock()
+	if fake.CurrentActivityStub != nil {
+		return fake.CurrentActivityStub(workflowID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.currentActivityReturns.result1, fake.currentActivityReturns.result2
+}
+
+func (fake *FakeClient) CurrentActivityCallCount() int {
+	fake.currentActivityMutex.RLock()
+	defer fake.currentActivityMutex.RUnlock()
+	return len(fake.currentActivityArgsForCall)
+}
+
+func (fake *FakeClient) CurrentActivityArgsForCall(i int) string {
+	fake.currentActivityMutex.RLock()
+	defer fake.currentActivityMutex.RUnlock()
+	return fake.currentActivityArgsForCall[i].workflowID
+}
+
+func (fake *FakeClient) CurrentActivityReturns(result1 *models.Activity, result2 error) {
+	fake.CurrentActivityStub = nil
+	fake.currentActivityReturns = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CurrentActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
+	fake.CurrentActivityStub = nil
+	if fake.currentActivityReturnsOnCall == nil {
+		fake.currentActivityReturnsOnCall = make(map[int]struct {
+			result1 *models.Activity
+			result2 error
+		})
+	}
+	fake.currentActivityReturnsOnCall[i] = struct {
+		result1 *models.Activity
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListActivitiesPage(workflowID string, cursor string, limit int) ([]*models.Activity, string, error) {
+	fake.listActivitiesPageMutex.Lock()
+	ret, specificReturn := fake.listActivitiesPageReturnsOnCall[len(fake.listActivitiesPageArgsForCall)]
+	fake.listActivitiesPageArgsForCall = append(fake.listActivitiesPageArgsForCall, struct {
+		workflowID string
+		cursor     string
+		limit      int
+	}{workflowID, cursor, limit})
+	fake.recordInvocation("ListActivitiesPage", []interface{}{workflowID, cursor, limit})
+	fake.listActivitiesPageMutex.Unlock()
+	if fake.ListActivitiesPageStub != nil {
+		return fake.ListActivitiesPageStub(workflowID, cursor, limit)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.listActivitiesPageReturns.result1, fake.listActivitiesPageReturns.result2, fake.listActivitiesPageReturns.result3
+}
+
+func (fake *FakeClient) ListActivitiesPageCallCount() int {
+	fake.listActivitiesPageMutex.RLock()
+	defer fake.listActivitiesPageMutex.RUnlock()
+	return len(fake.listActivitiesPageArgsForCall)
+}
+
+func (fake *FakeClient) ListActivitiesPageArgsForCall(i int) (string, string, int) {
+	fake.listActivitiesPageMutex.RLock()
+	defer fake.listActivitiesPageMutex.RUnlock()
+	return fake.listActivitiesPageArgsForCall[i].workflowID, fake.listActivitiesPageArgsForCall[i].cursor, fake.listActivitiesPageArgsForCall[i].limit
+}
+
+func (fake *FakeClient) ListActivitiesPageReturns(result1 []*models.Activity, result2 string, result3 error) {
+	fake.ListActivitiesPageStub = nil
+	fake.listActivitiesPageReturns = struct {
+		result1 []*models.Activity
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ListActivitiesPageReturnsOnCall(i int, result1 []*models.Activity, result2 string, result3 error) {
+	fake.ListActivitiesPageStub = nil
+	if fake.listActivitiesPageReturnsOnCall == nil {
+		fake.listActivitiesPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.Activity
+			result2 string
+			result3 error
+		})
+	}
+	fake.listActivitiesPageReturnsOnCall[i] = struct {
+		result1 []*models.Activity
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ListWorkflows(filter workflow.WorkflowFilter) ([]*models.Workflow, error) {
+	fake.listWorkflowsMutex.Lock()
+	ret, specificReturn := fake.listWorkflowsReturnsOnCall[len(fake.listWorkflowsArgsForCall)]
+	fake.listWorkflowsArgsForCall = append(fake.listWorkflowsArgsForCall, struct {
+		filter workflow.WorkflowFilter
+	}{filter})
+	fake.recordInvocation("ListWorkflows", []interface{}{filter})
+	fake.listWorkflowsMutex.Unlock()
+	if fake.ListWorkflowsStub != nil {
+		return fake.ListWorkflowsStub(filter)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listWorkflowsReturns.result1, fake.listWorkflowsReturns.result2
+}
+
+func (fake *FakeClient) ListWorkflowsCallCount() int {
+	fake.listWorkflowsMutex.RLock()
+	defer fake.listWorkflowsMutex.RUnlock()
+	return len(fake.listWorkflowsArgsForCall)
+}
+
+func (fake *FakeClient) ListWorkflowsArgsForCall(i int) workflow.WorkflowFilter {
+	fake.listWorkflowsMutex.RLock()
+	defer fake.listWorkflowsMutex.RUnlock()
+	return fake.listWorkflowsArgsForCall[i].filter
+}
+
+func (fake *FakeClient) ListWorkflowsReturns(result1 []*models.Workflow, result2 error) {
+	fake.ListWorkflowsStub = nil
+	fake.listWorkflowsReturns = struct {
+		result1 []*models.Workflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListWorkflowsReturnsOnCall(i int, result1 []*models.Workflow, result2 error) {
+	fake.ListWorkflowsStub = nil
+	if fake.listWorkflowsReturnsOnCall == nil {
+		fake.listWorkflowsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Workflow
+			result2 error
+		})
+	}
+	fake.listWorkflowsReturnsOnCall[i] = struct {
+		result1 []*models.Workflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SearchWorkflows(query string, organizationID int32) ([]*models.Workflow, error) {
+	fake.searchWorkflowsMutex.Lock()
+	ret, specificReturn := fake.searchWorkflowsReturnsOnCall[len(fake.searchWorkflowsArgsForCall)]
+	fake.searchWorkflowsArgsForCall = append(fake.searchWorkflowsArgsForCall, struct {
+		query          string
+		organizationID int32
+	}{query, organizationID})
+	fake.recordInvocation("SearchWorkflows", []interface{}{query, organizationID})
+	fake.searchWorkflowsMutex.Unlock()
+	if fake.SearchWorkflowsStub != nil {
+		return fake.SearchWorkflowsStub(query, organizationID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.searchWorkflowsReturns.result1, fake.searchWorkflowsReturns.result2
+}
+
+func (fake *FakeClient) SearchWorkflowsCallCount() int {
+	fake.searchWorkflowsMutex.RLock()
+	defer fake.searchWorkflowsMutex.RUnlock()
+	return len(fake.searchWorkflowsArgsForCall)
+}
+
+func (fake *FakeClient) SearchWorkflowsArgsForCall(i int) (string, int32) {
+	fake.searchWorkflowsMutex.RLock()
+	defer fake.searchWorkflowsMutex.RUnlock()
+	return fake.searchWorkflowsArgsForCall[i].query, fake.searchWorkflowsArgsForCall[i].organizationID
+}
+
+func (fake *FakeClient) SearchWorkflowsReturns(result1 []*models.Workflow, result2 error) {
+	fake.SearchWorkflowsStub = nil
+	fake.searchWorkflowsReturns = struct {
+		result1 []*models.Workflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SearchWorkflowsReturnsOnCall(i int, result1 []*models.Workflow, result2 error) {
+	fake.SearchWorkflowsStub = nil
+	if fake.searchWorkflowsReturnsOnCall == nil {
+		fake.searchWorkflowsReturnsOnCall = make(map[int]struct {
+			result1 []*models.Workflow
+			result2 error
+		})
+	}
+	fake.searchWorkflowsReturnsOnCall[i] = struct {
+		result1 []*models.Workflow
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListWorkflowsPage(filter workflow.WorkflowFilter, cursor string, limit int) ([]*models.Workflow, string, error) {
+	fake.listWorkflowsPageMutex.Lock()
+	ret, specificReturn := fake.listWorkflowsPageReturnsOnCall[len(fake.listWorkflowsPageArgsForCall)]
+	fake.listWorkflowsPageArgsForCall = append(fake.listWorkflowsPageArgsForCall, struct {
+		filter workflow.WorkflowFilter
+		cursor string
+		limit  int
+	}{filter, cursor, limit})
+	fake.recordInvocation("ListWorkflowsPage", []interface{}{filter, cursor, limit})
+	fake.listWorkflowsPageMutex.Unlock()
+	if fake.ListWorkflowsPageStub != nil {
+		return fake.ListWorkflowsPageStub(filter, cursor, limit)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.listWorkflowsPageReturns.result1, fake.listWorkflowsPageReturns.result2, fake.listWorkflowsPageReturns.result3
+}
+
+func (fake *FakeClient) ListWorkflowsPageCallCount() int {
+	fake.listWorkflowsPageMutex.RLock()
+	defer fake.listWorkflowsPageMutex.RUnlock()
+	return len(fake.listWorkflowsPageArgsForCall)
+}
+
+func (fake *FakeClient) ListWorkflowsPageArgsForCall(i int) (workflow.WorkflowFilter, string, int) {
+	fake.listWorkflowsPageMutex.RLock()
+	defer fake.listWorkflowsPageMutex.RUnlock()
+	return fake.listWorkflowsPageArgsForCall[i].filter, fake.listWorkflowsPageArgsForCall[i].cursor, fake.listWorkflowsPageArgsForCall[i].limit
+}
+
+func (fake *FakeClient) ListWorkflowsPageReturns(result1 []*models.Workflow, result2 string, result3 error) {
+	fake.ListWorkflowsPageStub = nil
+	fake.listWorkflowsPageReturns = struct {
+		result1 []*models.Workflow
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ListWorkflowsPageReturnsOnCall(i int, result1 []*models.Workflow, result2 string, result3 error) {
+	fake.ListWorkflowsPageStub = nil
+	if fake.listWorkflowsPageReturnsOnCall == nil {
+		fake.listWorkflowsPageReturnsOnCall = make(map[int]struct {
+			result1 []*models.Workflow
+			result2 string
+			result3 error
+		})
+	}
+	fake.listWorkflowsPageReturnsOnCall[i] = struct {
+		result1 []*models.Workflow
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) ForEachWorkflow(filter workflow.WorkflowFilter, fn func(*models.Workflow) error) error {
+	fake.forEachWorkflowMutex.Lock()
+	ret, specificReturn := fake.forEachWorkflowReturnsOnCall[len(fake.forEachWorkflowArgsForCall)]
+	fake.forEachWorkflowArgsForCall = append(fake.forEachWorkflowArgsForCall, struct {
+		filter workflow.WorkflowFilter
+		fn     func(*models.Workflow) error
+	}{filter, fn})
+	fake.recordInvocation("ForEachWorkflow", []interface{}{filter, fn})
+	fake.forEachWorkflowMutex.Unlock()
+	if fake.ForEachWorkflowStub != nil {
+		return fake.ForEachWorkflowStub(filter, fn)
 	}
 	if specificReturn {
 		return ret.result1
 	}
-	return fake.cancelWorkflowReturns.result1
+	return fake.forEachWorkflowReturns.result1
 }
 
-func (fake *FakeClient) CancelWorkflowCallCount() int {
-	fake.cancelWorkflowMutex.RLock()
-	defer fake.cancelWorkflowMutex.RUnlock()
-	return len(fake.cancelWorkflowArgsForCall)
+func (fake *FakeClient) ForEachWorkflowCallCount() int {
+	fake.forEachWorkflowMutex.RLock()
+	defer fake.forEachWorkflowMutex.RUnlock()
+	return len(fake.forEachWorkflowArgsForCall)
 }
 
-func (fake *FakeClient) CancelWorkflowArgsForCall(i int) string {
-	fake.cancelWorkflowMutex.RLock()
-	defer fake.cancelWorkflowMutex.RUnlock()
-	return fake.cancelWorkflowArgsForCall[i].workflowID
+func (fake *FakeClient) ForEachWorkflowArgsForCall(i int) (workflow.WorkflowFilter, func(*models.Workflow) error) {
+	fake.forEachWorkflowMutex.RLock()
+	defer fake.forEachWorkflowMutex.RUnlock()
+	return fake.forEachWorkflowArgsForCall[i].filter, fake.forEachWorkflowArgsForCall[i].fn
 }
 
-func (fake *FakeClient) CancelWorkflowReturns(result1 error) {
-	fake.CancelWorkflowStub = nil
-	fake.cancelWorkflowReturns = struct {
+func (fake *FakeClient) ForEachWorkflowReturns(result1 error) {
+	fake.ForEachWorkflowStub = nil
+	fake.forEachWorkflowReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeClient) CancelWorkflowReturnsOnCall(i int, result1 error) {
-	fake.CancelWorkflowStub = nil
-	if fake.cancelWorkflowReturnsOnCall == nil {
-		fake.cancelWorkflowReturnsOnCall = make(map[int]struct {
+func (fake *FakeClient) ForEachWorkflowReturnsOnCall(i int, result1 error) {
+	fake.ForEachWorkflowStub = nil
+	if fake.forEachWorkflowReturnsOnCall == nil {
+		fake.forEachWorkflowReturnsOnCall = make(map[int]struct {
 			result1 error
 		})
 	}
-	fake.cancelWorkflowReturnsOnCall[i] = struct {
+	fake.forEachWorkflowReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeClient) UpdateActivity(workflowID string, activity *models.Activity) (*models.Activity, error) {
-	fake.updateActivityMutex.Lock()
-	ret, specificReturn := fake.updateActivityReturnsOnCall[len(fake.updateActivityArgsForCall)]
-	fake.updateActivityArgsForCall = append(fake.updateActivityArgsForCall, struct {
-		workflowID string
-		activity   *models.Activity
-	}{workflowID, activity})
-	fake.recordInvocation("UpdateActivity", []interface{}{workflowID, activity})
-	fake.updateActivityMutex.Unlock()
-	if fake.UpdateActivityStub != nil {
-		return fake.UpdateActivityStub(workflowID, activity)
+func (fake *FakeClient) CancelWorkflowsForEntity(entityID int32, organizationID int32) ([]string, error) {
+	fake.cancelWorkflowsForEntityMutex.Lock()
+	ret, specificReturn := fake.cancelWorkflowsForEntityReturnsOnCall[len(fake.cancelWorkflowsForEntityArgsForCall)]
+	fake.cancelWorkflowsForEntityArgsForCall = append(fake.cancelWorkflowsForEntityArgsForCall, struct {
+		entityID       int32
+		organizationID int32
+	}{entityID, organizationID})
+	fake.recordInvocation("CancelWorkflowsForEntity", []interface{}{entityID, organizationID})
+	fake.cancelWorkflowsForEntityMutex.Unlock()
+	if fake.CancelWorkflowsForEntityStub != nil {
+		return fake.CancelWorkflowsForEntityStub(entityID, organizationID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.updateActivityReturns.result1, fake.updateActivityReturns.result2
+	return fake.cancelWorkflowsForEntityReturns.result1, fake.cancelWorkflowsForEntityReturns.result2
 }
 
-func (fake *FakeClient) UpdateActivityCallCount() int {
-	fake.updateActivityMutex.RLock()
-	defer fake.updateActivityMutex.RUnlock()
-	return len(fake.updateActivityArgsForCall)
+func (fake *FakeClient) CancelWorkflowsForEntityCallCount() int {
+	fake.cancelWorkflowsForEntityMutex.RLock()
+	defer fake.cancelWorkflowsForEntityMutex.RUnlock()
+	return len(fake.cancelWorkflowsForEntityArgsForCall)
 }
 
-func (fake *FakeClient) UpdateActivityArgsForCall(i int) (string, *models.Activity) {
-	fake.updateActivityMutex.RLock()
-	defer fake.updateActivityMutex.RUnlock()
-	return fake.updateActivityArgsForCall[i].workflowID, fake.updateActivityArgsForCall[i].activity
+func (fake *FakeClient) CancelWorkflowsForEntityArgsForCall(i int) (int32, int32) {
+	fake.cancelWorkflowsForEntityMutex.RLock()
+	defer fake.cancelWorkflowsForEntityMutex.RUnlock()
+	return fake.cancelWorkflowsForEntityArgsForCall[i].entityID, fake.cancelWorkflowsForEntityArgsForCall[i].organizationID
 }
 
-func (fake *FakeClient) UpdateActivityReturns(result1 *models.Activity, result2 error) {
-	fake.UpdateActivityStub = nil
-	fake.updateActivityReturns = struct {
-		result1 *models.Activity
+func (fake *FakeClient) CancelWorkflowsForEntityReturns(result1 []string, result2 error) {
+	fake.CancelWorkflowsForEntityStub = nil
+	fake.cancelWorkflowsForEntityReturns = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) UpdateActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
-	fake.UpdateActivityStub = nil
-	if fake.updateActivityReturnsOnCall == nil {
-		fake.updateActivityReturnsOnCall = make(map[int]struct {
-			result1 *models.Activity
+func (fake *FakeClient) CancelWorkflowsForEntityReturnsOnCall(i int, result1 []string, result2 error) {
+	fake.CancelWorkflowsForEntityStub = nil
+	if fake.cancelWorkflowsForEntityReturnsOnCall == nil {
+		fake.cancelWorkflowsForEntityReturnsOnCall = make(map[int]struct {
+			result1 []string
 			result2 error
 		})
 	}
-	fake.updateActivityReturnsOnCall[i] = struct {
-		result1 *models.Activity
+	fake.cancelWorkflowsForEntityReturnsOnCall[i] = struct {
+		result1 []string
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) UpdateActivityPercentComplete(workflowID string, activityID string, percentComplete int) (*models.Activity, error) {
-	fake.updateActivityPercentCompleteMutex.Lock()
-	ret, specificReturn := fake.updateActivityPercentCompleteReturnsOnCall[len(fake.updateActivityPercentCompleteArgsForCall)]
-	fake.updateActivityPercentCompleteArgsForCall = append(fake.updateActivityPercentCompleteArgsForCall, struct {
-		workflowID      string
-		activityID      string
-		percentComplete int
-	}{workflowID, activityID, percentComplete})
-	fake.recordInvocation("UpdateActivityPercentComplete", []interface{}{workflowID, activityID, percentComplete})
-	fake.updateActivityPercentCompleteMutex.Unlock()
-	if fake.UpdateActivityPercentCompleteStub != nil {
-		return fake.UpdateActivityPercentCompleteStub(workflowID, activityID, percentComplete)
+func (fake *FakeClient) RecentRequests() []workflow.RecordedRequest {
+	fake.recentRequestsMutex.Lock()
+	ret, specificReturn := fake.recentRequestsReturnsOnCall[len(fake.recentRequestsArgsForCall)]
+	fake.recentRequestsArgsForCall = append(fake.recentRequestsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RecentRequests", []interface{}{})
+	fake.recentRequestsMutex.Unlock()
+	if fake.RecentRequestsStub != nil {
+		return fake.RecentRequestsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.recentRequestsReturns.result1
+}
+
+func (fake *FakeClient) RecentRequestsCallCount() int {
+	fake.recentRequestsMutex.RLock()
+	defer fake.recentRequestsMutex.RUnlock()
+	return len(fake.recentRequestsArgsForCall)
+}
+
+func (fake *FakeClient) RecentRequestsReturns(result1 []workflow.RecordedRequest) {
+	fake.RecentRequestsStub = nil
+	fake.recentRequestsReturns = struct {
+		result1 []workflow.RecordedRequest
+	}{result1}
+}
+
+func (fake *FakeClient) RecentRequestsReturnsOnCall(i int, result1 []workflow.RecordedRequest) {
+	fake.RecentRequestsStub = nil
+	if fake.recentRequestsReturnsOnCall == nil {
+		fake.recentRequestsReturnsOnCall = make(map[int]struct {
+			result1 []workflow.RecordedRequest
+		})
+	}
+	fake.recentRequestsReturnsOnCall[i] = struct {
+		result1 []workflow.RecordedRequest
+	}{result1}
+}
+
+func (fake *FakeClient) GetWorkflowType(workflowType string) (*models.WorkflowTypeInfo, error) {
+	fake.getWorkflowTypeMutex.Lock()
+	ret, specificReturn := fake.getWorkflowTypeReturnsOnCall[len(fake.getWorkflowTypeArgsForCall)]
+	fake.getWorkflowTypeArgsForCall = append(fake.getWorkflowTypeArgsForCall, struct {
+		workflowType string
+	}{workflowType})
+	fake.recordInvocation("GetWorkflowType", []interface{}{workflowType})
+	fake.getWorkflowTypeMutex.Unlock()
+	if fake.GetWorkflowTypeStub != nil {
+		return fake.GetWorkflowTypeStub(workflowType)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.updateActivityPercentCompleteReturns.result1, fake.updateActivityPercentCompleteReturns.result2
+	return fake.getWorkflowTypeReturns.result1, fake.getWorkflowTypeReturns.result2
 }
 
-func (fake *FakeClient) UpdateActivityPercentCompleteCallCount() int {
-	fake.updateActivityPercentCompleteMutex.RLock()
-	defer fake.updateActivityPercentCompleteMutex.RUnlock()
-	return len(fake.updateActivityPercentCompleteArgsForCall)
+func (fake *FakeClient) GetWorkflowTypeCallCount() int {
+	fake.getWorkflowTypeMutex.RLock()
+	defer fake.getWorkflowTypeMutex.RUnlock()
+	return len(fake.getWorkflowTypeArgsForCall)
 }
 
-func (fake *FakeClient) UpdateActivityPercentCompleteArgsForCall(i int) (string, string, int) {
-	fake.updateActivityPercentCompleteMutex.RLock()
-	defer fake.updateActivityPercentCompleteMutex.RUnlock()
-	return fake.updateActivityPercentCompleteArgsForCall[i].workflowID, fake.updateActivityPercentCompleteArgsForCall[i].activityID, fake.updateActivityPercentCompleteArgsForCall[i].percentComplete
+func (fake *FakeClient) GetWorkflowTypeArgsForCall(i int) string {
+	fake.getWorkflowTypeMutex.RLock()
+	defer fake.getWorkflowTypeMutex.RUnlock()
+	return fake.getWorkflowTypeArgsForCall[i].workflowType
 }
 
-func (fake *FakeClient) UpdateActivityPercentCompleteReturns(result1 *models.Activity, result2 error) {
-	fake.UpdateActivityPercentCompleteStub = nil
-	fake.updateActivityPercentCompleteReturns = struct {
-		result1 *models.Activity
+func (fake *FakeClient) GetWorkflowTypeReturns(result1 *models.WorkflowTypeInfo, result2 error) {
+	fake.GetWorkflowTypeStub = nil
+	fake.getWorkflowTypeReturns = struct {
+		result1 *models.WorkflowTypeInfo
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) UpdateActivityPercentCompleteReturnsOnCall(i int, result1 *models.Activity, result2 error) {
-	fake.UpdateActivityPercentCompleteStub = nil
-	if fake.updateActivityPercentCompleteReturnsOnCall == nil {
-		fake.updateActivityPercentCompleteReturnsOnCall = make(map[int]struct {
-			result1 *models.Activity
+func (fake *FakeClient) GetWorkflowTypeReturnsOnCall(i int, result1 *models.WorkflowTypeInfo, result2 error) {
+	fake.GetWorkflowTypeStub = nil
+	if fake.getWorkflowTypeReturnsOnCall == nil {
+		fake.getWorkflowTypeReturnsOnCall = make(map[int]struct {
+			result1 *models.WorkflowTypeInfo
 			result2 error
 		})
 	}
-	fake.updateActivityPercentCompleteReturnsOnCall[i] = struct {
-		result1 *models.Activity
+	fake.getWorkflowTypeReturnsOnCall[i] = struct {
+		result1 *models.WorkflowTypeInfo
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) CompleteSuccessfulActivity(workflowID string, activityID string, result interface{}) (*models.Activity, error) {
-	fake.completeSuccessfulActivityMutex.Lock()
-	ret, specificReturn := fake.completeSuccessfulActivityReturnsOnCall[len(fake.completeSuccessfulActivityArgsForCall)]
-	fake.completeSuccessfulActivityArgsForCall = append(fake.completeSuccessfulActivityArgsForCall, struct {
-		workflowID string
-		activityID string
-		result     interface{}
-	}{workflowID, activityID, result})
-	fake.recordInvocation("CompleteSuccessfulActivity", []interface{}{workflowID, activityID, result})
-	fake.completeSuccessfulActivityMutex.Unlock()
-	if fake.CompleteSuccessfulActivityStub != nil {
-		return fake.CompleteSuccessfulActivityStub(workflowID, activityID, result)
+func (fake *FakeClient) WatchCapacity(ctx context.Context, organizationID int32) (<-chan *models.CapacityEvent, error) {
+	fake.watchCapacityMutex.Lock()
+	ret, specificReturn := fake.watchCapacityReturnsOnCall[len(fake.watchCapacityArgsForCall)]
+	fake.watchCapacityArgsForCall = append(fake.watchCapacityArgsForCall, struct {
+		ctx            context.Context
+		organizationID int32
+	}{ctx, organizationID})
+	fake.recordInvocation("WatchCapacity", []interface{}{ctx, organizationID})
+	fake.watchCapacityMutex.Unlock()
+	if fake.WatchCapacityStub != nil {
+		return fake.WatchCapacityStub(ctx, organizationID)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.completeSuccessfulActivityReturns.result1, fake.completeSuccessfulActivityReturns.result2
+	return fake.watchCapacityReturns.result1, fake.watchCapacityReturns.result2
 }
 
-func (fake *FakeClient) CompleteSuccessfulActivityCallCount() int {
-	fake.completeSuccessfulActivityMutex.RLock()
-	defer fake.completeSuccessfulActivityMutex.RUnlock()
-	return len(fake.completeSuccessfulActivityArgsForCall)
+func (fake *FakeClient) WatchCapacityCallCount() int {
+	fake.watchCapacityMutex.RLock()
+	defer fake.watchCapacityMutex.RUnlock()
+	return len(fake.watchCapacityArgsForCall)
 }
 
-func (fake *FakeClient) CompleteSuccessfulActivityArgsForCall(i int) (string, string, interface{}) {
-	fake.completeSuccessfulActivityMutex.RLock()
-	defer fake.completeSuccessfulActivityMutex.RUnlock()
-	return fake.completeSuccessfulActivityArgsForCall[i].workflowID, fake.completeSuccessfulActivityArgsForCall[i].activityID, fake.completeSuccessfulActivityArgsForCall[i].result
+func (fake *FakeClient) WatchCapacityArgsForCall(i int) (context.Context, int32) {
+	fake.watchCapacityMutex.RLock()
+	defer fake.watchCapacityMutex.RUnlock()
+	return fake.watchCapacityArgsForCall[i].ctx, fake.watchCapacityArgsForCall[i].organizationID
 }
 
-func (fake *FakeClient) CompleteSuccessfulActivityReturns(result1 *models.Activity, result2 error) {
-	fake.CompleteSuccessfulActivityStub = nil
-	fake.completeSuccessfulActivityReturns = struct {
-		result1 *models.Activity
+func (fake *FakeClient) WatchCapacityReturns(result1 <-chan *models.CapacityEvent, result2 error) {
+	fake.WatchCapacityStub = nil
+	fake.watchCapacityReturns = struct {
+		result1 <-chan *models.CapacityEvent
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) CompleteSuccessfulActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
-	fake.CompleteSuccessfulActivityStub = nil
-	if fake.completeSuccessfulActivityReturnsOnCall == nil {
-		fake.completeSuccessfulActivityReturnsOnCall = make(map[int]struct {
-			result1 *models.Activity
+func (fake *FakeClient) WatchCapacityReturnsOnCall(i int, result1 <-chan *models.CapacityEvent, result2 error) {
+	fake.WatchCapacityStub = nil
+	if fake.watchCapacityReturnsOnCall == nil {
+		fake.watchCapacityReturnsOnCall = make(map[int]struct {
+			result1 <-chan *models.CapacityEvent
 			result2 error
 		})
 	}
-	fake.completeSuccessfulActivityReturnsOnCall[i] = struct {
-		result1 *models.Activity
+	fake.watchCapacityReturnsOnCall[i] = struct {
+		result1 <-chan *models.CapacityEvent
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) CompleteCancelledActivity(workflowID string, activityID string, reason string, details string) (*models.Activity, error) {
-	fake.completeCancelledActivityMutex.Lock()
-	ret, specificReturn := fake.completeCancelledActivityReturnsOnCall[len(fake.completeCancelledActivityArgsForCall)]
-	fake.completeCancelledActivityArgsForCall = append(fake.completeCancelledActivityArgsForCall, struct {
-		workflowID string
-		activityID string
-		reason     string
-		details    string
-	}{workflowID, activityID, reason, details})
-	fake.recordInvocation("CompleteCancelledActivity", []interface{}{workflowID, activityID, reason, details})
-	fake.completeCancelledActivityMutex.Unlock()
-	if fake.CompleteCancelledActivityStub != nil {
-		return fake.CompleteCancelledActivityStub(workflowID, activityID, reason, details)
+func (fake *FakeClient) WaitForState(ctx context.Context, workflowID string, target string, pollInterval time.Duration) (*models.Workflow, error) {
+	fake.waitForStateMutex.Lock()
+	ret, specificReturn := fake.waitForStateReturnsOnCall[len(fake.waitForStateArgsForCall)]
+	fake.waitForStateArgsForCall = append(fake.waitForStateArgsForCall, struct {
+		ctx          context.Context
+		workflowID   string
+		target       string
+		pollInterval time.Duration
+	}{ctx, workflowID, target, pollInterval})
+	fake.recordInvocation("WaitForState", []interface{}{ctx, workflowID, target, pollInterval})
+	fake.waitForStateMutex.Unlock()
+	if fake.WaitForStateStub != nil {
+		return fake.WaitForStateStub(ctx, workflowID, target, pollInterval)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
 	}
-	return fake.completeCancelledActivityReturns.result1, fake.completeCancelledActivityReturns.result2
+	return fake.waitForStateReturns.result1, fake.waitForStateReturns.result2
 }
 
-func (fake *FakeClient) CompleteCancelledActivityCallCount() int {
-	fake.completeCancelledActivityMutex.RLock()
-	defer fake.completeCancelledActivityMutex.RUnlock()
-	return len(fake.completeCancelledActivityArgsForCall)
+func (fake *FakeClient) WaitForStateCallCount() int {
+	fake.waitForStateMutex.RLock()
+	defer fake.waitForStateMutex.RUnlock()
+	return len(fake.waitForStateArgsForCall)
 }
 
-func (fake *FakeClient) CompleteCancelledActivityArgsForCall(i int) (string, string, string, string) {
-	fake.completeCancelledActivityMutex.RLock()
-	defer fake.completeCancelledActivityMutex.RUnlock()
-	return fake.completeCancelledActivityArgsForCall[i].workflowID, fake.completeCancelledActivityArgsForCall[i].activityID, fake.completeCancelledActivityArgsForCall[i].reason, fake.completeCancelledActivityArgsForCall[i].details
+func (fake *FakeClient) WaitForStateArgsForCall(i int) (context.Context, string, string, time.Duration) {
+	fake.waitForStateMutex.RLock()
+	defer fake.waitForStateMutex.RUnlock()
+	return fake.waitForStateArgsForCall[i].ctx, fake.waitForStateArgsForCall[i].workflowID, fake.waitForStateArgsForCall[i].target, fake.waitForStateArgsForCall[i].pollInterval
 }
 
-func (fake *FakeClient) CompleteCancelledActivityReturns(result1 *models.Activity, result2 error) {
-	fake.CompleteCancelledActivityStub = nil
-	fake.completeCancelledActivityReturns = struct {
-		result1 *models.Activity
+func (fake *FakeClient) WaitForStateReturns(result1 *models.Workflow, result2 error) {
+	fake.WaitForStateStub = nil
+	fake.waitForStateReturns = struct {
+		result1 *models.Workflow
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) CompleteCancelledActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
-	fake.CompleteCancelledActivityStub = nil
-	if fake.completeCancelledActivityReturnsOnCall == nil {
-		fake.completeCancelledActivityReturnsOnCall = make(map[int]struct {
-			result1 *models.Activity
+func (fake *FakeClient) WaitForStateReturnsOnCall(i int, result1 *models.Workflow, result2 error) {
+	fake.WaitForStateStub = nil
+	if fake.waitForStateReturnsOnCall == nil {
+		fake.waitForStateReturnsOnCall = make(map[int]struct {
+			result1 *models.Workflow
 			result2 error
 		})
 	}
-	fake.completeCancelledActivityReturnsOnCall[i] = struct {
-		result1 *models.Activity
+	fake.waitForStateReturnsOnCall[i] = struct {
+		result1 *models.Workflow
 		result2 error
 	}{result1, result2}
 }
 
-func (fake *FakeClient) CompleteFailedActivity(workflowID string, activityID string, reason string, details string) (*models.Activity, error) {
-	fake.completeFailedActivityMutex.Lock()
-	ret, specificReturn := fake.completeFailedActivityReturnsOnCall[len(fake.completeFailedActivityArgsForCall)]
-	fake.completeFailedActivityArgsForCall = append(fake.completeFailedActivityArgsForCall, struct {
-		workflowID string
-		activityID string
-		reason     string
-		details    string
-	}{workflowID, activityID, reason, details})
-	fake.recordInvocation("CompleteFailedActivity", []interface{}{workflowID, activityID, reason, details})
-	fake.completeFailedActivityMutex.Unlock()
-	if fake.CompleteFailedActivityStub != nil {
-		return fake.CompleteFailedActivityStub(workflowID, activityID, reason, details)
+func (fake *FakeClient) APIGatewayURL() string {
+	fake.aPIGatewayURLMutex.Lock()
+	ret, specificReturn := fake.aPIGatewayURLReturnsOnCall[len(fake.aPIGatewayURLArgsForCall)]
+	fake.aPIGatewayURLArgsForCall = append(fake.aPIGatewayURLArgsForCall, struct {
+	}{})
+	fake.recordInvocation("APIGatewayURL", []interface{}{})
+	fake.aPIGatewayURLMutex.Unlock()
+	if fake.APIGatewayURLStub != nil {
+		return fake.APIGatewayURLStub()
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fake.completeFailedActivityReturns.result1, fake.completeFailedActivityReturns.result2
-}
-
-func (fake *FakeClient) CompleteFailedActivityCallCount() int {
-	fake.completeFailedActivityMutex.RLock()
-	defer fake.completeFailedActivityMutex.RUnlock()
-	return len(fake.completeFailedActivityArgsForCall)
+	return fake.aPIGatewayURLReturns.result1
 }
 
-func (fake *FakeClient) CompleteFailedActivityArgsForCall(i int) (string, string, string, string) {
-	fake.completeFailedActivityMutex.RLock()
-	defer fake.completeFailedActivityMutex.RUnlock()
-	return fake.completeFailedActivityArgsForCall[i].workflowID, fake.completeFailedActivityArgsForCall[i].activityID, fake.completeFailedActivityArgsForCall[i].reason, fake.completeFailedActivityArgsForCall[i].details
+func (fake *FakeClient) APIGatewayURLCallCount() int {
+	fake.aPIGatewayURLMutex.RLock()
+	defer fake.aPIGatewayURLMutex.RUnlock()
+	return len(fake.aPIGatewayURLArgsForCall)
 }
 
-func (fake *FakeClient) CompleteFailedActivityReturns(result1 *models.Activity, result2 error) {
-	fake.CompleteFailedActivityStub = nil
-	fake.completeFailedActivityReturns = struct {
-		result1 *models.Activity
-		result2 error
-	}{result1, result2}
+func (fake *FakeClient) APIGatewayURLReturns(result1 string) {
+	fake.APIGatewayURLStub = nil
+	fake.aPIGatewayURLReturns = struct {
+		result1 string
+	}{result1}
 }
 
-func (fake *FakeClient) CompleteFailedActivityReturnsOnCall(i int, result1 *models.Activity, result2 error) {
-	fake.CompleteFailedActivityStub = nil
-	if fake.completeFailedActivityReturnsOnCall == nil {
-		fake.completeFailedActivityReturnsOnCall = make(map[int]struct {
-			result1 *models.Activity
-			result2 error
+func (fake *FakeClient) APIGatewayURLReturnsOnCall(i int, result1 string) {
+	fake.APIGatewayURLStub = nil
+	if fake.aPIGatewayURLReturnsOnCall == nil {
+		fake.aPIGatewayURLReturnsOnCall = make(map[int]struct {
+			result1 string
 		})
 	}
-	fake.completeFailedActivityReturnsOnCall[i] = struct {
-		result1 *models.Activity
-		result2 error
-	}{result1, result2}
+	fake.aPIGatewayURLReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
 }
 
-func (fake *FakeClient) HeartbeatActivity(workflowID string, activityID string) (*models.Heartbeat, error) {
-	fake.heartbeatActivityMutex.Lock()
-	ret, specificReturn := fake.heartbeatActivityReturnsOnCall[len(fake.heartbeatActivityArgsForCall)]
-	fake.heartbeatActivityArgsForCall = append(fake.heartbeatActivityArgsForCall, struct {
-		workflowID string
-		activityID string
-	}{workflowID, activityID})
-	fake.recordInvocation("HeartbeatActivity", []interface{}{workflowID, activityID})
-	fake.heartbeatActivityMutex.Unlock()
-	if fake.HeartbeatActivityStub != nil {
-		return fake.HeartbeatActivityStub(workflowID, activityID)
+func (fake *FakeClient) APIBasePath() string {
+	fake.aPIBasePathMutex.Lock()
+	ret, specificReturn := fake.aPIBasePathReturnsOnCall[len(fake.aPIBasePathArgsForCall)]
+	fake.aPIBasePathArgsForCall = append(fake.aPIBasePathArgsForCall, struct {
+	}{})
+	fake.recordInvocation("APIBasePath", []interface{}{})
+	fake.aPIBasePathMutex.Unlock()
+	if fake.APIBasePathStub != nil {
+		return fake.APIBasePathStub()
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fake.heartbeatActivityReturns.result1, fake.heartbeatActivityReturns.result2
+	return fake.aPIBasePathReturns.result1
 }
 
-func (fake *FakeClient) HeartbeatActivityCallCount() int {
-	fake.heartbeatActivityMutex.RLock()
-	defer fake.heartbeatActivityMutex.RUnlock()
-	return len(fake.heartbeatActivityArgsForCall)
+func (fake *FakeClient) APIBasePathCallCount() int {
+	fake.aPIBasePathMutex.RLock()
+	defer fake.aPIBasePathMutex.RUnlock()
+	return len(fake.aPIBasePathArgsForCall)
 }
 
-func (fake *FakeClient) HeartbeatActivityArgsForCall(i int) (string, string) {
-	fake.heartbeatActivityMutex.RLock()
-	defer fake.heartbeatActivityMutex.RUnlock()
-	return fake.heartbeatActivityArgsForCall[i].workflowID, fake.heartbeatActivityArgsForCall[i].activityID
+func (fake *FakeClient) APIBasePathReturns(result1 string) {
+	fake.APIBasePathStub = nil
+	fake.aPIBasePathReturns = struct {
+		result1 string
+	}{result1}
 }
 
-func (fake *FakeClient) HeartbeatActivityReturns(result1 *models.Heartbeat, result2 error) {
-	fake.HeartbeatActivityStub = nil
-	fake.heartbeatActivityReturns = struct {
-		result1 *models.Heartbeat
-		result2 error
-	}{result1, result2}
+func (fake *FakeClient) APIBasePathReturnsOnCall(i int, result1 string) {
+	fake.APIBasePathStub = nil
+	if fake.aPIBasePathReturnsOnCall == nil {
+		fake.aPIBasePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.aPIBasePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
 }
 
-func (fake *FakeClient) HeartbeatActivityReturnsOnCall(i int, result1 *models.Heartbeat, result2 error) {
-	fake.HeartbeatActivityStub = nil
-	if fake.heartbeatActivityReturnsOnCall == nil {
-		fake.heartbeatActivityReturnsOnCall = make(map[int]struct {
-			result1 *models.Heartbeat
-			result2 error
+func (fake *FakeClient) Audience() string {
+	fake.audienceMutex.Lock()
+	ret, specificReturn := fake.audienceReturnsOnCall[len(fake.audienceArgsForCall)]
+	fake.audienceArgsForCall = append(fake.audienceArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Audience", []interface{}{})
+	fake.audienceMutex.Unlock()
+	if fake.AudienceStub != nil {
+		return fake.AudienceStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.audienceReturns.result1
+}
+
+func (fake *FakeClient) AudienceCallCount() int {
+	fake.audienceMutex.RLock()
+	defer fake.audienceMutex.RUnlock()
+	return len(fake.audienceArgsForCall)
+}
+
+func (fake *FakeClient) AudienceReturns(result1 string) {
+	fake.AudienceStub = nil
+	fake.audienceReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeClient) AudienceReturnsOnCall(i int, result1 string) {
+	fake.AudienceStub = nil
+	if fake.audienceReturnsOnCall == nil {
+		fake.audienceReturnsOnCall = make(map[int]struct {
+			result1 string
 		})
 	}
-	fake.heartbeatActivityReturnsOnCall[i] = struct {
-		result1 *models.Heartbeat
-		result2 error
-	}{result1, result2}
+	fake.audienceReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
 }
 
-func (fake *FakeClient) HeartbeatActivityWithToken(taskToken string, activityID string, details string) (*models.Heartbeat, error) {
-	fake.heartbeatActivityWithTokenMutex.Lock()
-	ret, specificReturn := fake.heartbeatActivityWithTokenReturnsOnCall[len(fake.heartbeatActivityWithTokenArgsForCall)]
-	fake.heartbeatActivityWithTokenArgsForCall = append(fake.heartbeatActivityWithTokenArgsForCall, struct {
-		taskToken  string
-		activityID string
-		details    string
-	}{taskToken, activityID, details})
-	fake.recordInvocation("HeartbeatActivityWithToken", []interface{}{taskToken, activityID, details})
-	fake.heartbeatActivityWithTokenMutex.Unlock()
-	if fake.HeartbeatActivityWithTokenStub != nil {
-		return fake.HeartbeatActivityWithTokenStub(taskToken, activityID, details)
+func (fake *FakeClient) WithContext(ctx context.Context) workflow.Client {
+	fake.withContextMutex.Lock()
+	ret, specificReturn := fake.withContextReturnsOnCall[len(fake.withContextArgsForCall)]
+	fake.withContextArgsForCall = append(fake.withContextArgsForCall, struct {
+		ctx context.Context
+	}{ctx})
+	fake.recordInvocation("WithContext", []interface{}{ctx})
+	fake.withContextMutex.Unlock()
+	if fake.WithContextStub != nil {
+		return fake.WithContextStub(ctx)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2
+		return ret.result1
 	}
-	return fake.heartbeatActivityWithTokenReturns.result1, fake.heartbeatActivityWithTokenReturns.result2
+	return fake.withContextReturns.result1
 }
 
-func (fake *FakeClient) HeartbeatActivityWithTokenCallCount() int {
-	fake.heartbeatActivityWithTokenMutex.RLock()
-	defer fake.heartbeatActivityWithTokenMutex.RUnlock()
-	return len(fake.heartbeatActivityWithTokenArgsForCall)
+func (fake *FakeClient) WithContextCallCount() int {
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
+	return len(fake.withContextArgsForCall)
 }
 
-func (fake *FakeClient) HeartbeatActivityWithTokenArgsForCall(i int) (string, string, string) {
-	fake.heartbeatActivityWithTokenMutex.RLock()
-	defer fake.heartbeatActivityWithTokenMutex.RUnlock()
-	return fake.heartbeatActivityWithTokenArgsForCall[i].taskToken, fake.heartbeatActivityWithTokenArgsForCall[i].activityID, fake.heartbeatActivityWithTokenArgsForCall[i].details
+func (fake *FakeClient) WithContextArgsForCall(i int) context.Context {
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
+	return fake.withContextArgsForCall[i].ctx
 }
 
-func (fake *FakeClient) HeartbeatActivityWithTokenReturns(result1 *models.Heartbeat, result2 error) {
-	fake.HeartbeatActivityWithTokenStub = nil
-	fake.heartbeatActivityWithTokenReturns = struct {
-		result1 *models.Heartbeat
-		result2 error
-	}{result1, result2}
+func (fake *FakeClient) WithContextReturns(result1 workflow.Client) {
+	fake.WithContextStub = nil
+	fake.withContextReturns = struct {
+		result1 workflow.Client
+	}{result1}
 }
 
-func (fake *FakeClient) HeartbeatActivityWithTokenReturnsOnCall(i int, result1 *models.Heartbeat, result2 error) {
-	fake.HeartbeatActivityWithTokenStub = nil
-	if fake.heartbeatActivityWithTokenReturnsOnCall == nil {
-		fake.heartbeatActivityWithTokenReturnsOnCall = make(map[int]struct {
-			result1 *models.Heartbeat
-			result2 error
+func (fake *FakeClient) WithContextReturnsOnCall(i int, result1 workflow.Client) {
+	fake.WithContextStub = nil
+	if fake.withContextReturnsOnCall == nil {
+		fake.withContextReturnsOnCall = make(map[int]struct {
+			result1 workflow.Client
 		})
 	}
-	fake.heartbeatActivityWithTokenReturnsOnCall[i] = struct {
-		result1 *models.Heartbeat
-		result2 error
-	}{result1, result2}
+	fake.withContextReturnsOnCall[i] = struct {
+		result1 workflow.Client
+	}{result1}
+}
+
+func (fake *FakeClient) Close() error {
+	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if fake.CloseStub != nil {
+		return fake.CloseStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.closeReturns.result1
+}
+
+func (fake *FakeClient) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *FakeClient) CloseReturns(result1 error) {
+	fake.CloseStub = nil
+	fake.closeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeClient) CloseReturnsOnCall(i int, result1 error) {
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
 }
 
 func (fake *FakeClient) Invocations() map[string][][]interface{} {
@@ -617,22 +4145,132 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.startWorkflowMutex.RLock()
 	defer fake.startWorkflowMutex.RUnlock()
+	fake.startWorkflowNoQueueMutex.RLock()
+	defer fake.startWorkflowNoQueueMutex.RUnlock()
+	fake.startWorkflowIfNotExistsMutex.RLock()
+	defer fake.startWorkflowIfNotExistsMutex.RUnlock()
+	fake.cloneWorkflowMutex.RLock()
+	defer fake.cloneWorkflowMutex.RUnlock()
+	fake.getWorkflowParametersMutex.RLock()
+	defer fake.getWorkflowParametersMutex.RUnlock()
+	fake.validateWorkflowMutex.RLock()
+	defer fake.validateWorkflowMutex.RUnlock()
 	fake.cancelWorkflowMutex.RLock()
 	defer fake.cancelWorkflowMutex.RUnlock()
+	fake.transferWorkflowMutex.RLock()
+	defer fake.transferWorkflowMutex.RUnlock()
+	fake.updateWorkflowMetadataMutex.RLock()
+	defer fake.updateWorkflowMetadataMutex.RUnlock()
+	fake.getWorkflowMutex.RLock()
+	defer fake.getWorkflowMutex.RUnlock()
+	fake.getWorkflowByExternalIDMutex.RLock()
+	defer fake.getWorkflowByExternalIDMutex.RUnlock()
+	fake.getChildWorkflowsMutex.RLock()
+	defer fake.getChildWorkflowsMutex.RUnlock()
+	fake.getWorkflowHistoryMutex.RLock()
+	defer fake.getWorkflowHistoryMutex.RUnlock()
+	fake.listWorkflowHistoryPageMutex.RLock()
+	defer fake.listWorkflowHistoryPageMutex.RUnlock()
+	fake.forEachHistoryEventMutex.RLock()
+	defer fake.forEachHistoryEventMutex.RUnlock()
+	fake.exportWorkflowMutex.RLock()
+	defer fake.exportWorkflowMutex.RUnlock()
+	fake.signalWorkflowMutex.RLock()
+	defer fake.signalWorkflowMutex.RUnlock()
+	fake.signalWorkflowIfRunningMutex.RLock()
+	defer fake.signalWorkflowIfRunningMutex.RUnlock()
+	fake.getSignalStatusMutex.RLock()
+	defer fake.getSignalStatusMutex.RUnlock()
+	fake.registerWebhookMutex.RLock()
+	defer fake.registerWebhookMutex.RUnlock()
+	fake.unregisterWebhookMutex.RLock()
+	defer fake.unregisterWebhookMutex.RUnlock()
+	fake.workflowRawMutex.RLock()
+	defer fake.workflowRawMutex.RUnlock()
 	fake.updateActivityMutex.RLock()
 	defer fake.updateActivityMutex.RUnlock()
 	fake.updateActivityPercentCompleteMutex.RLock()
 	defer fake.updateActivityPercentCompleteMutex.RUnlock()
+	fake.updateActivitiesPercentCompleteMutex.RLock()
+	defer fake.updateActivitiesPercentCompleteMutex.RUnlock()
+	fake.patchActivityMutex.RLock()
+	defer fake.patchActivityMutex.RUnlock()
 	fake.completeSuccessfulActivityMutex.RLock()
 	defer fake.completeSuccessfulActivityMutex.RUnlock()
+	fake.completeSuccessfulActivityStreamMutex.RLock()
+	defer fake.completeSuccessfulActivityStreamMutex.RUnlock()
 	fake.completeCancelledActivityMutex.RLock()
 	defer fake.completeCancelledActivityMutex.RUnlock()
+	fake.completeCancelledActivityWithResultMutex.RLock()
+	defer fake.completeCancelledActivityWithResultMutex.RUnlock()
 	fake.completeFailedActivityMutex.RLock()
 	defer fake.completeFailedActivityMutex.RUnlock()
+	fake.completeFailedActivityNonBlockingMutex.RLock()
+	defer fake.completeFailedActivityNonBlockingMutex.RUnlock()
+	fake.completeActivityMutex.RLock()
+	defer fake.completeActivityMutex.RUnlock()
+	fake.retryActivityMutex.RLock()
+	defer fake.retryActivityMutex.RUnlock()
 	fake.heartbeatActivityMutex.RLock()
 	defer fake.heartbeatActivityMutex.RUnlock()
+	fake.appendActivityLogsMutex.RLock()
+	defer fake.appendActivityLogsMutex.RUnlock()
+	fake.getActivityLogsMutex.RLock()
+	defer fake.getActivityLogsMutex.RUnlock()
+	fake.downloadActivityResultMutex.RLock()
+	defer fake.downloadActivityResultMutex.RUnlock()
 	fake.heartbeatActivityWithTokenMutex.RLock()
 	defer fake.heartbeatActivityWithTokenMutex.RUnlock()
+	fake.abandonActivityMutex.RLock()
+	defer fake.abandonActivityMutex.RUnlock()
+	fake.extendHeartbeatTimeoutMutex.RLock()
+	defer fake.extendHeartbeatTimeoutMutex.RUnlock()
+	fake.isCancellationRequestedMutex.RLock()
+	defer fake.isCancellationRequestedMutex.RUnlock()
+	fake.getActivityTaskTokenMutex.RLock()
+	defer fake.getActivityTaskTokenMutex.RUnlock()
+	fake.lookupByTaskTokenMutex.RLock()
+	defer fake.lookupByTaskTokenMutex.RUnlock()
+	fake.heartbeatActivitiesMutex.RLock()
+	defer fake.heartbeatActivitiesMutex.RUnlock()
+	fake.getActivityMutex.RLock()
+	defer fake.getActivityMutex.RUnlock()
+	fake.listActivitiesMutex.RLock()
+	defer fake.listActivitiesMutex.RUnlock()
+	fake.workflowProgressMutex.RLock()
+	defer fake.workflowProgressMutex.RUnlock()
+	fake.currentActivityMutex.RLock()
+	defer fake.currentActivityMutex.RUnlock()
+	fake.listActivitiesPageMutex.RLock()
+	defer fake.listActivitiesPageMutex.RUnlock()
+	fake.listWorkflowsMutex.RLock()
+	defer fake.listWorkflowsMutex.RUnlock()
+	fake.searchWorkflowsMutex.RLock()
+	defer fake.searchWorkflowsMutex.RUnlock()
+	fake.listWorkflowsPageMutex.RLock()
+	defer fake.listWorkflowsPageMutex.RUnlock()
+	fake.forEachWorkflowMutex.RLock()
+	defer fake.forEachWorkflowMutex.RUnlock()
+	fake.cancelWorkflowsForEntityMutex.RLock()
+	defer fake.cancelWorkflowsForEntityMutex.RUnlock()
+	fake.recentRequestsMutex.RLock()
+	defer fake.recentRequestsMutex.RUnlock()
+	fake.getWorkflowTypeMutex.RLock()
+	defer fake.getWorkflowTypeMutex.RUnlock()
+	fake.watchCapacityMutex.RLock()
+	defer fake.watchCapacityMutex.RUnlock()
+	fake.waitForStateMutex.RLock()
+	defer fake.waitForStateMutex.RUnlock()
+	fake.aPIGatewayURLMutex.RLock()
+	defer fake.aPIGatewayURLMutex.RUnlock()
+	fake.aPIBasePathMutex.RLock()
+	defer fake.aPIBasePathMutex.RUnlock()
+	fake.audienceMutex.RLock()
+	defer fake.audienceMutex.RUnlock()
+	fake.withContextMutex.RLock()
+	defer fake.withContextMutex.RUnlock()
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
 	return fake.invocations
 }
 